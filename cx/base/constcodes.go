@@ -3,6 +3,10 @@
 package cxcore
 
 import (
+	"math"
+	"time"
+	"unicode/utf8"
+
 	. "github.com/skycoin/cx/cx"
 )
 
@@ -36,6 +40,33 @@ const (
 	CONST_OS_SEEK_CUR
 	CONST_OS_SEEK_END
 
+	// time
+	CONST_TIME_NANOSECOND
+	CONST_TIME_MICROSECOND
+	CONST_TIME_MILLISECOND
+	CONST_TIME_SECOND
+	CONST_TIME_MINUTE
+	CONST_TIME_HOUR
+	CONST_TIME_JANUARY
+	CONST_TIME_FEBRUARY
+	CONST_TIME_MARCH
+	CONST_TIME_APRIL
+	CONST_TIME_MAY
+	CONST_TIME_JUNE
+	CONST_TIME_JULY
+	CONST_TIME_AUGUST
+	CONST_TIME_SEPTEMBER
+	CONST_TIME_OCTOBER
+	CONST_TIME_NOVEMBER
+	CONST_TIME_DECEMBER
+	CONST_TIME_SUNDAY
+	CONST_TIME_MONDAY
+	CONST_TIME_TUESDAY
+	CONST_TIME_WEDNESDAY
+	CONST_TIME_THURSDAY
+	CONST_TIME_FRIDAY
+	CONST_TIME_SATURDAY
+
 	// json
 	CONST_JSON_TOKEN_NULL
 	CONST_JSON_TOKEN_DELIM
@@ -47,6 +78,48 @@ const (
 	CONST_JSON_DELIM_CURLY_RIGHT
 	CONST_JSON_DELIM_SQUARE_LEFT
 	CONST_JSON_DELIM_SQUARE_RIGHT
+	CONST_JSON_NAMES_AS_IS
+	CONST_JSON_NAMES_SNAKE_CASE
+	CONST_JSON_NAMES_CAMEL_CASE
+
+	// base64
+	CONST_BASE64_STD
+	CONST_BASE64_URL
+	CONST_BASE64_RAW_STD
+	CONST_BASE64_RAW_URL
+
+	// binary
+	CONST_BINARY_BIG_ENDIAN
+	CONST_BINARY_LITTLE_ENDIAN
+
+	// math
+	CONST_MATH_PI
+	CONST_MATH_E
+	CONST_MATH_PHI
+	CONST_MATH_SQRT2
+	CONST_MATH_LN2
+	CONST_MATH_LN10
+	CONST_MATH_MAX_FLOAT32
+	CONST_MATH_SMALLEST_NONZERO_FLOAT32
+	CONST_MATH_MAX_FLOAT64
+	CONST_MATH_SMALLEST_NONZERO_FLOAT64
+	CONST_MATH_MIN_INT8
+	CONST_MATH_MAX_INT8
+	CONST_MATH_MIN_INT16
+	CONST_MATH_MAX_INT16
+	CONST_MATH_MIN_INT32
+	CONST_MATH_MAX_INT32
+	CONST_MATH_MIN_INT64
+	CONST_MATH_MAX_INT64
+	CONST_MATH_MAX_UINT8
+	CONST_MATH_MAX_UINT16
+	CONST_MATH_MAX_UINT32
+	CONST_MATH_MAX_UINT64
+
+	// utf8
+	CONST_UTF8_RUNE_ERROR
+	CONST_UTF8_MAX_RUNE
+	CONST_UTF8_UTF_MAX
 )
 
 const (
@@ -88,6 +161,33 @@ func init() {
 	ConstI32(CONST_OS_SEEK_CUR, "os.SEEK_CUR", OS_SEEK_CUR)
 	ConstI32(CONST_OS_SEEK_END, "os.SEEK_END", OS_SEEK_END)
 
+	// time
+	ConstI64(CONST_TIME_NANOSECOND, "time.NANOSECOND", int64(time.Nanosecond))
+	ConstI64(CONST_TIME_MICROSECOND, "time.MICROSECOND", int64(time.Microsecond))
+	ConstI64(CONST_TIME_MILLISECOND, "time.MILLISECOND", int64(time.Millisecond))
+	ConstI64(CONST_TIME_SECOND, "time.SECOND", int64(time.Second))
+	ConstI64(CONST_TIME_MINUTE, "time.MINUTE", int64(time.Minute))
+	ConstI64(CONST_TIME_HOUR, "time.HOUR", int64(time.Hour))
+	ConstI32(CONST_TIME_JANUARY, "time.JANUARY", int32(time.January))
+	ConstI32(CONST_TIME_FEBRUARY, "time.FEBRUARY", int32(time.February))
+	ConstI32(CONST_TIME_MARCH, "time.MARCH", int32(time.March))
+	ConstI32(CONST_TIME_APRIL, "time.APRIL", int32(time.April))
+	ConstI32(CONST_TIME_MAY, "time.MAY", int32(time.May))
+	ConstI32(CONST_TIME_JUNE, "time.JUNE", int32(time.June))
+	ConstI32(CONST_TIME_JULY, "time.JULY", int32(time.July))
+	ConstI32(CONST_TIME_AUGUST, "time.AUGUST", int32(time.August))
+	ConstI32(CONST_TIME_SEPTEMBER, "time.SEPTEMBER", int32(time.September))
+	ConstI32(CONST_TIME_OCTOBER, "time.OCTOBER", int32(time.October))
+	ConstI32(CONST_TIME_NOVEMBER, "time.NOVEMBER", int32(time.November))
+	ConstI32(CONST_TIME_DECEMBER, "time.DECEMBER", int32(time.December))
+	ConstI32(CONST_TIME_SUNDAY, "time.SUNDAY", int32(time.Sunday))
+	ConstI32(CONST_TIME_MONDAY, "time.MONDAY", int32(time.Monday))
+	ConstI32(CONST_TIME_TUESDAY, "time.TUESDAY", int32(time.Tuesday))
+	ConstI32(CONST_TIME_WEDNESDAY, "time.WEDNESDAY", int32(time.Wednesday))
+	ConstI32(CONST_TIME_THURSDAY, "time.THURSDAY", int32(time.Thursday))
+	ConstI32(CONST_TIME_FRIDAY, "time.FRIDAY", int32(time.Friday))
+	ConstI32(CONST_TIME_SATURDAY, "time.SATURDAY", int32(time.Saturday))
+
 	// json
 	ConstI32(CONST_JSON_TOKEN_NULL, "json.TOKEN_NULL", JSON_TOKEN_NULL)
 	ConstI32(CONST_JSON_TOKEN_DELIM, "json.TOKEN_DELIM", JSON_TOKEN_DELIM)
@@ -99,4 +199,46 @@ func init() {
 	ConstI32(CONST_JSON_DELIM_CURLY_RIGHT, "json.DELIM_CURLY_RIGHT", JSON_DELIM_CURLY_RIGHT)
 	ConstI32(CONST_JSON_DELIM_SQUARE_LEFT, "json.DELIM_SQUARE_LEFT", JSON_DELIM_SQUARE_LEFT)
 	ConstI32(CONST_JSON_DELIM_SQUARE_RIGHT, "json.DELIM_SQUARE_RIGHT", JSON_DELIM_SQUARE_RIGHT)
+	ConstI32(CONST_JSON_NAMES_AS_IS, "json.NAMES_AS_IS", JSON_NAMES_AS_IS)
+	ConstI32(CONST_JSON_NAMES_SNAKE_CASE, "json.NAMES_SNAKE_CASE", JSON_NAMES_SNAKE_CASE)
+	ConstI32(CONST_JSON_NAMES_CAMEL_CASE, "json.NAMES_CAMEL_CASE", JSON_NAMES_CAMEL_CASE)
+
+	// base64
+	ConstI32(CONST_BASE64_STD, "base64.STD", BASE64_STD)
+	ConstI32(CONST_BASE64_URL, "base64.URL", BASE64_URL)
+	ConstI32(CONST_BASE64_RAW_STD, "base64.RAW_STD", BASE64_RAW_STD)
+	ConstI32(CONST_BASE64_RAW_URL, "base64.RAW_URL", BASE64_RAW_URL)
+
+	// binary
+	ConstI32(CONST_BINARY_BIG_ENDIAN, "binary.BIG_ENDIAN", BINARY_BIG_ENDIAN)
+	ConstI32(CONST_BINARY_LITTLE_ENDIAN, "binary.LITTLE_ENDIAN", BINARY_LITTLE_ENDIAN)
+
+	// math
+	ConstF64(CONST_MATH_PI, "math.Pi", math.Pi)
+	ConstF64(CONST_MATH_E, "math.E", math.E)
+	ConstF64(CONST_MATH_PHI, "math.Phi", math.Phi)
+	ConstF64(CONST_MATH_SQRT2, "math.Sqrt2", math.Sqrt2)
+	ConstF64(CONST_MATH_LN2, "math.Ln2", math.Ln2)
+	ConstF64(CONST_MATH_LN10, "math.Ln10", math.Ln10)
+	ConstF32(CONST_MATH_MAX_FLOAT32, "math.MaxFloat32", math.MaxFloat32)
+	ConstF32(CONST_MATH_SMALLEST_NONZERO_FLOAT32, "math.SmallestNonzeroFloat32", math.SmallestNonzeroFloat32)
+	ConstF64(CONST_MATH_MAX_FLOAT64, "math.MaxFloat64", math.MaxFloat64)
+	ConstF64(CONST_MATH_SMALLEST_NONZERO_FLOAT64, "math.SmallestNonzeroFloat64", math.SmallestNonzeroFloat64)
+	ConstI8(CONST_MATH_MIN_INT8, "math.MinInt8", math.MinInt8)
+	ConstI8(CONST_MATH_MAX_INT8, "math.MaxInt8", math.MaxInt8)
+	ConstI16(CONST_MATH_MIN_INT16, "math.MinInt16", math.MinInt16)
+	ConstI16(CONST_MATH_MAX_INT16, "math.MaxInt16", math.MaxInt16)
+	ConstI32(CONST_MATH_MIN_INT32, "math.MinInt32", math.MinInt32)
+	ConstI32(CONST_MATH_MAX_INT32, "math.MaxInt32", math.MaxInt32)
+	ConstI64(CONST_MATH_MIN_INT64, "math.MinInt64", math.MinInt64)
+	ConstI64(CONST_MATH_MAX_INT64, "math.MaxInt64", math.MaxInt64)
+	ConstUI8(CONST_MATH_MAX_UINT8, "math.MaxUint8", math.MaxUint8)
+	ConstUI16(CONST_MATH_MAX_UINT16, "math.MaxUint16", math.MaxUint16)
+	ConstUI32(CONST_MATH_MAX_UINT32, "math.MaxUint32", math.MaxUint32)
+	ConstUI64(CONST_MATH_MAX_UINT64, "math.MaxUint64", math.MaxUint64)
+
+	// utf8
+	ConstI32(CONST_UTF8_RUNE_ERROR, "utf8.RuneError", utf8.RuneError)
+	ConstI32(CONST_UTF8_MAX_RUNE, "utf8.MaxRune", utf8.MaxRune)
+	ConstI32(CONST_UTF8_UTF_MAX, "utf8.UTFMax", utf8.UTFMax)
 }
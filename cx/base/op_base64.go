@@ -0,0 +1,116 @@
+// +build base
+
+package cxcore
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// Encodings, as in `base64.Encode(base64.URL, data)`. The raw encodings omit
+// the padding.
+const (
+	BASE64_STD = iota
+	BASE64_URL
+	BASE64_RAW_STD
+	BASE64_RAW_URL
+)
+
+func init() {
+	RegisterPackage("base64")
+}
+
+func base64Encoding(fp int, inp *CXArgument) *base64.Encoding {
+	switch ReadI32(fp, inp) {
+	case BASE64_STD:
+		return base64.StdEncoding
+	case BASE64_URL:
+		return base64.URLEncoding
+	case BASE64_RAW_STD:
+		return base64.RawStdEncoding
+	case BASE64_RAW_URL:
+		return base64.RawURLEncoding
+	}
+	panic(CX_RUNTIME_INVALID_ARGUMENT)
+}
+
+func opBase64Encode(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	enc := base64Encoding(fp, expr.Inputs[0])
+	WriteString(fp, enc.EncodeToString(ReadSliceBytes(fp, expr.Inputs[1], TYPE_UI8)), expr.Outputs[0])
+}
+
+func opBase64Decode(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	enc := base64Encoding(fp, expr.Inputs[0])
+	data, err := enc.DecodeString(ReadStr(fp, expr.Inputs[1]))
+	if err != nil {
+		data = nil
+	}
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opBase64EncodeStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	enc := base64Encoding(fp, expr.Inputs[0])
+	WriteString(fp, enc.EncodeToString([]byte(ReadStr(fp, expr.Inputs[1]))), expr.Outputs[0])
+}
+
+func opBase64DecodeStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	enc := base64Encoding(fp, expr.Inputs[0])
+	data, err := enc.DecodeString(ReadStr(fp, expr.Inputs[1]))
+	if err != nil {
+		data = nil
+	}
+	WriteString(fp, string(data), expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// ReadFile decodes the rest of an `os` file. Line breaks are ignored.
+func opBase64ReadFile(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	enc := base64Encoding(fp, expr.Inputs[0])
+	var data []byte
+	success := false
+	if file := ValidFile(ReadI32(fp, expr.Inputs[1])); file != nil {
+		if text, err := ioutil.ReadAll(file); err == nil {
+			if data, err = enc.DecodeString(string(text)); err == nil {
+				success = true
+			} else {
+				data = nil
+			}
+		}
+	}
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+// WriteFile encodes data and writes it to an `os` file.
+func opBase64WriteFile(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	enc := base64Encoding(fp, expr.Inputs[0])
+	success := false
+	if file := ValidFile(ReadI32(fp, expr.Inputs[1])); file != nil {
+		text := enc.EncodeToString(ReadSliceBytes(fp, expr.Inputs[2], TYPE_UI8))
+		if _, err := file.WriteString(text); err == nil {
+			success = true
+		}
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
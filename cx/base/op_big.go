@@ -0,0 +1,556 @@
+// +build base
+
+package cxcore
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/jinzhu/copier"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// The `big.Int` and `big.Dec` instances keep their value as the decimal text
+// of their `v` field, so they are stored in the heap like any other string
+// and they survive the garbage collections and the serialization of the
+// program. An empty `v` is the zero value.
+//
+// A `big.Dec` is an exact decimal number with a fixed number of digits after
+// the decimal point, its scale. Its text is the unscaled value with the
+// decimal point inserted, e.g. "-12.340" has a scale of 3.
+
+func init() {
+	RegisterPackage("big")
+
+	bigPkg := MakePackage("big")
+
+	intStrct := MakeStruct("Int")
+	intStrct.AddField(MakeArgument("v", "", 0).AddType(TypeNames[TYPE_STR]).AddPackage(bigPkg))
+	bigPkg.AddStruct(intStrct)
+
+	decStrct := MakeStruct("Dec")
+	decStrct.AddField(MakeArgument("v", "", 0).AddType(TypeNames[TYPE_STR]).AddPackage(bigPkg))
+	bigPkg.AddStruct(decStrct)
+
+	PROGRAM.AddPackage(bigPkg)
+}
+
+// bigValue returns a copy of the `big.Int` or `big.Dec` argument `arg`,
+// which accesses its `v` field.
+func bigValue(arg *CXArgument, strctName string) *CXArgument {
+	v := CXArgument{}
+	err := copier.Copy(&v, arg)
+	if err != nil {
+		panic(err)
+	}
+
+	bigPkg, err := PROGRAM.GetPackage("big")
+	if err != nil {
+		panic(err)
+	}
+	strct, err := bigPkg.GetStruct(strctName)
+	if err != nil {
+		panic(err)
+	}
+	vFld, err := strct.GetField("v")
+	if err != nil {
+		panic(err)
+	}
+
+	if v.IsPointer && len(v.DereferenceOperations) == 0 {
+		v.DereferenceOperations = append(v.DereferenceOperations, DEREF_POINTER)
+	}
+	v.Fields = append(v.Fields, vFld)
+	return &v
+}
+
+// readInt reads the `big.Int` `inp`.
+func readInt(fp int, inp *CXArgument) *big.Int {
+	x := new(big.Int)
+	s := ReadStr(fp, bigValue(inp, "Int"))
+	if s == "" {
+		return x
+	}
+	if _, ok := x.SetString(s, 10); !ok {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return x
+}
+
+// writeInt writes `x` to the `big.Int` `out`.
+func writeInt(fp int, x *big.Int, out *CXArgument) {
+	WriteString(fp, x.String(), bigValue(out, "Int"))
+}
+
+// decimal is the Go representation of a `big.Dec`: `unscaled` / 10^`scale`.
+type decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// pow10 returns 10^`n`.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// rescale returns the unscaled value of `d` with `scale` digits after the
+// decimal point. `scale` must be greater than or equal to the scale of `d`.
+func (d decimal) rescale(scale int32) *big.Int {
+	return new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+}
+
+// String formats `d` with exactly `d.scale` digits after the decimal point.
+func (d decimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if len(digits) <= int(d.scale) {
+			digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-int(d.scale)] + "." + digits[len(digits)-int(d.scale):]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// isDigits checks if `s` only contains decimal digits.
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDecimal parses a decimal number such as "12", "-0.50" or "+3.", whose
+// scale is the number of digits after the decimal point.
+func parseDecimal(s string) (decimal, bool) {
+	digits := s
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		digits = digits[1:]
+	}
+	intPart, fracPart := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		intPart, fracPart = digits[:i], digits[i+1:]
+	}
+	if intPart+fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return decimal{}, false
+	}
+
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return decimal{}, false
+	}
+	if strings.HasPrefix(s, "-") {
+		unscaled.Neg(unscaled)
+	}
+	return decimal{unscaled: unscaled, scale: int32(len(fracPart))}, true
+}
+
+// quoRound returns `x` / `y` rounded half away from zero. It panics if `y`
+// is zero.
+func quoRound(x, y *big.Int) *big.Int {
+	if y.Sign() == 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(y)) >= 0 {
+		if x.Sign() == y.Sign() {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// round returns `d` rounded half away from zero to `scale` digits after the
+// decimal point.
+func (d decimal) round(scale int32) decimal {
+	if scale < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	if scale >= d.scale {
+		return decimal{unscaled: d.rescale(scale), scale: scale}
+	}
+	return decimal{unscaled: quoRound(d.unscaled, pow10(d.scale-scale)), scale: scale}
+}
+
+// readDec reads the `big.Dec` `inp`.
+func readDec(fp int, inp *CXArgument) decimal {
+	s := ReadStr(fp, bigValue(inp, "Dec"))
+	if s == "" {
+		return decimal{unscaled: new(big.Int)}
+	}
+	d, ok := parseDecimal(s)
+	if !ok {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return d
+}
+
+// writeDec writes `d` to the `big.Dec` `out`.
+func writeDec(fp int, d decimal, out *CXArgument) {
+	WriteString(fp, d.String(), bigValue(out, "Dec"))
+}
+
+func opBigNewInt(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, big.NewInt(ReadI64(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opBigNewIntUI64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).SetUint64(ReadUI64(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+// opBigParseInt parses an integer in the base given by the second input,
+// which can be between 2 and 36, or 0 to use the prefix of the text (0x,
+// 0o, 0b).
+func opBigParseInt(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	base := ReadI32(fp, expr.Inputs[1])
+	if base != 0 && (base < 2 || base > 36) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	x, ok := new(big.Int).SetString(ReadStr(fp, expr.Inputs[0]), int(base))
+	if !ok {
+		x = new(big.Int)
+	}
+	writeInt(fp, x, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), ok)
+}
+
+func opBigIntString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readInt(fp, expr.Inputs[0]).String(), expr.Outputs[0])
+}
+
+func opBigIntText(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	base := ReadI32(fp, expr.Inputs[1])
+	if base < 2 || base > 62 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	WriteString(fp, readInt(fp, expr.Inputs[0]).Text(int(base)), expr.Outputs[0])
+}
+
+func opBigIntAdd(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Add(readInt(fp, expr.Inputs[0]), readInt(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opBigIntSub(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Sub(readInt(fp, expr.Inputs[0]), readInt(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opBigIntMul(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Mul(readInt(fp, expr.Inputs[0]), readInt(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// readIntDivisor reads the `big.Int` `inp`, which can't be zero.
+func readIntDivisor(fp int, inp *CXArgument) *big.Int {
+	y := readInt(fp, inp)
+	if y.Sign() == 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return y
+}
+
+// opBigIntQuo is the division truncated towards zero.
+func opBigIntQuo(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Quo(readInt(fp, expr.Inputs[0]), readIntDivisor(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// opBigIntRem is the remainder of `opBigIntQuo`, which has the sign of the
+// dividend.
+func opBigIntRem(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Rem(readInt(fp, expr.Inputs[0]), readIntDivisor(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// opBigIntDiv is the Euclidean division.
+func opBigIntDiv(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Div(readInt(fp, expr.Inputs[0]), readIntDivisor(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// opBigIntMod is the Euclidean modulus, which is never negative.
+func opBigIntMod(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Mod(readInt(fp, expr.Inputs[0]), readIntDivisor(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// bigMaxExpBits is the maximum size in bits of the result of `big.Int.Exp`,
+// so a huge exponent fails instead of computing the power for hours.
+const bigMaxExpBits = 1 << 20
+
+func opBigIntExp(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x := readInt(fp, expr.Inputs[0])
+	y := readInt(fp, expr.Inputs[1])
+	if y.Sign() < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	// The powers of -1, 0 and 1 are small whatever the exponent is, and the
+	// result has at least (x.BitLen() - 1) * y bits otherwise.
+	if x.CmpAbs(big.NewInt(1)) > 0 &&
+		(y.Cmp(big.NewInt(bigMaxExpBits)) > 0 || int64(x.BitLen()-1)*y.Int64() > bigMaxExpBits) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	writeInt(fp, new(big.Int).Exp(x, y, nil), expr.Outputs[0])
+}
+
+func opBigIntNeg(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Neg(readInt(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opBigIntAbs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeInt(fp, new(big.Int).Abs(readInt(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opBigIntCmp(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(readInt(fp, expr.Inputs[0]).Cmp(readInt(fp, expr.Inputs[1]))))
+}
+
+func opBigIntSign(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(readInt(fp, expr.Inputs[0]).Sign()))
+}
+
+// opBigIntI64 converts the integer to i64. The second output is false if it
+// doesn't fit, and then the first one is undefined.
+func opBigIntI64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x := readInt(fp, expr.Inputs[0])
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), x.Int64())
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), x.IsInt64())
+}
+
+// opBigIntUI64 converts the integer to ui64. The second output is false if
+// it doesn't fit, and then the first one is undefined.
+func opBigIntUI64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x := readInt(fp, expr.Inputs[0])
+	WriteUI64(GetFinalOffset(fp, expr.Outputs[0]), x.Uint64())
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), x.IsUint64())
+}
+
+// opBigIntF64 converts the integer to the nearest f64.
+func opBigIntF64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	f, _ := new(big.Float).SetInt(readInt(fp, expr.Inputs[0])).Float64()
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), f)
+}
+
+// opBigIntDec converts the integer to a decimal with a scale of 0.
+func opBigIntDec(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDec(fp, decimal{unscaled: readInt(fp, expr.Inputs[0])}, expr.Outputs[0])
+}
+
+// opBigNewDec returns the decimal `unscaled` / 10^`scale`, e.g. 12345 and 2
+// are 123.45.
+func opBigNewDec(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	scale := ReadI32(fp, expr.Inputs[1])
+	if scale < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	writeDec(fp, decimal{unscaled: big.NewInt(ReadI64(fp, expr.Inputs[0])), scale: scale}, expr.Outputs[0])
+}
+
+func opBigParseDec(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	d, ok := parseDecimal(ReadStr(fp, expr.Inputs[0]))
+	if !ok {
+		d = decimal{unscaled: new(big.Int)}
+	}
+	writeDec(fp, d, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), ok)
+}
+
+func opBigDecString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readDec(fp, expr.Inputs[0]).String(), expr.Outputs[0])
+}
+
+func opBigDecScale(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), readDec(fp, expr.Inputs[0]).scale)
+}
+
+// readDecs reads the decimal inputs of `expr` and returns their unscaled
+// values with the largest of their scales.
+func readDecs(fp int, expr *CXExpression) (*big.Int, *big.Int, int32) {
+	x, y := readDec(fp, expr.Inputs[0]), readDec(fp, expr.Inputs[1])
+	scale := x.scale
+	if y.scale > scale {
+		scale = y.scale
+	}
+	return x.rescale(scale), y.rescale(scale), scale
+}
+
+func opBigDecAdd(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x, y, scale := readDecs(fp, expr)
+	writeDec(fp, decimal{unscaled: x.Add(x, y), scale: scale}, expr.Outputs[0])
+}
+
+func opBigDecSub(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x, y, scale := readDecs(fp, expr)
+	writeDec(fp, decimal{unscaled: x.Sub(x, y), scale: scale}, expr.Outputs[0])
+}
+
+// opBigDecMul is the exact product, whose scale is the sum of the scales.
+func opBigDecMul(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x, y := readDec(fp, expr.Inputs[0]), readDec(fp, expr.Inputs[1])
+	writeDec(fp, decimal{unscaled: new(big.Int).Mul(x.unscaled, y.unscaled), scale: x.scale + y.scale}, expr.Outputs[0])
+}
+
+// opBigDecQuo is the quotient rounded half away from zero to the scale
+// given by the third input.
+func opBigDecQuo(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x, y := readDec(fp, expr.Inputs[0]), readDec(fp, expr.Inputs[1])
+	scale := ReadI32(fp, expr.Inputs[2])
+	if scale < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+
+	// x / y = (ux / 10^sx) / (uy / 10^sy) = (ux * 10^(sy+scale)) / (uy * 10^sx) / 10^scale
+	num := new(big.Int).Mul(x.unscaled, pow10(y.scale+scale))
+	den := new(big.Int).Mul(y.unscaled, pow10(x.scale))
+	writeDec(fp, decimal{unscaled: quoRound(num, den), scale: scale}, expr.Outputs[0])
+}
+
+// opBigDecRound rounds the decimal half away from zero to the scale given by
+// the second input.
+func opBigDecRound(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDec(fp, readDec(fp, expr.Inputs[0]).round(ReadI32(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opBigDecNeg(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	d := readDec(fp, expr.Inputs[0])
+	writeDec(fp, decimal{unscaled: d.unscaled.Neg(d.unscaled), scale: d.scale}, expr.Outputs[0])
+}
+
+func opBigDecAbs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	d := readDec(fp, expr.Inputs[0])
+	writeDec(fp, decimal{unscaled: d.unscaled.Abs(d.unscaled), scale: d.scale}, expr.Outputs[0])
+}
+
+// opBigDecCmp compares the values of the decimals, regardless of their
+// scales.
+func opBigDecCmp(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	x, y, _ := readDecs(fp, expr)
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(x.Cmp(y)))
+}
+
+func opBigDecSign(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(readDec(fp, expr.Inputs[0]).unscaled.Sign()))
+}
+
+// opBigDecInt converts the decimal to an integer, truncating it towards
+// zero.
+func opBigDecInt(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	d := readDec(fp, expr.Inputs[0])
+	writeInt(fp, new(big.Int).Quo(d.unscaled, pow10(d.scale)), expr.Outputs[0])
+}
+
+// opBigDecF64 converts the decimal to the nearest f64.
+func opBigDecF64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	d := readDec(fp, expr.Inputs[0])
+	f, _ := new(big.Rat).SetFrac(d.unscaled, pow10(d.scale)).Float64()
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), f)
+}
@@ -0,0 +1,172 @@
+// +build base
+
+package cxcore
+
+import (
+	"encoding/binary"
+	"math"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// Byte orders, as in `binary.PutUI32(binary.BIG_ENDIAN, b, 0, v)`.
+const (
+	BINARY_BIG_ENDIAN = iota
+	BINARY_LITTLE_ENDIAN
+)
+
+func init() {
+	RegisterPackage("binary")
+}
+
+// The functions of the `binary` package read and write numbers at an offset
+// of a []ui8 slice, which must be long enough. Slices of bytes are read from
+// and written to `os` files with os.ReadUI8Slice and os.WriteUI8Slice.
+
+func binaryOrder(fp int, inp *CXArgument) binary.ByteOrder {
+	switch ReadI32(fp, inp) {
+	case BINARY_BIG_ENDIAN:
+		return binary.BigEndian
+	case BINARY_LITTLE_ENDIAN:
+		return binary.LittleEndian
+	}
+	panic(CX_RUNTIME_INVALID_ARGUMENT)
+}
+
+// binaryBytes returns the `size` bytes of the slice `inp1` at the offset `inp2`.
+func binaryBytes(fp int, inp1, inp2 *CXArgument, size int) []byte {
+	data := ReadSliceBytes(fp, inp1, TYPE_UI8)
+	off := int(ReadI32(fp, inp2))
+	if off < 0 || off+size > len(data) {
+		panic(CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE)
+	}
+	return data[off : off+size]
+}
+
+func opBinaryUI16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 2)
+	WriteUI16(GetFinalOffset(fp, expr.Outputs[0]), binaryOrder(fp, expr.Inputs[0]).Uint16(b))
+}
+
+func opBinaryUI32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 4)
+	WriteUI32(GetFinalOffset(fp, expr.Outputs[0]), binaryOrder(fp, expr.Inputs[0]).Uint32(b))
+}
+
+func opBinaryUI64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 8)
+	WriteUI64(GetFinalOffset(fp, expr.Outputs[0]), binaryOrder(fp, expr.Inputs[0]).Uint64(b))
+}
+
+func opBinaryI16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 2)
+	WriteI16(GetFinalOffset(fp, expr.Outputs[0]), int16(binaryOrder(fp, expr.Inputs[0]).Uint16(b)))
+}
+
+func opBinaryI32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 4)
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(binaryOrder(fp, expr.Inputs[0]).Uint32(b)))
+}
+
+func opBinaryI64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 8)
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), int64(binaryOrder(fp, expr.Inputs[0]).Uint64(b)))
+}
+
+func opBinaryF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 4)
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), math.Float32frombits(binaryOrder(fp, expr.Inputs[0]).Uint32(b)))
+}
+
+func opBinaryF64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 8)
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Float64frombits(binaryOrder(fp, expr.Inputs[0]).Uint64(b)))
+}
+
+func opBinaryPutUI16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 2)
+	binaryOrder(fp, expr.Inputs[0]).PutUint16(b, ReadUI16(fp, expr.Inputs[3]))
+}
+
+func opBinaryPutUI32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 4)
+	binaryOrder(fp, expr.Inputs[0]).PutUint32(b, ReadUI32(fp, expr.Inputs[3]))
+}
+
+func opBinaryPutUI64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 8)
+	binaryOrder(fp, expr.Inputs[0]).PutUint64(b, ReadUI64(fp, expr.Inputs[3]))
+}
+
+func opBinaryPutI16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 2)
+	binaryOrder(fp, expr.Inputs[0]).PutUint16(b, uint16(ReadI16(fp, expr.Inputs[3])))
+}
+
+func opBinaryPutI32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 4)
+	binaryOrder(fp, expr.Inputs[0]).PutUint32(b, uint32(ReadI32(fp, expr.Inputs[3])))
+}
+
+func opBinaryPutI64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 8)
+	binaryOrder(fp, expr.Inputs[0]).PutUint64(b, uint64(ReadI64(fp, expr.Inputs[3])))
+}
+
+func opBinaryPutF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 4)
+	binaryOrder(fp, expr.Inputs[0]).PutUint32(b, math.Float32bits(ReadF32(fp, expr.Inputs[3])))
+}
+
+func opBinaryPutF64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	b := binaryBytes(fp, expr.Inputs[1], expr.Inputs[2], 8)
+	binaryOrder(fp, expr.Inputs[0]).PutUint64(b, math.Float64bits(ReadF64(fp, expr.Inputs[3])))
+}
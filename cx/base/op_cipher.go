@@ -4,36 +4,90 @@ package cxcore
 
 import (
 	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/cipher/base58"
 
 	. "github.com/skycoin/cx/cx"
 )
 
 func init() {
 	cipherPkg := MakePackage("cipher")
-	pubkeyStrct := MakeStruct("PubKey")
-	seckeyStrct := MakeStruct("SecKey")
 
-	// PubKey
-	pubkeyFld := MakeArgument("PubKey", "", -1).AddType(TypeNames[TYPE_UI8]).AddPackage(cipherPkg)
-	pubkeyFld.DeclarationSpecifiers = append(pubkeyFld.DeclarationSpecifiers, DECL_ARRAY)
-	pubkeyFld.IsArray = true
-	pubkeyFld.Lengths = []int{33} // Yes, PubKey is 33 bytes long.
-	pubkeyFld.TotalSize = 33      // 33 * 1 byte (ui8)
+	// PubKey, which is 33 bytes long.
+	cipherPkg.AddStruct(cipherBytesStruct(cipherPkg, "PubKey", len(cipher.PubKey{})))
+	// SecKey, which is 32 bytes long.
+	cipherPkg.AddStruct(cipherBytesStruct(cipherPkg, "SecKey", len(cipher.SecKey{})))
+	// Sig, a recoverable signature of 65 bytes.
+	cipherPkg.AddStruct(cipherBytesStruct(cipherPkg, "Sig", len(cipher.Sig{})))
+	// SHA256 and Ripemd160 hashes.
+	cipherPkg.AddStruct(cipherBytesStruct(cipherPkg, "SHA256", len(cipher.SHA256{})))
+	cipherPkg.AddStruct(cipherBytesStruct(cipherPkg, "Ripemd160", len(cipher.Ripemd160{})))
 
-	// SecKey
-	seckeyFld := MakeArgument("SecKey", "", -1).AddType(TypeNames[TYPE_UI8]).AddPackage(cipherPkg)
-	seckeyFld.DeclarationSpecifiers = append(seckeyFld.DeclarationSpecifiers, DECL_ARRAY)
-	seckeyFld.IsArray = true
-	seckeyFld.Lengths = []int{32} // Yes, SecKey is 32 bytes long.
-	seckeyFld.TotalSize = 33      // 33 * 1 byte (ui8)
+	// Address, a version byte followed by the Ripemd160 hash of a PubKey.
+	addressStrct := MakeStruct("Address")
+	versionFld := MakeArgument("Version", "", -1).AddType(TypeNames[TYPE_UI8]).AddPackage(cipherPkg)
+	addressStrct.AddField(versionFld)
+	addressStrct.AddField(cipherBytesField(cipherPkg, "Key", len(cipher.Ripemd160{})))
+	cipherPkg.AddStruct(addressStrct)
 
-	pubkeyStrct.AddField(pubkeyFld)
-	seckeyStrct.AddField(seckeyFld)
+	PROGRAM.AddPackage(cipherPkg)
+}
 
-	cipherPkg.AddStruct(pubkeyStrct)
-	cipherPkg.AddStruct(seckeyStrct)
+// cipherBytesField returns a field `name` of type [length]ui8.
+func cipherBytesField(pkg *CXPackage, name string, length int) *CXArgument {
+	fld := MakeArgument(name, "", -1).AddType(TypeNames[TYPE_UI8]).AddPackage(pkg)
+	fld.DeclarationSpecifiers = append(fld.DeclarationSpecifiers, DECL_ARRAY)
+	fld.IsArray = true
+	fld.Lengths = []int{length}
+	fld.TotalSize = length // length * 1 byte (ui8)
+	return fld
+}
 
-	PROGRAM.AddPackage(cipherPkg)
+// cipherBytesStruct returns a struct `name` with a single field of the same
+// name holding the bytes of the cipher type, as `PubKey.PubKey`.
+func cipherBytesStruct(pkg *CXPackage, name string, length int) *CXStruct {
+	strct := MakeStruct(name)
+	strct.AddField(cipherBytesField(pkg, name, length))
+	return strct
+}
+
+// readCipherBytes copies the bytes of the cipher struct `arg` to `dst`,
+// which must have the size of the struct.
+func readCipherBytes(fp int, arg *CXArgument, dst []byte) {
+	copy(dst, ReadMemory(GetFinalOffset(fp, arg), arg))
+}
+
+func readPubKey(fp int, arg *CXArgument) (pubKey cipher.PubKey) {
+	readCipherBytes(fp, arg, pubKey[:])
+	return pubKey
+}
+
+func readSecKey(fp int, arg *CXArgument) (secKey cipher.SecKey) {
+	readCipherBytes(fp, arg, secKey[:])
+	return secKey
+}
+
+func readSig(fp int, arg *CXArgument) (sig cipher.Sig) {
+	readCipherBytes(fp, arg, sig[:])
+	return sig
+}
+
+func readSHA256(fp int, arg *CXArgument) (hash cipher.SHA256) {
+	readCipherBytes(fp, arg, hash[:])
+	return hash
+}
+
+func readAddress(fp int, arg *CXArgument) (addr cipher.Address) {
+	b := ReadMemory(GetFinalOffset(fp, arg), arg)
+	addr.Version = b[0]
+	copy(addr.Key[:], b[1:])
+	return addr
+}
+
+func writeAddress(fp int, addr cipher.Address, out *CXArgument) {
+	b := make([]byte, 1+len(addr.Key))
+	b[0] = addr.Version
+	copy(b[1:], addr.Key[:])
+	WriteMemory(GetFinalOffset(fp, out), b)
 }
 
 // opCipherGenerateKeyPair generates a PubKey and a SecKey.
@@ -44,17 +98,242 @@ func opCipherGenerateKeyPair(prgrm *CXProgram) {
 
 	pubKey, secKey := cipher.GenerateKeyPair()
 
-	bPubKey := make([]byte, len(pubKey))
-	bSecKey := make([]byte, len(secKey))
+	WriteMemory(GetFinalOffset(fp, out1), pubKey[:])
+	WriteMemory(GetFinalOffset(fp, out2), secKey[:])
+}
 
-	// Copying bytes
-	for i, byt := range pubKey {
-		bPubKey[i] = byt
-	}
-	for i, byt := range secKey {
-		bSecKey[i] = byt
-	}
+// opCipherGenerateDeterministicKeyPair generates the PubKey and SecKey of a
+// seed. The same seed always returns the same keys.
+func opCipherGenerateDeterministicKeyPair(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	pubKey, secKey, err := cipher.GenerateDeterministicKeyPair(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), pubKey[:])
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[1]), secKey[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[2]), err == nil)
+}
+
+// opCipherDeterministicKeyPairIterator generates the keys of a seed and the
+// seed of the next keys of a sequence.
+func opCipherDeterministicKeyPairIterator(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	seed, pubKey, secKey, err := cipher.DeterministicKeyPairIterator(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8))
+
+	WriteSliceData(fp, seed, 1, expr.Outputs[0])
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[1]), pubKey[:])
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[2]), secKey[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[3]), err == nil)
+}
+
+func opCipherPubKeyFromSecKey(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	pubKey, err := cipher.PubKeyFromSecKey(readSecKey(fp, expr.Inputs[0]))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), pubKey[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opCipherPubKeyHex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readPubKey(fp, expr.Inputs[0]).Hex(), expr.Outputs[0])
+}
+
+func opCipherPubKeyFromHex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	pubKey, err := cipher.PubKeyFromHex(ReadStr(fp, expr.Inputs[0]))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), pubKey[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opCipherSecKeyHex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readSecKey(fp, expr.Inputs[0]).Hex(), expr.Outputs[0])
+}
+
+func opCipherSecKeyFromHex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	secKey, err := cipher.SecKeyFromHex(ReadStr(fp, expr.Inputs[0]))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), secKey[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opCipherSumSHA256(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	hash := cipher.SumSHA256(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8))
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), hash[:])
+}
+
+// opCipherDoubleSHA256 returns SHA256(SHA256(data)).
+func opCipherDoubleSHA256(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	hash := cipher.DoubleSHA256(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8))
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), hash[:])
+}
+
+func opCipherHashRipemd160(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	hash := cipher.HashRipemd160(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8))
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), hash[:])
+}
+
+func opCipherSHA256Hex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
 
-	WriteMemory(GetFinalOffset(fp, out1), bPubKey)
-	WriteMemory(GetFinalOffset(fp, out2), bSecKey)
+	WriteString(fp, readSHA256(fp, expr.Inputs[0]).Hex(), expr.Outputs[0])
+}
+
+func opCipherSHA256FromHex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	hash, err := cipher.SHA256FromHex(ReadStr(fp, expr.Inputs[0]))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), hash[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opCipherSignHash signs a hash with a SecKey.
+func opCipherSignHash(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	sig, err := cipher.SignHash(readSHA256(fp, expr.Inputs[0]), readSecKey(fp, expr.Inputs[1]))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), sig[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opCipherVerifySignature reports whether a hash was signed by the SecKey of
+// a PubKey.
+func opCipherVerifySignature(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := cipher.VerifyPubKeySignedHash(readPubKey(fp, expr.Inputs[0]), readSig(fp, expr.Inputs[1]), readSHA256(fp, expr.Inputs[2]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opCipherVerifyAddressSignature reports whether a hash was signed by the
+// SecKey of an Address.
+func opCipherVerifyAddressSignature(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := cipher.VerifyAddressSignedHash(readAddress(fp, expr.Inputs[0]), readSig(fp, expr.Inputs[1]), readSHA256(fp, expr.Inputs[2]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opCipherPubKeyFromSig recovers the PubKey that signed a hash.
+func opCipherPubKeyFromSig(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	pubKey, err := cipher.PubKeyFromSig(readSig(fp, expr.Inputs[0]), readSHA256(fp, expr.Inputs[1]))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), pubKey[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opCipherSigHex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readSig(fp, expr.Inputs[0]).Hex(), expr.Outputs[0])
+}
+
+func opCipherSigFromHex(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	sig, err := cipher.SigFromHex(ReadStr(fp, expr.Inputs[0]))
+
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), sig[:])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opCipherAddressFromPubKey(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeAddress(fp, cipher.AddressFromPubKey(readPubKey(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opCipherAddressFromSecKey(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	addr, err := cipher.AddressFromSecKey(readSecKey(fp, expr.Inputs[0]))
+
+	writeAddress(fp, addr, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opCipherAddressString returns the base58 encoding of an Address.
+func opCipherAddressString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readAddress(fp, expr.Inputs[0]).String(), expr.Outputs[0])
+}
+
+// opCipherDecodeBase58Address decodes a base58 Address and checks its
+// checksum.
+func opCipherDecodeBase58Address(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	addr, err := cipher.DecodeBase58Address(ReadStr(fp, expr.Inputs[0]))
+
+	writeAddress(fp, addr, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opCipherVerifyAddress(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := readAddress(fp, expr.Inputs[0]).Verify(readPubKey(fp, expr.Inputs[1]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+func opCipherBase58Encode(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, base58.Encode(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8)), expr.Outputs[0])
+}
+
+func opCipherBase58Decode(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, err := base58.Decode(ReadStr(fp, expr.Inputs[0]))
+	if err != nil {
+		data = nil
+	}
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
 }
@@ -0,0 +1,54 @@
+// +build base
+
+package cxcore
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("crypto")
+}
+
+// opCryptoHMACSHA256 returns the HMAC-SHA256 of a message with a key.
+func opCryptoHMACSHA256(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	mac := hmac.New(sha256.New, ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8))
+	mac.Write(ReadSliceBytes(fp, expr.Inputs[1], TYPE_UI8))
+	WriteSliceData(fp, mac.Sum(nil), 1, expr.Outputs[0])
+}
+
+// opCryptoEqual compares two MACs in constant time.
+func opCryptoEqual(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	equal := hmac.Equal(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8), ReadSliceBytes(fp, expr.Inputs[1], TYPE_UI8))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), equal)
+}
+
+// opCryptoRandomBytes returns n bytes from the secure random number generator
+// of the system.
+func opCryptoRandomBytes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	n := ReadI32(fp, expr.Inputs[0])
+	if n < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+
+	data := make([]byte, n)
+	_, err := rand.Read(data)
+	if err != nil {
+		data = nil
+	}
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
@@ -0,0 +1,94 @@
+// +build base
+
+package cxcore
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("csv")
+}
+
+// The functions of the `csv` package receive the field delimiter, usually
+// ',', as a rune. Records can have different numbers of fields.
+
+func csvComma(fp int, inp *CXArgument) rune {
+	comma := ReadI32(fp, inp)
+	if comma == '"' || comma == '\r' || comma == '\n' || comma == utf8.RuneError || !utf8.ValidRune(comma) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return comma
+}
+
+func csvRead(r io.Reader, comma rune) ([][]string, bool) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, false
+	}
+	return rows, true
+}
+
+func csvWrite(w io.Writer, rows [][]string, comma rune) bool {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	return writer.WriteAll(rows) == nil
+}
+
+// Parse reads the records of a CSV string.
+func opCsvParse(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	rows, success := csvRead(strings.NewReader(ReadStr(fp, expr.Inputs[0])), csvComma(fp, expr.Inputs[1]))
+	WriteStringTable(fp, rows, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+// Format returns the CSV representation of records, quoting the fields as
+// needed.
+func opCsvFormat(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var buf bytes.Buffer
+	csvWrite(&buf, ReadStringTable(fp, expr.Inputs[0]), csvComma(fp, expr.Inputs[1]))
+	WriteString(fp, buf.String(), expr.Outputs[0])
+}
+
+// ReadFile reads the records of the rest of an `os` file.
+func opCsvReadFile(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	comma := csvComma(fp, expr.Inputs[1])
+	var rows [][]string
+	success := false
+	if file := ValidFile(ReadI32(fp, expr.Inputs[0])); file != nil {
+		rows, success = csvRead(file, comma)
+	}
+	WriteStringTable(fp, rows, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+// WriteFile writes records to an `os` file.
+func opCsvWriteFile(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	comma := csvComma(fp, expr.Inputs[2])
+	success := false
+	if file := ValidFile(ReadI32(fp, expr.Inputs[0])); file != nil {
+		success = csvWrite(file, ReadStringTable(fp, expr.Inputs[1]), comma)
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
@@ -0,0 +1,410 @@
+// +build base
+
+package cxcore
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("exec")
+
+	execPkg := MakePackage("exec")
+
+	statusStrct := MakeStruct("ExitStatus")
+	statusStrct.AddField(MakeArgument("Code", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(execPkg))
+	statusStrct.AddField(MakeArgument("Success", "", 0).AddType(TypeNames[TYPE_BOOL]).AddPackage(execPkg))
+	statusStrct.AddField(MakeArgument("Signaled", "", 0).AddType(TypeNames[TYPE_BOOL]).AddPackage(execPkg))
+	statusStrct.AddField(MakeArgument("Error", "", 0).AddType(TypeNames[TYPE_STR]).AddPackage(execPkg))
+	execPkg.AddStruct(statusStrct)
+
+	PROGRAM.AddPackage(execPkg)
+}
+
+// execStream collects the output of a process, which the program reads at its
+// own pace while the process runs.
+type execStream struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	buf    bytes.Buffer
+	closed bool
+}
+
+func newExecStream() *execStream {
+	s := &execStream{}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+func (s *execStream) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buf.Write(p)
+	s.cond.Broadcast()
+	return len(p), nil
+}
+
+func (s *execStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	s.cond.Broadcast()
+}
+
+// take returns the output that hasn't been read yet. If `block` is true, it
+// waits until there's some or the stream is closed. The second value is false
+// if the stream is closed and everything was read.
+func (s *execStream) take(block bool) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for block && s.buf.Len() == 0 && !s.closed {
+		s.cond.Wait()
+	}
+	out := s.buf.String()
+	s.buf.Reset()
+	return out, out != "" || !s.closed
+}
+
+type process struct {
+	cmd    *exec.Cmd
+	stdout *execStream
+	stderr *execStream
+	stdin  io.WriteCloser
+
+	started bool
+	done    chan struct{} // closed when the process is waited for
+	err     error         // the error of `Start` or `Wait`
+}
+
+var processes []*process
+var freeProcesses []int32
+
+// getProcess returns the process of the handle `arg`, which must be valid.
+func getProcess(fp int, arg *CXArgument) *process {
+	handle := ReadI32(fp, arg)
+	if handle < 0 || handle >= int32(len(processes)) || processes[handle] == nil {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return processes[handle]
+}
+
+// getProcessHandle stores `p` in the first free handle.
+func getProcessHandle(p *process) int32 {
+	if n := len(freeProcesses); n > 0 {
+		handle := freeProcesses[n-1]
+		freeProcesses = freeProcesses[:n-1]
+		processes[handle] = p
+		return handle
+	}
+	processes = append(processes, p)
+	return int32(len(processes) - 1)
+}
+
+// start starts the process and a goroutine that waits for it, so the program
+// can poll it and read its output while it runs.
+func (p *process) start() bool {
+	if p.started {
+		return false
+	}
+	p.started = true
+	if p.err = p.cmd.Start(); p.err != nil {
+		close(p.done)
+		p.stdout.close()
+		p.stderr.close()
+		return false
+	}
+	go func() {
+		p.err = p.cmd.Wait()
+		p.stdout.close()
+		p.stderr.close()
+		close(p.done)
+	}()
+	return true
+}
+
+func (p *process) running() bool {
+	if !p.started {
+		return false
+	}
+	select {
+	case <-p.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// writeExitStatus waits for the process and writes its `exec.ExitStatus`.
+// The `Code` is -1 if the process didn't exit by itself, or if it couldn't
+// be started, and `Error` says why.
+func writeExitStatus(fp int, p *process, out *CXArgument) {
+	var b [10]byte
+	code := int32(-1)
+	var signaled bool
+	var msg string
+
+	if !p.started {
+		msg = "exec: not started"
+	} else {
+		<-p.done
+		if p.cmd.ProcessState != nil {
+			code = int32(p.cmd.ProcessState.ExitCode())
+			if status, ok := p.cmd.ProcessState.Sys().(syscall.WaitStatus); ok {
+				signaled = status.Signaled()
+			}
+		}
+		if _, ok := p.err.(*exec.ExitError); p.err != nil && !ok {
+			msg = p.err.Error()
+		} else if signaled {
+			msg = p.cmd.ProcessState.String()
+		}
+	}
+
+	WriteMemI32(b[:], 0, code)
+	if code == 0 && msg == "" {
+		b[4] = 1
+	}
+	if signaled {
+		b[5] = 1
+	}
+	if msg != "" {
+		WriteMemI32(b[:], 6, int32(WriteStringObj(msg)))
+	}
+	WriteMemory(GetFinalOffset(fp, out), b[:])
+}
+
+// opExecCommand returns the handle of a process that runs a program with a
+// list of arguments, which isn't started yet. Its output is collected, to be
+// read with `exec.ReadStdout` and `exec.Stdout` and their stderr versions.
+func opExecCommand(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := &process{
+		cmd:    exec.Command(ReadStr(fp, expr.Inputs[0]), ReadStringSlice(fp, expr.Inputs[1])...),
+		stdout: newExecStream(),
+		stderr: newExecStream(),
+		done:   make(chan struct{}),
+	}
+	p.cmd.Stdout = p.stdout
+	p.cmd.Stderr = p.stderr
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), getProcessHandle(p))
+}
+
+func opExecSetDir(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getProcess(fp, expr.Inputs[0]).cmd.Dir = ReadStr(fp, expr.Inputs[1])
+}
+
+// opExecSetEnv replaces the environment of a process, which is the one of the
+// program by default, with a list of "key=value" strings.
+func opExecSetEnv(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	env := ReadStringSlice(fp, expr.Inputs[1])
+	if env == nil {
+		env = []string{}
+	}
+	getProcess(fp, expr.Inputs[0]).cmd.Env = env
+}
+
+// opExecAddEnv adds a "key=value" string to the environment of a process.
+func opExecAddEnv(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	cmd := getProcess(fp, expr.Inputs[0]).cmd
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, ReadStr(fp, expr.Inputs[1]))
+}
+
+// opExecSetStdin gives a process all of its input before it starts.
+func opExecSetStdin(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getProcess(fp, expr.Inputs[0]).cmd.Stdin = bytes.NewBufferString(ReadStr(fp, expr.Inputs[1]))
+}
+
+// opExecStdinPipe lets the program write the input of a process while it
+// runs, with `exec.WriteStdin`, until `exec.CloseStdin`. It must be called
+// before the process starts.
+func opExecStdinPipe(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := getProcess(fp, expr.Inputs[0])
+	success := false
+	if !p.started && p.stdin == nil {
+		var err error
+		p.cmd.Stdin = nil
+		if p.stdin, err = p.cmd.StdinPipe(); err == nil {
+			success = true
+		}
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+func opExecWriteStdin(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := getProcess(fp, expr.Inputs[0])
+	success := false
+	if p.stdin != nil {
+		_, err := io.WriteString(p.stdin, ReadStr(fp, expr.Inputs[1]))
+		success = err == nil
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+func opExecCloseStdin(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := getProcess(fp, expr.Inputs[0])
+	success := false
+	if p.stdin != nil {
+		success = p.stdin.Close() == nil
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+// opExecStart starts a process without waiting for it. It returns false if
+// it couldn't be started, and `exec.Wait` says why.
+func opExecStart(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), getProcess(fp, expr.Inputs[0]).start())
+}
+
+// opExecWait waits for a process to exit and returns its `exec.ExitStatus`.
+func opExecWait(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeExitStatus(fp, getProcess(fp, expr.Inputs[0]), expr.Outputs[0])
+}
+
+// opExecRun starts a process and waits for it.
+func opExecRun(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := getProcess(fp, expr.Inputs[0])
+	p.start()
+	writeExitStatus(fp, p, expr.Outputs[0])
+}
+
+// opExecRunning reports whether a process was started and hasn't exited.
+func opExecRunning(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), getProcess(fp, expr.Inputs[0]).running())
+}
+
+func opExecKill(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := getProcess(fp, expr.Inputs[0])
+	success := false
+	if p.running() {
+		success = p.cmd.Process.Kill() == nil
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+// opExecPid returns the process ID of a started process, or -1.
+func opExecPid(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := getProcess(fp, expr.Inputs[0])
+	pid := int32(-1)
+	if p.started && p.cmd.Process != nil {
+		pid = int32(p.cmd.Process.Pid)
+	}
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), pid)
+}
+
+// readStream reads an output of a process. `exec.ReadStdout` blocks until
+// there's output to read, and returns false at the end of the output, and
+// `exec.Stdout` returns what's there without waiting.
+func readStream(prgrm *CXProgram, stderr bool, block bool) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	p := getProcess(fp, expr.Inputs[0])
+	s := p.stdout
+	if stderr {
+		s = p.stderr
+	}
+	out, more := s.take(block && p.started)
+
+	WriteString(fp, out, expr.Outputs[0])
+	if block {
+		WriteBool(GetFinalOffset(fp, expr.Outputs[1]), more)
+	}
+}
+
+func opExecReadStdout(prgrm *CXProgram) {
+	readStream(prgrm, false, true)
+}
+
+func opExecReadStderr(prgrm *CXProgram) {
+	readStream(prgrm, true, true)
+}
+
+func opExecStdout(prgrm *CXProgram) {
+	readStream(prgrm, false, false)
+}
+
+func opExecStderr(prgrm *CXProgram) {
+	readStream(prgrm, true, false)
+}
+
+// opExecRelease frees the handle of a process, which is killed if it's
+// still running.
+func opExecRelease(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	handle := ReadI32(fp, expr.Inputs[0])
+	p := getProcess(fp, expr.Inputs[0])
+	if p.running() {
+		p.cmd.Process.Kill()
+		<-p.done
+	}
+	if p.stdin != nil {
+		p.stdin.Close()
+	}
+	processes[handle] = nil
+	freeProcesses = append(freeProcesses, handle)
+}
+
+// opExecLookPath searches for an executable in the directories of the PATH
+// environment variable.
+func opExecLookPath(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	path, err := exec.LookPath(ReadStr(fp, expr.Inputs[0]))
+	WriteString(fp, path, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
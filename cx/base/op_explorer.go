@@ -0,0 +1,345 @@
+// +build base
+
+package cxcore
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// The explorer package reflects the program at runtime: it lists the
+// packages and their functions, structs and globals, and it reads and writes
+// values by name. The values are exchanged as JSON, like json.Marshal and
+// json.Unmarshal encode them, so a struct field or a global can hold any
+// type and the arguments of a call can be built at runtime.
+
+func explorerPackage(name string) *CXPackage {
+	pkg, err := PROGRAM.GetPackage(name)
+	if err != nil {
+		return nil
+	}
+	return pkg
+}
+
+func explorerStruct(pkgName, name string) *CXStruct {
+	if pkg := explorerPackage(pkgName); pkg != nil {
+		if strct, err := pkg.GetStruct(name); err == nil {
+			return strct
+		}
+	}
+	return nil
+}
+
+func explorerFunction(pkgName, name string) (*CXFunction, error) {
+	if pkg := explorerPackage(pkgName); pkg != nil {
+		if fn, err := pkg.GetFunction(name); err == nil {
+			return fn, nil
+		}
+	}
+	return nil, fmt.Errorf("explorer: function %s.%s not found", pkgName, name)
+}
+
+func explorerGlobal(pkgName, name string) (*CXArgument, error) {
+	if pkg := explorerPackage(pkgName); pkg != nil {
+		if glbl, err := pkg.GetGlobal(name); err == nil {
+			return glbl, nil
+		}
+	}
+	return nil, fmt.Errorf("explorer: global %s.%s not found", pkgName, name)
+}
+
+// declJSONType returns the type of a declared field, parameter or global.
+func declJSONType(arg *CXArgument) *jsonType {
+	return newJSONType(arg.Type, arg.CustomType, arg.DeclarationSpecifiers, arg.Lengths)
+}
+
+func declTypeNames(args []*CXArgument) []string {
+	names := make([]string, len(args))
+	for i, arg := range args {
+		names[i] = declJSONType(arg).String()
+	}
+	return names
+}
+
+// explorerField returns the offset and type of the field `name` of the
+// struct of type `t` at `off`, or of the struct it points to.
+func explorerField(off int, t *jsonType, name string) (int, *jsonType, error) {
+	if t.kind == jsonKindPointer {
+		ptr := ReadMemI32(PROGRAM.Memory, off)
+		if ptr == 0 {
+			return 0, nil, fmt.Errorf("explorer: field %s of a nil %s", name, t)
+		}
+		off, t = jsonValueOffset(ptr), t.elem
+	}
+	if t.kind != jsonKindStruct {
+		return 0, nil, fmt.Errorf("explorer: value of type %s is not a struct", t)
+	}
+	fld, err := t.strct.GetField(name)
+	if err != nil {
+		return 0, nil, fmt.Errorf("explorer: struct %s has no field %s", t.strct.Name, name)
+	}
+	return off + fld.Offset, declJSONType(fld), nil
+}
+
+// explorerEncode returns the JSON encoding of the value of type `t` at `off`
+// in `mem`.
+func explorerEncode(t *jsonType, mem []byte, off int) (string, error) {
+	var e jsonEncoder
+	if err := e.encode(t, mem, off); err != nil {
+		return "", err
+	}
+	return e.buf.String(), nil
+}
+
+// explorerCall calls `fn` with the JSON encoded arguments `args`, returning
+// the JSON encoding of its results.
+func explorerCall(prgrm *CXProgram, fn *CXFunction, args []string) ([]string, error) {
+	if len(args) != len(fn.Inputs) {
+		return nil, fmt.Errorf("explorer: %s.%s takes %d arguments, not %d", fn.Package.Name, fn.Name, len(fn.Inputs), len(args))
+	}
+
+	// The arguments share their new heap objects, which are allocated at
+	// once and referenced by the frame of the call as soon as it starts.
+	var d jsonDecoder
+	imgs := make([]*jsonImage, len(args))
+	for i, arg := range fn.Inputs {
+		t := declJSONType(arg)
+		v, err := jsonParse([]byte(args[i]))
+		if err == nil {
+			imgs[i] = &jsonImage{data: make([]byte, t.size)}
+			err = d.decode(t, v, imgs[i], 0, arg.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("explorer: argument %d of %s.%s: %v", i+1, fn.Package.Name, fn.Name, err)
+		}
+	}
+	d.allocate(imgs...)
+
+	inputs := make([][]byte, len(imgs))
+	for i, img := range imgs {
+		inputs[i] = img.data
+	}
+	outputs := prgrm.Callback(fn, inputs)
+
+	// The results are encoded before anything is allocated, as nothing
+	// references their heap objects anymore.
+	results := make([]string, len(outputs))
+	for i, out := range fn.Outputs {
+		result, err := explorerEncode(declJSONType(out), outputs[i], 0)
+		if err != nil {
+			return nil, fmt.Errorf("explorer: result %d of %s.%s: %v", i+1, fn.Package.Name, fn.Name, err)
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// Packages returns the names of the packages of the program, including the
+// packages of the standard library that declare structs.
+func opExplorerPackages(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	names := make([]string, len(prgrm.Packages))
+	for i, pkg := range prgrm.Packages {
+		names[i] = pkg.Name
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// Functions returns the names of the functions of a package, which are empty
+// if the package doesn't exist. Methods are named as in "Point.Move".
+func opExplorerFunctions(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var names []string
+	if pkg := explorerPackage(ReadStr(fp, expr.Inputs[0])); pkg != nil {
+		for _, fn := range pkg.Functions {
+			if !strings.HasPrefix(fn.Name, "*") {
+				names = append(names, fn.Name)
+			}
+		}
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// Structs returns the names of the structs of a package.
+func opExplorerStructs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var names []string
+	if pkg := explorerPackage(ReadStr(fp, expr.Inputs[0])); pkg != nil {
+		for _, strct := range pkg.Structs {
+			names = append(names, strct.Name)
+		}
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// Globals returns the names of the global variables of a package.
+func opExplorerGlobals(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var names []string
+	if pkg := explorerPackage(ReadStr(fp, expr.Inputs[0])); pkg != nil {
+		for _, glbl := range pkg.Globals {
+			names = append(names, glbl.Name)
+		}
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// Fields returns the names of the fields of a struct of a package, in the
+// order they are declared.
+func opExplorerFields(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var names []string
+	if strct := explorerStruct(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])); strct != nil {
+		for _, fld := range strct.Fields {
+			names = append(names, fld.Name)
+		}
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// FieldTypes returns the types of the fields of a struct, such as "i32",
+// "[]str" or "*Point", in the order of Fields.
+func opExplorerFieldTypes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var names []string
+	if strct := explorerStruct(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])); strct != nil {
+		names = declTypeNames(strct.Fields)
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// Inputs returns the types of the parameters of a function.
+func opExplorerInputs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var names []string
+	if fn, err := explorerFunction(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])); err == nil {
+		names = declTypeNames(fn.Inputs)
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// Outputs returns the types of the results of a function.
+func opExplorerOutputs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var names []string
+	if fn, err := explorerFunction(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])); err == nil {
+		names = declTypeNames(fn.Outputs)
+	}
+	WriteStringSlice(fp, names, expr.Outputs[0])
+}
+
+// TypeOf returns the type of a value, such as "i32", "[]str" or "Point".
+// The type of `&v` is a pointer to the type of `v`.
+func opExplorerTypeOf(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	inp := expr.Inputs[0]
+	name := argJSONType(inp).String()
+	if inp.PassBy == PASSBY_REFERENCE {
+		name = "*" + name
+	}
+	WriteString(fp, name, expr.Outputs[0])
+}
+
+// GetField returns the JSON encoding of a field of a struct, or of the
+// struct a pointer points to.
+func opExplorerGetField(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var value string
+	inp := expr.Inputs[0]
+	off, t, err := explorerField(GetFinalOffset(fp, inp), argJSONType(inp), ReadStr(fp, expr.Inputs[1]))
+	if err == nil {
+		value, err = explorerEncode(t, PROGRAM.Memory, off)
+	}
+	WriteString(fp, value, expr.Outputs[0])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[1])
+}
+
+// SetField decodes a JSON value into a field of the struct pointed to by its
+// first argument, as in `explorer.SetField(&p, "X", "1")`.
+func opExplorerSetField(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	inp, name := expr.Inputs[0], ReadStr(fp, expr.Inputs[1])
+	target := func() (int, *jsonType, error) {
+		off, t, err := jsonTarget(fp, inp)
+		if err != nil {
+			return 0, nil, err
+		}
+		return explorerField(off, t, name)
+	}
+
+	_, t, err := target()
+	if err == nil {
+		err = jsonDecodeAt([]byte(ReadStr(fp, expr.Inputs[2])), t, func() int {
+			off, _, _ := target()
+			return off
+		})
+	}
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[0])
+}
+
+// GetGlobal returns the JSON encoding of a global variable of a package.
+func opExplorerGetGlobal(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var value string
+	glbl, err := explorerGlobal(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	if err == nil {
+		value, err = explorerEncode(declJSONType(glbl), PROGRAM.Memory, glbl.Offset)
+	}
+	WriteString(fp, value, expr.Outputs[0])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[1])
+}
+
+// SetGlobal decodes a JSON value into a global variable of a package.
+func opExplorerSetGlobal(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	glbl, err := explorerGlobal(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	if err == nil {
+		err = jsonDecodeAt([]byte(ReadStr(fp, expr.Inputs[2])), declJSONType(glbl), func() int {
+			return glbl.Offset
+		})
+	}
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[0])
+}
+
+// Call calls a function of a package with the JSON encoded arguments, and
+// returns the JSON encoding of its results.
+func opExplorerCall(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var results []string
+	fn, err := explorerFunction(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	if err == nil {
+		results, err = explorerCall(prgrm, fn, ReadStringSlice(fp, expr.Inputs[2]))
+	}
+	WriteStringSlice(fp, results, expr.Outputs[0])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[1])
+}
@@ -0,0 +1,144 @@
+// +build base
+
+package cxcore
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("filepath")
+}
+
+// opFilepathJoin joins two paths with a separator and cleans the result.
+// Longer paths are joined with nested calls.
+func opFilepathJoin(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, filepath.Join(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opFilepathDir(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, filepath.Dir(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opFilepathBase(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, filepath.Base(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opFilepathExt(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, filepath.Ext(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opFilepathClean(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, filepath.Clean(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+// opFilepathSplit splits a path after its last separator, into a directory
+// and a file name.
+func opFilepathSplit(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	dir, file := filepath.Split(ReadStr(fp, expr.Inputs[0]))
+	WriteString(fp, dir, expr.Outputs[0])
+	WriteString(fp, file, expr.Outputs[1])
+}
+
+func opFilepathIsAbs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), filepath.IsAbs(ReadStr(fp, expr.Inputs[0])))
+}
+
+func opFilepathAbs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	path, err := filepath.Abs(ReadStr(fp, expr.Inputs[0]))
+	WriteString(fp, path, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opFilepathRel returns a path to the second path relative to the first one.
+func opFilepathRel(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	path, err := filepath.Rel(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	WriteString(fp, path, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opFilepathMatch reports whether a name matches a shell pattern, and whether
+// the pattern is valid.
+func opFilepathMatch(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	matched, err := filepath.Match(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), matched)
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opFilepathGlob returns the sorted paths of the files matching a shell
+// pattern, and whether the pattern is valid.
+func opFilepathGlob(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	paths, err := filepath.Glob(ReadStr(fp, expr.Inputs[0]))
+	WriteStringSlice(fp, paths, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opFilepathWalk calls a `fn(path str, isDir bool) (walk bool)` function for
+// a root path and all the files and directories under it, in lexical order.
+// If `fn` returns false for a directory, its contents are skipped. It returns
+// false if a directory couldn't be read, and stops there.
+func opFilepathWalk(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+	inp1, inp2 := expr.Inputs[0], expr.Inputs[1]
+
+	walkPkg, err := prgrm.GetPackage(inp2.Package.Name)
+	if err != nil {
+		panic(err)
+	}
+	walkFn, err := walkPkg.GetFunction(inp2.Name)
+	if err != nil {
+		panic(err)
+	}
+
+	err = filepath.Walk(ReadStr(fp, inp1), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		var p [4]byte
+		WriteMemI32(p[:], 0, int32(WriteStringObj(path)))
+		outs := prgrm.Callback(walkFn, [][]byte{p[:], FromBool(info.IsDir())})
+		if info.IsDir() && !ReadMemBool(outs[0], 0) {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
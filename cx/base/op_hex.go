@@ -0,0 +1,96 @@
+// +build base
+
+package cxcore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("hex")
+}
+
+func opHexEncode(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, hex.EncodeToString(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8)), expr.Outputs[0])
+}
+
+func opHexDecode(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, err := hex.DecodeString(ReadStr(fp, expr.Inputs[0]))
+	if err != nil {
+		data = nil
+	}
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opHexEncodeStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, hex.EncodeToString([]byte(ReadStr(fp, expr.Inputs[0]))), expr.Outputs[0])
+}
+
+func opHexDecodeStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, err := hex.DecodeString(ReadStr(fp, expr.Inputs[0]))
+	if err != nil {
+		data = nil
+	}
+	WriteString(fp, string(data), expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// Dump returns a hex dump of data, as the output of `hexdump -C`.
+func opHexDump(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, hex.Dump(ReadSliceBytes(fp, expr.Inputs[0], TYPE_UI8)), expr.Outputs[0])
+}
+
+// ReadFile decodes the rest of an `os` file, ignoring surrounding white space.
+func opHexReadFile(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var data []byte
+	success := false
+	if file := ValidFile(ReadI32(fp, expr.Inputs[0])); file != nil {
+		if text, err := ioutil.ReadAll(file); err == nil {
+			if data, err = hex.DecodeString(string(bytes.TrimSpace(text))); err == nil {
+				success = true
+			} else {
+				data = nil
+			}
+		}
+	}
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+// WriteFile encodes data and writes it to an `os` file.
+func opHexWriteFile(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	success := false
+	if file := ValidFile(ReadI32(fp, expr.Inputs[0])); file != nil {
+		text := hex.EncodeToString(ReadSliceBytes(fp, expr.Inputs[1], TYPE_UI8))
+		if _, err := file.WriteString(text); err == nil {
+			success = true
+		}
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
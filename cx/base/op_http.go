@@ -0,0 +1,868 @@
+// +build base
+
+package cxcore
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// The HTTP servers accept and read the requests in the background, but the
+// CX middleware and handler functions can only be called between the
+// expressions of the program. The server goroutines hand the calls over to
+// the program, and wait while it runs them in `http.Poll`, `http.Server.Run`
+// or `http.Server.Shutdown`.
+
+// httpExchange is a request being served. Its `id` is shared by the
+// `http.ResponseWriter` and the `http.ServerRequest` given to the functions.
+type httpExchange struct {
+	w http.ResponseWriter
+	r *http.Request
+
+	// the `id`s of the `http.Header`s of the response and the request,
+	// or 0 if they weren't used yet
+	wHeader int32
+	rHeader int32
+}
+
+// httpCall is a call to a middleware or handler function.
+type httpCall struct {
+	fn   *CXFunction
+	id   int32
+	next bool // returned by a middleware
+	done chan struct{}
+
+	// the request and its body given to a function of `http.Handle`, and
+	// the response it returned
+	r        *http.Request
+	body     string
+	response string
+}
+
+type httpServer struct {
+	srv     *http.Server
+	router  *chi.Mux
+	ln      net.Listener
+	stopped chan struct{}
+	stop    sync.Once
+	ok      bool // whether it was shut down or closed, set before `stopped` is closed
+}
+
+// httpExchangeKey is the key of the exchange `id` in the request contexts.
+type httpExchangeKey struct{}
+
+var (
+	// httpMu guards the exchanges and the headers, which the server
+	// goroutines add and release.
+	httpMu        sync.Mutex
+	httpExchanges = map[int32]*httpExchange{}
+	httpHeaders   = map[int32]http.Header{}
+	lastHTTPID    int32
+
+	httpCalls = make(chan *httpCall)
+	// httpReleased wakes up `http.Poll` when an exchange is released.
+	httpReleased = make(chan struct{}, 1)
+
+	// httpDepth is the number of middleware and handler functions being
+	// called.
+	httpDepth int
+
+	// httpRouters and httpServers are indexed by the `id`s of their
+	// `http.Router`s and `http.Server`s minus one, so the zero values
+	// aren't valid.
+	httpRouters []*chi.Mux
+	httpServers []*httpServer
+)
+
+func init() {
+	httpPkg, err := PROGRAM.GetPackage("http")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, name := range []string{"ResponseWriter", "ServerRequest", "Router", "Server", "Client"} {
+		strct := MakeStruct(name)
+		strct.AddField(MakeArgument("id", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(httpPkg))
+		httpPkg.AddStruct(strct)
+	}
+}
+
+// newHTTPHeader adds a header and returns its `id`. httpMu must be held.
+func newHTTPHeader(h http.Header) int32 {
+	lastHTTPID++
+	httpHeaders[lastHTTPID] = h
+	return lastHTTPID
+}
+
+func getHTTPHeader(fp int, arg *CXArgument) http.Header {
+	httpMu.Lock()
+	defer httpMu.Unlock()
+	h := httpHeaders[readID(fp, arg)]
+	if h == nil {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return h
+}
+
+// getHTTPExchange returns the exchange of a `http.ResponseWriter` or a
+// `http.ServerRequest`, which can only be used until the request is served.
+func getHTTPExchange(fp int, arg *CXArgument) *httpExchange {
+	httpMu.Lock()
+	defer httpMu.Unlock()
+	ex := httpExchanges[readID(fp, arg)]
+	if ex == nil {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return ex
+}
+
+// ServeHTTP adds the exchange of a request for the functions of the router,
+// and releases it with its headers when the request is served.
+func (s *httpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	httpMu.Lock()
+	lastHTTPID++
+	id := lastHTTPID
+	httpExchanges[id] = &httpExchange{w: w, r: r}
+	httpMu.Unlock()
+
+	defer func() {
+		httpMu.Lock()
+		ex := httpExchanges[id]
+		delete(httpHeaders, ex.wHeader)
+		delete(httpHeaders, ex.rHeader)
+		delete(httpExchanges, id)
+		httpMu.Unlock()
+
+		select {
+		case httpReleased <- struct{}{}:
+		default:
+		}
+	}()
+
+	s.router.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), httpExchangeKey{}, id)))
+}
+
+// callHTTP hands a call over to the program and waits until it returns. It
+// gives up if the request is canceled before the call starts.
+func callHTTP(fn *CXFunction, w http.ResponseWriter, r *http.Request) bool {
+	id := r.Context().Value(httpExchangeKey{}).(int32)
+	httpMu.Lock()
+	ex := httpExchanges[id]
+	ex.w, ex.r = w, r
+	httpMu.Unlock()
+
+	call := &httpCall{fn: fn, id: id, done: make(chan struct{})}
+	select {
+	case httpCalls <- call:
+	case <-r.Context().Done():
+		return false
+	}
+	<-call.done
+	return call.next
+}
+
+func (call *httpCall) run(prgrm *CXProgram) {
+	httpDepth++
+	if call.r != nil {
+		call.response = callHTTPHandle(prgrm, call.fn, call.r, call.body)
+	} else {
+		var id [4]byte
+		WriteMemI32(id[:], 0, call.id)
+		outs := prgrm.Callback(call.fn, [][]byte{id[:], id[:]})
+		call.next = len(outs) > 0 && ReadMemBool(outs[0], 0)
+	}
+	httpDepth--
+
+	close(call.done)
+}
+
+// callHTTPHandle calls a function of `http.Handle` with a copy of a request,
+// and returns the response that it assigned to its first input.
+func callHTTPHandle(prgrm *CXProgram, fn *CXFunction, r *http.Request, body string) string {
+	httpMu.Lock()
+	header := newHTTPHeader(r.Header)
+	httpMu.Unlock()
+	defer func() {
+		httpMu.Lock()
+		delete(httpHeaders, header)
+		httpMu.Unlock()
+	}()
+
+	requestType := httpStruct("Request")
+	urlType := httpStruct("URL")
+	strs := []string{r.Method, body, r.URL.Scheme, r.URL.Opaque, r.URL.Host, r.URL.Path, r.URL.RawPath, r.URL.RawQuery, r.URL.Fragment}
+
+	// the request, its URL and their strings are allocated at once, so the
+	// garbage collector doesn't free the first ones
+	objs := [][]byte{make([]byte, requestType.Size), make([]byte, urlType.Size)}
+	for _, str := range strs {
+		objs = append(objs, encoder.Serialize(str))
+	}
+	offs := WriteObjs(objs)
+	req, u, strOffs := objs[0], objs[1], offs[2:]
+
+	WriteMemI32(req, httpFieldOffset(requestType, "Method"), strOffs[0])
+	WriteMemI32(req, httpFieldOffset(requestType, "URL"), offs[1])
+	WriteMemI32(req, httpFieldOffset(requestType, "Header"), header)
+	WriteMemI32(req, httpFieldOffset(requestType, "Body"), strOffs[1])
+	WriteMemory(int(offs[0])+OBJECT_HEADER_SIZE, req)
+
+	WriteMemI32(u, httpFieldOffset(urlType, "Scheme"), strOffs[2])
+	WriteMemI32(u, httpFieldOffset(urlType, "Opaque"), strOffs[3])
+	WriteMemI32(u, httpFieldOffset(urlType, "Host"), strOffs[4])
+	WriteMemI32(u, httpFieldOffset(urlType, "Path"), strOffs[5])
+	WriteMemI32(u, httpFieldOffset(urlType, "RawPath"), strOffs[6])
+	WriteMemBool(u, httpFieldOffset(urlType, "ForceQuery"), r.URL.ForceQuery)
+	WriteMemI32(u, httpFieldOffset(urlType, "RawQuery"), strOffs[7])
+	WriteMemI32(u, httpFieldOffset(urlType, "Fragment"), strOffs[8])
+	WriteMemory(int(offs[1])+OBJECT_HEADER_SIZE, u)
+
+	var w, reqPtr [4]byte
+	WriteMemI32(reqPtr[:], 0, offs[0])
+
+	// the frame of the function is still there when it returns
+	fp := prgrm.StackPointer
+	prgrm.Callback(fn, [][]byte{w[:], reqPtr[:]})
+	return ReadStr(fp, fn.Inputs[0])
+}
+
+// runHTTPCalls runs the calls of the servers until `stopped` is closed.
+func runHTTPCalls(prgrm *CXProgram, stopped chan struct{}) {
+	for {
+		select {
+		case call := <-httpCalls:
+			call.run(prgrm)
+		case <-stopped:
+			return
+		}
+	}
+}
+
+// pollHTTP waits up to `d` for a call, and then runs calls until there
+// aren't any requests being served. It returns how many calls it ran.
+func pollHTTP(prgrm *CXProgram, d time.Duration) int32 {
+	timeout := time.NewTimer(d)
+	defer timeout.Stop()
+
+	select {
+	case call := <-httpCalls:
+		call.run(prgrm)
+	case <-timeout.C:
+		return 0
+	}
+
+	calls := int32(1)
+	for {
+		httpMu.Lock()
+		serving := len(httpExchanges)
+		httpMu.Unlock()
+		if serving == 0 {
+			return calls
+		}
+
+		select {
+		case call := <-httpCalls:
+			call.run(prgrm)
+			calls++
+		case <-httpReleased:
+		}
+	}
+}
+
+// finish marks a server as stopped. `ok` reports whether it was shut down
+// or closed, rather than failing to serve.
+func (s *httpServer) finish(ok bool) {
+	s.stop.Do(func() {
+		s.ok = ok
+		close(s.stopped)
+	})
+}
+
+// shutdown stops a server in the background, closing it if its requests
+// aren't served within `d`.
+func (s *httpServer) shutdown(d time.Duration) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), d)
+		defer cancel()
+		err := s.srv.Shutdown(ctx)
+		if err != nil {
+			s.srv.Close()
+		}
+		s.finish(err == nil)
+	}()
+}
+
+// newHTTPServer adds a server of the requests to `addr`, which isn't
+// started.
+func newHTTPServer(addr string, router *chi.Mux) *httpServer {
+	s := &httpServer{
+		srv:     &http.Server{Addr: addr},
+		router:  router,
+		stopped: make(chan struct{}),
+	}
+	s.srv.Handler = s
+	httpServers = append(httpServers, s)
+	return s
+}
+
+// serve accepts the requests of a server from `ln` in the background.
+func (s *httpServer) serve(ln net.Listener) {
+	s.ln = ln
+	go func() {
+		if err := s.srv.Serve(ln); err != http.ErrServerClosed {
+			s.finish(false)
+		}
+	}()
+}
+
+// httpHandler returns a handler calling a CX handler function.
+func httpHandler(fn *CXFunction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		callHTTP(fn, w, r)
+	}
+}
+
+// routeHTTP configures a router, failing with a runtime error instead of the
+// panics of chi, such as adding a middleware after the routes.
+func routeHTTP(route func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			panic(CX_RUNTIME_INVALID_ARGUMENT)
+		}
+	}()
+	route()
+}
+
+func getHTTPRouter(fp int, arg *CXArgument) *chi.Mux {
+	id := readID(fp, arg)
+	if id < 1 || int(id) > len(httpRouters) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return httpRouters[id-1]
+}
+
+func getHTTPServer(fp int, arg *CXArgument) *httpServer {
+	id := readID(fp, arg)
+	if id < 1 || int(id) > len(httpServers) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return httpServers[id-1]
+}
+
+func opHTTPHeaderGet(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPHeader(fp, expr.Inputs[0]).Get(ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opHTTPHeaderValues(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteStringSlice(fp, getHTTPHeader(fp, expr.Inputs[0]).Values(ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opHTTPHeaderSet(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getHTTPHeader(fp, expr.Inputs[0]).Set(ReadStr(fp, expr.Inputs[1]), ReadStr(fp, expr.Inputs[2]))
+}
+
+func opHTTPHeaderAdd(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getHTTPHeader(fp, expr.Inputs[0]).Add(ReadStr(fp, expr.Inputs[1]), ReadStr(fp, expr.Inputs[2]))
+}
+
+func opHTTPHeaderDel(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getHTTPHeader(fp, expr.Inputs[0]).Del(ReadStr(fp, expr.Inputs[1]))
+}
+
+// opHTTPHeaderKeys returns the sorted names of the fields of a header, in
+// their canonical form.
+func opHTTPHeaderKeys(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	h := getHTTPHeader(fp, expr.Inputs[0])
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	WriteStringSlice(fp, keys, expr.Outputs[0])
+}
+
+// opHTTPResponseWriterHeader returns the header of a response, which can be
+// changed until its status code is written.
+func opHTTPResponseWriterHeader(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	ex := getHTTPExchange(fp, expr.Inputs[0])
+	httpMu.Lock()
+	if ex.wHeader == 0 {
+		ex.wHeader = newHTTPHeader(ex.w.Header())
+	}
+	id := ex.wHeader
+	httpMu.Unlock()
+	writeID(fp, id, expr.Outputs[0])
+}
+
+// opHTTPResponseWriterWriteHeader writes the status code of a response, and
+// its header. Without it, the first write uses 200.
+func opHTTPResponseWriterWriteHeader(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getHTTPExchange(fp, expr.Inputs[0]).w.WriteHeader(int(ReadI32(fp, expr.Inputs[1])))
+}
+
+func opHTTPResponseWriterWrite(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	_, err := getHTTPExchange(fp, expr.Inputs[0]).w.Write(ReadSliceBytes(fp, expr.Inputs[1], TYPE_UI8))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+func opHTTPResponseWriterWriteStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	_, err := io.WriteString(getHTTPExchange(fp, expr.Inputs[0]).w, ReadStr(fp, expr.Inputs[1]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opHTTPError replies with a plain text error message and a status code.
+func opHTTPError(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	http.Error(getHTTPExchange(fp, expr.Inputs[0]).w, ReadStr(fp, expr.Inputs[1]), int(ReadI32(fp, expr.Inputs[2])))
+}
+
+// opHTTPRedirect replies with a redirection to a URL, which can be relative
+// to the path of the request.
+func opHTTPRedirect(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	ex := getHTTPExchange(fp, expr.Inputs[0])
+	http.Redirect(ex.w, getHTTPExchange(fp, expr.Inputs[1]).r, ReadStr(fp, expr.Inputs[2]), int(ReadI32(fp, expr.Inputs[3])))
+}
+
+func opHTTPServerRequestMethod(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.Method, expr.Outputs[0])
+}
+
+// opHTTPServerRequestURL returns the URL of a request as it was sent, such
+// as "/search?q=cx".
+func opHTTPServerRequestURL(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.RequestURI, expr.Outputs[0])
+}
+
+func opHTTPServerRequestPath(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.URL.Path, expr.Outputs[0])
+}
+
+func opHTTPServerRequestHost(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.Host, expr.Outputs[0])
+}
+
+func opHTTPServerRequestRemoteAddr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.RemoteAddr, expr.Outputs[0])
+}
+
+func opHTTPServerRequestHeader(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	ex := getHTTPExchange(fp, expr.Inputs[0])
+	httpMu.Lock()
+	if ex.rHeader == 0 {
+		ex.rHeader = newHTTPHeader(ex.r.Header)
+	}
+	id := ex.rHeader
+	httpMu.Unlock()
+	writeID(fp, id, expr.Outputs[0])
+}
+
+// opHTTPServerRequestQuery returns the first value of a query parameter, or
+// "" if there isn't any.
+func opHTTPServerRequestQuery(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.URL.Query().Get(ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opHTTPServerRequestQueryValues(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteStringSlice(fp, getHTTPExchange(fp, expr.Inputs[0]).r.URL.Query()[ReadStr(fp, expr.Inputs[1])], expr.Outputs[0])
+}
+
+// opHTTPServerRequestFormValue returns the first value of a field of a
+// form sent in the body of a request, or of a query parameter. Parsing the
+// form reads the body.
+func opHTTPServerRequestFormValue(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.FormValue(ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// opHTTPServerRequestPostFormValue is like `FormValue`, but it ignores the
+// query parameters.
+func opHTTPServerRequestPostFormValue(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getHTTPExchange(fp, expr.Inputs[0]).r.PostFormValue(ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// opHTTPServerRequestPathParam returns the value of a parameter of the
+// route pattern, such as `id` in "/items/{id}".
+func opHTTPServerRequestPathParam(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, chi.URLParam(getHTTPExchange(fp, expr.Inputs[0]).r, ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+// opHTTPServerRequestContentLength returns the length of the body of a
+// request, or -1 if it's unknown.
+func opHTTPServerRequestContentLength(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), getHTTPExchange(fp, expr.Inputs[0]).r.ContentLength)
+}
+
+// readHTTPBody reads up to `max` bytes of the body of a request. At the end
+// of the body it returns false.
+func readHTTPBody(fp int, expr *CXExpression) ([]byte, bool) {
+	max := ReadI32(fp, expr.Inputs[1])
+	if max < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	data := make([]byte, max)
+	n, err := io.ReadFull(getHTTPExchange(fp, expr.Inputs[0]).r.Body, data)
+	return data[:n], err == nil || (n > 0 && err == io.ErrUnexpectedEOF)
+}
+
+func opHTTPServerRequestRead(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, success := readHTTPBody(fp, expr)
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+func opHTTPServerRequestReadStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, success := readHTTPBody(fp, expr)
+	WriteString(fp, string(data), expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+// opHTTPServerRequestBody reads the rest of the body of a request.
+func opHTTPServerRequestBody(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, err := ioutil.ReadAll(getHTTPExchange(fp, expr.Inputs[0]).r.Body)
+	WriteString(fp, string(data), expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opHTTPNewRouter(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	httpRouters = append(httpRouters, chi.NewRouter())
+	writeID(fp, int32(len(httpRouters)), expr.Outputs[0])
+}
+
+// opHTTPRouterHandleFunc routes the requests matching a pattern to a
+// `fn(w http.ResponseWriter, r http.ServerRequest)` handler, for all the
+// methods. The patterns can have parameters, such as "/items/{id}", and end
+// with "*" to match any rest of the path.
+func opHTTPRouterHandleFunc(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	router := getHTTPRouter(fp, expr.Inputs[0])
+	pattern := ReadStr(fp, expr.Inputs[1])
+	fn := callbackFunction(prgrm, expr.Inputs[2])
+	routeHTTP(func() { router.HandleFunc(pattern, httpHandler(fn)) })
+}
+
+// opHTTPRouterMethodFunc is like `HandleFunc`, for a single method.
+func opHTTPRouterMethodFunc(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	router := getHTTPRouter(fp, expr.Inputs[0])
+	method := ReadStr(fp, expr.Inputs[1])
+	pattern := ReadStr(fp, expr.Inputs[2])
+	fn := callbackFunction(prgrm, expr.Inputs[3])
+	routeHTTP(func() { router.MethodFunc(method, pattern, httpHandler(fn)) })
+}
+
+// opHTTPRouterUse adds a `fn(w http.ResponseWriter, r http.ServerRequest)
+// (next bool)` middleware, which is called before the handlers of the
+// router, in the order they were added. If it returns false, the request
+// isn't passed on, so it should write a response. The middleware must be
+// added before the routes.
+func opHTTPRouterUse(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	router := getHTTPRouter(fp, expr.Inputs[0])
+	fn := callbackFunction(prgrm, expr.Inputs[1])
+	routeHTTP(func() {
+		router.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if callHTTP(fn, w, r) {
+					next.ServeHTTP(w, r)
+				}
+			})
+		})
+	})
+}
+
+// opHTTPRouterMount routes the requests starting with a pattern to another
+// router, which matches the rest of their paths.
+func opHTTPRouterMount(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	router := getHTTPRouter(fp, expr.Inputs[0])
+	pattern := ReadStr(fp, expr.Inputs[1])
+	sub := getHTTPRouter(fp, expr.Inputs[2])
+	routeHTTP(func() { router.Mount(pattern, sub) })
+}
+
+// opHTTPRouterNotFound sets the handler of the requests that don't match any
+// route, instead of replying with 404.
+func opHTTPRouterNotFound(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	router := getHTTPRouter(fp, expr.Inputs[0])
+	fn := callbackFunction(prgrm, expr.Inputs[1])
+	routeHTTP(func() { router.NotFound(httpHandler(fn)) })
+}
+
+// opHTTPNewServer returns a server for an address, such as "127.0.0.1:8080"
+// or ":0" for any free port, with the routes of a router.
+func opHTTPNewServer(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	newHTTPServer(ReadStr(fp, expr.Inputs[0]), getHTTPRouter(fp, expr.Inputs[1]))
+	writeID(fp, int32(len(httpServers)), expr.Outputs[0])
+}
+
+// opHTTPServerStart listens on the address of a server, and accepts its
+// requests in the background. It returns false if the server couldn't
+// listen, or was already started or stopped.
+func opHTTPServerStart(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s := getHTTPServer(fp, expr.Inputs[0])
+	select {
+	case <-s.stopped:
+		WriteBool(GetFinalOffset(fp, expr.Outputs[0]), false)
+		return
+	default:
+	}
+	if s.ln != nil {
+		WriteBool(GetFinalOffset(fp, expr.Outputs[0]), false)
+		return
+	}
+
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	if err == nil {
+		s.serve(ln)
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opHTTPServerAddr returns the address a server listens on, or "" if it
+// wasn't started.
+func opHTTPServerAddr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var addr string
+	if s := getHTTPServer(fp, expr.Inputs[0]); s.ln != nil {
+		addr = s.ln.Addr().String()
+	}
+	WriteString(fp, addr, expr.Outputs[0])
+}
+
+// opHTTPServerRun calls the functions of the requests until the server is
+// shut down or closed, such as by a handler, and returns true. It returns
+// false if the server wasn't started, or fails to serve.
+func opHTTPServerRun(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s := getHTTPServer(fp, expr.Inputs[0])
+	if s.ln == nil {
+		WriteBool(GetFinalOffset(fp, expr.Outputs[0]), false)
+		return
+	}
+	runHTTPCalls(prgrm, s.stopped)
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), s.ok)
+}
+
+// opHTTPServerShutdown stops a server from accepting requests, and calls the
+// functions of the requests being served until they are done. It returns
+// false if they took longer than a duration, and the server was closed. In
+// a middleware or a handler it doesn't wait and returns true, as its own
+// request is being served, and `Run` returns when the server stops.
+func opHTTPServerShutdown(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s := getHTTPServer(fp, expr.Inputs[0])
+	s.shutdown(readDuration(fp, expr.Inputs[1]))
+	if httpDepth > 0 {
+		WriteBool(GetFinalOffset(fp, expr.Outputs[0]), true)
+		return
+	}
+	runHTTPCalls(prgrm, s.stopped)
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), s.ok)
+}
+
+// opHTTPServerClose stops a server at once, closing its connections.
+func opHTTPServerClose(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s := getHTTPServer(fp, expr.Inputs[0])
+	err := s.srv.Close()
+	s.finish(true)
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opHTTPPoll waits up to a duration for a request, and calls the functions
+// of the requests until none are being served, so a program can serve them
+// between other work. It returns how many functions it called.
+func opHTTPPoll(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), pollHTTP(prgrm, readDuration(fp, expr.Inputs[0])))
+}
+
+// httpDefaultServer is the server of `http.Serve` and `http.ListenAndServe`,
+// closed by `http.Close`.
+var httpDefaultServer *http.Server
+
+// opHTTPHandle adds a `fn(w str, r *http.Request)` function serving the
+// requests to a pattern, which responds with the value it assigns to `w`.
+func opHTTPHandle(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	pattern := ReadStr(fp, expr.Inputs[0])
+	fn := callbackFunction(prgrm, expr.Inputs[1])
+	routeHTTP(func() {
+		http.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return
+			}
+
+			call := &httpCall{fn: fn, r: r, body: string(body), done: make(chan struct{})}
+			select {
+			case httpCalls <- call:
+			case <-r.Context().Done():
+				return
+			}
+			<-call.done
+			io.WriteString(w, call.response)
+		})
+	})
+}
+
+// serveHTTP serves the functions of `http.Handle` until the server is
+// closed, and returns why it stopped.
+func serveHTTP(prgrm *CXProgram, addr string) string {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err.Error()
+	}
+
+	srv := &http.Server{Addr: addr}
+	httpDefaultServer = srv
+	stopped := make(chan struct{})
+	go func() {
+		err = srv.Serve(ln)
+		close(stopped)
+	}()
+	runHTTPCalls(prgrm, stopped)
+	return err.Error()
+}
+
+func opHTTPServe(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, serveHTTP(prgrm, ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opHTTPListenAndServe(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, serveHTTP(prgrm, ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opHTTPClose(prgrm *CXProgram) {
+	if httpDefaultServer != nil {
+		httpDefaultServer.Close()
+	}
+}
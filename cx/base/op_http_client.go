@@ -0,0 +1,426 @@
+// +build base
+
+package cxcore
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// The requests are sent in the background, and while a program waits for a
+// response it runs the calls of its servers, so it can send requests to
+// them.
+
+var (
+	// httpClients are indexed by the `id`s of their `http.Client`s minus one,
+	// so the zero values aren't valid.
+	httpClients []*http.Client
+
+	// httpDefaultClient sends the requests of `http.Do`.
+	httpDefaultClient = &http.Client{Timeout: 30 * time.Second}
+)
+
+// httpResult is the response to a request, with its body unless it was
+// written somewhere else.
+type httpResult struct {
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// httpStruct returns a struct of the http package.
+func httpStruct(name string) *CXStruct {
+	httpPkg, err := PROGRAM.GetPackage("http")
+	if err != nil {
+		panic(err)
+	}
+	strct, err := httpPkg.GetStruct(name)
+	if err != nil {
+		panic(err)
+	}
+	return strct
+}
+
+// httpFieldOffset returns the offset of a field in a struct of the http
+// package.
+func httpFieldOffset(strct *CXStruct, name string) int {
+	fld, err := strct.GetField(name)
+	if err != nil {
+		panic(err)
+	}
+	return fld.Offset
+}
+
+// readStrField reads a `str` field of the struct `b`.
+func readStrField(b []byte, strct *CXStruct, name string) string {
+	off := ReadMemI32(b, httpFieldOffset(strct, name))
+	if off == 0 {
+		return ""
+	}
+	return ReadStringFromObject(off)
+}
+
+// readHTTPURL reads the `http.URL` at `off`.
+func readHTTPURL(off int32) string {
+	if off == 0 {
+		return ""
+	}
+	urlType := httpStruct("URL")
+	if int(off) > PROGRAM.HeapStartsAt {
+		off += OBJECT_HEADER_SIZE
+	}
+	b := PROGRAM.Memory[off : int(off)+urlType.Size]
+
+	u := url.URL{
+		Scheme:     readStrField(b, urlType, "Scheme"),
+		Opaque:     readStrField(b, urlType, "Opaque"),
+		Host:       readStrField(b, urlType, "Host"),
+		Path:       readStrField(b, urlType, "Path"),
+		RawPath:    readStrField(b, urlType, "RawPath"),
+		ForceQuery: ReadMemBool(b, httpFieldOffset(urlType, "ForceQuery")),
+		RawQuery:   readStrField(b, urlType, "RawQuery"),
+		Fragment:   readStrField(b, urlType, "Fragment"),
+	}
+	return u.String()
+}
+
+// requestHeader returns the header of an `http.Request`.
+func requestHeader(fp int, arg *CXArgument) http.Header {
+	b := ReadMemory(GetFinalOffset(fp, arg), arg)
+	id := ReadMemI32(b, httpFieldOffset(httpStruct("Request"), "Header"))
+
+	httpMu.Lock()
+	defer httpMu.Unlock()
+	h := httpHeaders[id]
+	if h == nil {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return h
+}
+
+// readHTTPRequest reads an `http.Request`. Its `RawURL` is used if it isn't
+// empty, and otherwise its `URL`.
+func readHTTPRequest(fp int, arg *CXArgument) (*http.Request, error) {
+	requestType := httpStruct("Request")
+	b := ReadMemory(GetFinalOffset(fp, arg), arg)
+
+	rawURL := readStrField(b, requestType, "RawURL")
+	if rawURL == "" {
+		rawURL = readHTTPURL(ReadMemI32(b, httpFieldOffset(requestType, "URL")))
+	}
+	req, err := http.NewRequest(readStrField(b, requestType, "Method"), rawURL, strings.NewReader(readStrField(b, requestType, "Body")))
+	if err != nil {
+		return nil, err
+	}
+
+	if id := ReadMemI32(b, httpFieldOffset(requestType, "Header")); id != 0 {
+		httpMu.Lock()
+		h := httpHeaders[id]
+		httpMu.Unlock()
+		if h == nil {
+			panic(CX_RUNTIME_INVALID_ARGUMENT)
+		}
+		req.Header = h.Clone()
+		if host := h.Get("Host"); host != "" {
+			req.Host = host
+		}
+	}
+	return req, nil
+}
+
+// writeHTTPResponse writes an `http.Response`, with new headers, and a
+// request error or "".
+func writeHTTPResponse(fp int, res httpResult, out1, out2 *CXArgument) {
+	responseType := httpStruct("Response")
+	b := make([]byte, responseType.Size)
+	if resp := res.resp; resp != nil {
+		trailers := resp.Trailer
+		if trailers == nil {
+			trailers = http.Header{}
+		}
+		httpMu.Lock()
+		header := newHTTPHeader(resp.Header)
+		trailer := newHTTPHeader(trailers)
+		httpMu.Unlock()
+
+		strs := WriteStringObjs([]string{resp.Status, resp.Proto, string(res.body)})
+		WriteMemI32(b, httpFieldOffset(responseType, "Status"), strs[0])
+		WriteMemI32(b, httpFieldOffset(responseType, "StatusCode"), int32(resp.StatusCode))
+		WriteMemI32(b, httpFieldOffset(responseType, "Proto"), strs[1])
+		WriteMemI32(b, httpFieldOffset(responseType, "ProtoMajor"), int32(resp.ProtoMajor))
+		WriteMemI32(b, httpFieldOffset(responseType, "ProtoMinor"), int32(resp.ProtoMinor))
+		WriteMemI32(b, httpFieldOffset(responseType, "Header"), header)
+		WriteMemI32(b, httpFieldOffset(responseType, "Body"), strs[2])
+		WriteMemI64(b, httpFieldOffset(responseType, "ContentLength"), resp.ContentLength)
+		WriteMemBool(b, httpFieldOffset(responseType, "Close"), resp.Close)
+		WriteMemBool(b, httpFieldOffset(responseType, "Uncompressed"), resp.Uncompressed)
+		WriteMemI32(b, httpFieldOffset(responseType, "Trailer"), trailer)
+	}
+	WriteMemory(GetFinalOffset(fp, out1), b)
+
+	var err string
+	if res.err != nil {
+		err = res.err.Error()
+	}
+	WriteString(fp, err, out2)
+}
+
+// doHTTP sends a request in the background, and runs the calls of the
+// servers until its response is read. The body is written to `w`, or kept
+// in the result if `w` is nil.
+func doHTTP(prgrm *CXProgram, client *http.Client, req *http.Request, w io.Writer) httpResult {
+	done := make(chan httpResult, 1)
+	go func() {
+		resp, err := client.Do(req)
+		if err != nil {
+			done <- httpResult{err: err}
+			return
+		}
+		defer resp.Body.Close()
+
+		var body []byte
+		if w != nil {
+			_, err = io.Copy(w, resp.Body)
+		} else {
+			body, err = ioutil.ReadAll(resp.Body)
+		}
+		done <- httpResult{resp: resp, body: body, err: err}
+	}()
+
+	for {
+		select {
+		case call := <-httpCalls:
+			call.run(prgrm)
+		case res := <-done:
+			return res
+		}
+	}
+}
+
+// sendHTTPRequest sends the `http.Request` `inp` and writes its response.
+func sendHTTPRequest(prgrm *CXProgram, client *http.Client, inp, out1, out2 *CXArgument) {
+	fp := prgrm.GetFramePointer()
+
+	req, err := readHTTPRequest(fp, inp)
+	if err != nil {
+		writeHTTPResponse(fp, httpResult{err: err}, out1, out2)
+		return
+	}
+	writeHTTPResponse(fp, doHTTP(prgrm, client, req, nil), out1, out2)
+}
+
+func getHTTPClient(fp int, arg *CXArgument) *http.Client {
+	id := readID(fp, arg)
+	if id < 1 || int(id) > len(httpClients) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return httpClients[id-1]
+}
+
+// opHTTPNewHeader returns an empty header, such as for a request that isn't
+// made by `http.NewRequest`.
+func opHTTPNewHeader(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	httpMu.Lock()
+	id := newHTTPHeader(http.Header{})
+	httpMu.Unlock()
+	writeID(fp, id, expr.Outputs[0])
+}
+
+// opHTTPNewRequest returns a request with an empty header, and an error if
+// the method or the URL aren't valid.
+func opHTTPNewRequest(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	method, rawURL, body := ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]), ReadStr(fp, expr.Inputs[2])
+	requestType := httpStruct("Request")
+	b := make([]byte, requestType.Size)
+
+	_, err := http.NewRequest(method, rawURL, nil)
+	if err == nil {
+		httpMu.Lock()
+		header := newHTTPHeader(http.Header{})
+		httpMu.Unlock()
+
+		strs := WriteStringObjs([]string{method, body, rawURL})
+		WriteMemI32(b, httpFieldOffset(requestType, "Method"), strs[0])
+		WriteMemI32(b, httpFieldOffset(requestType, "Header"), header)
+		WriteMemI32(b, httpFieldOffset(requestType, "Body"), strs[1])
+		WriteMemI32(b, httpFieldOffset(requestType, "RawURL"), strs[2])
+	}
+	WriteMemory(GetFinalOffset(fp, expr.Outputs[0]), b)
+
+	var errStr string
+	if err != nil {
+		errStr = err.Error()
+	}
+	WriteString(fp, errStr, expr.Outputs[1])
+}
+
+// opHTTPRequestSetBasicAuth sets the "Authorization" header of a request to
+// a user name and a password.
+func opHTTPRequestSetBasicAuth(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	req := http.Request{Header: requestHeader(fp, expr.Inputs[0])}
+	req.SetBasicAuth(ReadStr(fp, expr.Inputs[1]), ReadStr(fp, expr.Inputs[2]))
+}
+
+// opHTTPRequestSetBearerAuth sets the "Authorization" header of a request to
+// a bearer token.
+func opHTTPRequestSetBearerAuth(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	requestHeader(fp, expr.Inputs[0]).Set("Authorization", "Bearer "+ReadStr(fp, expr.Inputs[1]))
+}
+
+// opHTTPRequestAddCookie adds a cookie to the "Cookie" header of a request.
+func opHTTPRequestAddCookie(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	req := http.Request{Header: requestHeader(fp, expr.Inputs[0])}
+	req.AddCookie(&http.Cookie{Name: ReadStr(fp, expr.Inputs[1]), Value: ReadStr(fp, expr.Inputs[2])})
+}
+
+// opHTTPDo sends a request with a client that times out after 30 seconds,
+// and follows up to 10 redirects.
+func opHTTPDo(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+
+	sendHTTPRequest(prgrm, httpDefaultClient, expr.Inputs[0], expr.Outputs[0], expr.Outputs[1])
+}
+
+// opHTTPNewClient returns a client that keeps the cookies of the responses,
+// without a timeout, and that follows up to 10 redirects.
+func opHTTPNewClient(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		panic(err)
+	}
+	httpClients = append(httpClients, &http.Client{Jar: jar})
+	writeID(fp, int32(len(httpClients)), expr.Outputs[0])
+}
+
+// opHTTPClientSetTimeout sets the time limit of the requests of a client,
+// including reading their bodies. Zero means no limit.
+func opHTTPClientSetTimeout(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getHTTPClient(fp, expr.Inputs[0]).Timeout = readDuration(fp, expr.Inputs[1])
+}
+
+// opHTTPClientSetMaxRedirects sets how many redirects a client follows. The
+// response of the redirect after them is returned, so with 0 the client
+// doesn't follow them.
+func opHTTPClientSetMaxRedirects(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	max := int(ReadI32(fp, expr.Inputs[1]))
+	if max < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	getHTTPClient(fp, expr.Inputs[0]).CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) > max {
+			return http.ErrUseLastResponse
+		}
+		return nil
+	}
+}
+
+func opHTTPClientDo(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	sendHTTPRequest(prgrm, getHTTPClient(fp, expr.Inputs[0]), expr.Inputs[1], expr.Outputs[0], expr.Outputs[1])
+}
+
+func opHTTPClientGet(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	client := getHTTPClient(fp, expr.Inputs[0])
+	req, err := http.NewRequest("GET", ReadStr(fp, expr.Inputs[1]), nil)
+	if err != nil {
+		writeHTTPResponse(fp, httpResult{err: err}, expr.Outputs[0], expr.Outputs[1])
+		return
+	}
+	writeHTTPResponse(fp, doHTTP(prgrm, client, req, nil), expr.Outputs[0], expr.Outputs[1])
+}
+
+// opHTTPClientDownload sends a request and writes the body of its response
+// to a file as it's read, instead of to the `Body` of the response. The
+// body is written whatever the status code is.
+func opHTTPClientDownload(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	client := getHTTPClient(fp, expr.Inputs[0])
+	req, err := readHTTPRequest(fp, expr.Inputs[1])
+	if err != nil {
+		writeHTTPResponse(fp, httpResult{err: err}, expr.Outputs[0], expr.Outputs[1])
+		return
+	}
+
+	file, err := os.Create(ReadStr(fp, expr.Inputs[2]))
+	if err != nil {
+		writeHTTPResponse(fp, httpResult{err: err}, expr.Outputs[0], expr.Outputs[1])
+		return
+	}
+	res := doHTTP(prgrm, client, req, file)
+	if err := file.Close(); err != nil && res.err == nil {
+		res.err = err
+	}
+	writeHTTPResponse(fp, res, expr.Outputs[0], expr.Outputs[1])
+}
+
+// opHTTPClientSetCookie sets a cookie of a client for a URL. It returns
+// false if the URL isn't valid.
+func opHTTPClientSetCookie(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	client := getHTTPClient(fp, expr.Inputs[0])
+	u, err := url.Parse(ReadStr(fp, expr.Inputs[1]))
+	if err == nil {
+		client.Jar.SetCookies(u, []*http.Cookie{{Name: ReadStr(fp, expr.Inputs[2]), Value: ReadStr(fp, expr.Inputs[3])}})
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opHTTPClientCookie returns the value of a cookie a client sends to a URL,
+// or "" if it doesn't have it.
+func opHTTPClientCookie(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	client := getHTTPClient(fp, expr.Inputs[0])
+	name := ReadStr(fp, expr.Inputs[2])
+	var value string
+	if u, err := url.Parse(ReadStr(fp, expr.Inputs[1])); err == nil {
+		for _, c := range client.Jar.Cookies(u) {
+			if c.Name == name {
+				value = c.Value
+			}
+		}
+	}
+	WriteString(fp, value, expr.Outputs[0])
+}
@@ -0,0 +1,246 @@
+// +build base
+
+package cxcore
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/skycoin/dmsg"
+	"github.com/skycoin/dmsg/cipher"
+	"github.com/skycoin/dmsg/disc"
+	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/yamux"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// The dmsg clients keep their entries in a dmsg discovery, where they find
+// the dmsg servers that relay their streams. The discovery is an HTTP service
+// that can be served by the program itself. While an op
+// waits for dmsg, it runs the calls of the HTTP servers like the requests of
+// the HTTP clients.
+
+const (
+	// dmsgTimeout is how long the clients wait to be registered in their
+	// discovery.
+	dmsgTimeout = 30 * time.Second
+
+	// dmsgDials is how many times a stream is dialed when its session was
+	// closed, and dmsgRedial is how long it waits for a new session.
+	dmsgDials  = 5
+	dmsgRedial = 100 * time.Millisecond
+)
+
+var errDMSGTimeout = errors.New("dmsg: timed out waiting for the discovery")
+
+// dmsgTransport sends HTTP requests through the streams of a dmsg client,
+// like the transport of dmsghttp. A session with a dmsg server can be closed
+// right after it's dialed, and the client dials another, so the streams of
+// closed sessions are dialed again.
+type dmsgTransport struct {
+	c *dmsg.Client
+}
+
+// dmsgBody closes the stream of a response with its body.
+type dmsgBody struct {
+	io.ReadCloser
+	stream net.Conn
+}
+
+type dmsgClient struct {
+	c      *dmsg.Client
+	client *http.Client // sends the requests of `http.DmsgDo`
+	cancel context.CancelFunc
+}
+
+var (
+	// dmsgClients is indexed by the `id`s of the `http.DmsgClient`s minus
+	// one, so the zero value isn't valid.
+	dmsgClients []*dmsgClient
+)
+
+func init() {
+	httpPkg, err := PROGRAM.GetPackage("http")
+	if err != nil {
+		panic(err)
+	}
+
+	strct := MakeStruct("DmsgClient")
+	strct.AddField(MakeArgument("id", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(httpPkg))
+	httpPkg.AddStruct(strct)
+
+	// The errors of dmsg are returned by the ops instead.
+	logging.Disable()
+}
+
+// runDMSG runs `fn` in the background, and runs the calls of the HTTP
+// servers until it returns.
+func runDMSG(prgrm *CXProgram, fn func() error) error {
+	done := make(chan struct{})
+	var err error
+	go func() {
+		err = fn()
+		close(done)
+	}()
+	runHTTPCalls(prgrm, done)
+	return err
+}
+
+// waitDMSG waits until `ready` is closed or dmsgTimeout passes.
+func waitDMSG(ready <-chan struct{}) error {
+	timeout := time.NewTimer(dmsgTimeout)
+	defer timeout.Stop()
+
+	select {
+	case <-ready:
+		return nil
+	case <-timeout.C:
+		return errDMSGTimeout
+	}
+}
+
+func (t dmsgTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var addr dmsg.Addr
+	if err := addr.Set(req.Host); err != nil {
+		return nil, fmt.Errorf("invalid host address: %w", err)
+	}
+	if addr.Port == 0 {
+		addr.Port = 80
+	}
+
+	stream, err := t.c.DialStream(req.Context(), addr)
+	for i := 1; i < dmsgDials && (err == yamux.ErrStreamClosed || err == yamux.ErrSessionShutdown); i++ {
+		select {
+		case <-time.After(dmsgRedial):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		stream, err = t.c.DialStream(req.Context(), addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Write(stream); err != nil {
+		stream.Close()
+		return nil, err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(stream), req)
+	if err != nil {
+		stream.Close()
+		return nil, err
+	}
+	resp.Body = dmsgBody{resp.Body, stream}
+	return resp, nil
+}
+
+func (b dmsgBody) Close() error {
+	err := b.ReadCloser.Close()
+	if serr := b.stream.Close(); err == nil {
+		err = serr
+	}
+	return err
+}
+
+func getDMSGClient(fp int, arg *CXArgument) *dmsgClient {
+	id := readID(fp, arg)
+	if id < 1 || int(id) > len(dmsgClients) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return dmsgClients[id-1]
+}
+
+// opHTTPNewDmsgClient starts a dmsg client with a new key pair, which uses
+// the discovery at an address such as "http://127.0.0.1:9090". It returns
+// when the client has a session with a dmsg server, or an error.
+func opHTTPNewDmsgClient(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	pk, sk := cipher.GenerateKeyPair()
+	c := dmsg.NewClient(pk, sk, disc.NewHTTP(ReadStr(fp, expr.Inputs[0])), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	go c.Serve(ctx)
+
+	err := runDMSG(prgrm, func() error {
+		return waitDMSG(c.Ready())
+	})
+	if err != nil {
+		cancel()
+		runDMSG(prgrm, c.Close)
+		writeID(fp, 0, expr.Outputs[0])
+		WriteString(fp, err.Error(), expr.Outputs[1])
+		return
+	}
+
+	dmsgClients = append(dmsgClients, &dmsgClient{
+		c:      c,
+		client: &http.Client{Transport: dmsgTransport{c}, Timeout: httpDefaultClient.Timeout},
+		cancel: cancel,
+	})
+	writeID(fp, int32(len(dmsgClients)), expr.Outputs[0])
+	WriteString(fp, "", expr.Outputs[1])
+}
+
+// opHTTPDmsgClientPublicKey returns the hex public key of a dmsg client,
+// which is the host of the URLs of its servers.
+func opHTTPDmsgClientPublicKey(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, getDMSGClient(fp, expr.Inputs[0]).c.LocalPK().Hex(), expr.Outputs[0])
+}
+
+// opHTTPDmsgClientClose closes the sessions and the listeners of a dmsg
+// client.
+func opHTTPDmsgClientClose(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	dc := getDMSGClient(fp, expr.Inputs[0])
+	dc.cancel()
+	err := runDMSG(prgrm, dc.c.Close)
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opHTTPDmsgDo sends a request through a dmsg client, to a URL such as
+// "http://<public key>:80/path" of a server of another client.
+func opHTTPDmsgDo(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+
+	sendHTTPRequest(prgrm, getDMSGClient(prgrm.GetFramePointer(), expr.Inputs[0]).client, expr.Inputs[1], expr.Outputs[0], expr.Outputs[1])
+}
+
+// opHTTPDmsgServe listens on a port of a dmsg client, and serves the
+// requests with a router in the background. The server is like the
+// started servers of `http.NewServer`, and its address is
+// "<public key>:<port>".
+func opHTTPDmsgServe(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	dc := getDMSGClient(fp, expr.Inputs[0])
+	port := ReadI32(fp, expr.Inputs[1])
+	if port < 1 || port > 65535 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	router := getHTTPRouter(fp, expr.Inputs[2])
+
+	ln, err := dc.c.Listen(uint16(port))
+	if err != nil {
+		writeID(fp, 0, expr.Outputs[0])
+		WriteString(fp, err.Error(), expr.Outputs[1])
+		return
+	}
+	s := newHTTPServer(ln.Addr().String(), router)
+	s.serve(ln)
+	writeID(fp, int32(len(httpServers)), expr.Outputs[0])
+	WriteString(fp, "", expr.Outputs[1])
+}
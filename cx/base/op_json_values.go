@@ -0,0 +1,755 @@
+// +build base
+
+package cxcore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	. "github.com/skycoin/cx/cx"
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+)
+
+// json.Marshal and json.Unmarshal convert any CX value to and from JSON by
+// walking its memory with the type information of its declaration: struct
+// fields become object keys, slices and arrays become arrays and nil
+// pointers become null.
+
+// Naming policies for the keys of struct fields.
+const (
+	JSON_NAMES_AS_IS = iota
+	JSON_NAMES_SNAKE_CASE
+	JSON_NAMES_CAMEL_CASE
+)
+
+// Maximum nesting of values, which stops the encoding of cyclic pointers.
+const jsonMaxDepth = 1000
+
+var jsonNaming = int32(JSON_NAMES_AS_IS)
+var jsonFieldNames = map[string]string{}
+var jsonDisallowUnknownFields bool
+var jsonRequireFields bool
+
+const (
+	jsonKindBasic = iota
+	jsonKindStruct
+	jsonKindSlice
+	jsonKindArray
+	jsonKindPointer
+)
+
+// jsonType describes the memory layout of a CX value.
+type jsonType struct {
+	kind   int
+	typ    int       // Type of a basic value.
+	strct  *CXStruct // Type of a struct value.
+	length int       // Length of an array.
+	elem   *jsonType // Type of the elements of slices and arrays, and of the values pointed to.
+	size   int       // Size of the value in memory.
+}
+
+// newJSONType builds the type of a value declared with the base type `typ` or
+// `strct` and the declaration specifiers `specs`, the outermost last.
+func newJSONType(typ int, strct *CXStruct, specs []int, lengths []int) *jsonType {
+	if n := len(specs); n > 0 {
+		switch specs[n-1] {
+		case DECL_SLICE, DECL_ARRAY:
+			length := 0
+			if len(lengths) > 0 {
+				length, lengths = lengths[0], lengths[1:]
+			}
+			elem := newJSONType(typ, strct, specs[:n-1], lengths)
+			if specs[n-1] == DECL_SLICE {
+				return &jsonType{kind: jsonKindSlice, elem: elem, size: TYPE_POINTER_SIZE}
+			}
+			return &jsonType{kind: jsonKindArray, length: length, elem: elem, size: length * elem.size}
+		case DECL_POINTER:
+			return &jsonType{kind: jsonKindPointer, elem: newJSONType(typ, strct, specs[:n-1], lengths), size: TYPE_POINTER_SIZE}
+		case DECL_DEREF, DECL_INDEXING:
+			return newJSONType(typ, strct, specs[:n-1], lengths)
+		}
+	}
+	if strct != nil {
+		return &jsonType{kind: jsonKindStruct, strct: strct, size: strct.Size}
+	}
+	return &jsonType{kind: jsonKindBasic, typ: typ, size: GetArgSize(typ)}
+}
+
+// argJSONType returns the type of the value `arg` refers to, which can be an
+// element or a field of a variable. The final offset of a reference, as in
+// `&v`, is the offset of `v`, so its type is the type of `v`.
+func argJSONType(arg *CXArgument) *jsonType {
+	elt := GetAssignmentElement(arg)
+	t := newJSONType(elt.Type, elt.CustomType, elt.DeclarationSpecifiers, elt.Lengths)
+	for _, op := range elt.DereferenceOperations {
+		switch op {
+		case DEREF_ARRAY, DEREF_SLICE, DEREF_POINTER:
+			if t.elem != nil {
+				t = t.elem
+			}
+		}
+	}
+	if arg.PassBy == PASSBY_REFERENCE && t.kind == jsonKindPointer {
+		t = t.elem
+	}
+	return t
+}
+
+func (t *jsonType) String() string {
+	switch t.kind {
+	case jsonKindStruct:
+		return t.strct.Name
+	case jsonKindSlice:
+		return "[]" + t.elem.String()
+	case jsonKindArray:
+		return fmt.Sprintf("[%d]%s", t.length, t.elem.String())
+	case jsonKindPointer:
+		return "*" + t.elem.String()
+	}
+	return TypeNames[t.typ]
+}
+
+// jsonFieldKey returns the key of the struct field `fld`, which is set with
+// json.SetFieldName or derived from its name with the naming policy. An
+// empty key means the field is skipped.
+func jsonFieldKey(strct *CXStruct, fld *CXArgument) string {
+	if key, ok := jsonFieldNames[strct.Package.Name+"."+strct.Name+"."+fld.Name]; ok {
+		return key
+	}
+	if key, ok := jsonFieldNames[strct.Name+"."+fld.Name]; ok {
+		return key
+	}
+
+	switch jsonNaming {
+	case JSON_NAMES_SNAKE_CASE:
+		return jsonSnakeCase(fld.Name)
+	case JSON_NAMES_CAMEL_CASE:
+		return jsonCamelCase(fld.Name)
+	}
+	return fld.Name
+}
+
+// jsonSnakeCase converts "firstName" and "HTTPCode" to "first_name" and
+// "http_code".
+func jsonSnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prev != '_' && (unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower)) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// jsonCamelCase converts "first_name" and "Name" to "firstName" and "name".
+func jsonCamelCase(name string) string {
+	var b strings.Builder
+	for i, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		runes := []rune(part)
+		if i == 0 || b.Len() == 0 {
+			runes[0] = unicode.ToLower(runes[0])
+		} else {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		b.WriteString(string(runes))
+	}
+	return b.String()
+}
+
+// jsonValueOffset returns the offset of the value a pointer refers to.
+func jsonValueOffset(ptr int32) int {
+	if int(ptr) >= PROGRAM.HeapStartsAt {
+		return int(ptr) + OBJECT_HEADER_SIZE
+	}
+	return int(ptr)
+}
+
+// jsonEncoder writes the JSON representation of CX values in memory.
+type jsonEncoder struct {
+	buf   bytes.Buffer
+	depth int
+}
+
+// encode writes the value of type `t` at `off` in `mem`, which is the memory
+// of the program or a copy of a value. The values referenced by slices and
+// pointers are always in the memory of the program.
+func (e *jsonEncoder) encode(t *jsonType, mem []byte, off int) error {
+	if e.depth++; e.depth > jsonMaxDepth {
+		return fmt.Errorf("json: value of type %s is nested too deeply", t)
+	}
+	defer func() { e.depth-- }()
+
+	switch t.kind {
+	case jsonKindBasic:
+		return e.encodeBasic(t.typ, mem[off:off+t.size])
+	case jsonKindStruct:
+		e.buf.WriteByte('{')
+		first := true
+		for _, fld := range t.strct.Fields {
+			key := jsonFieldKey(t.strct, fld)
+			if key == "" || key == "-" {
+				continue
+			}
+			if !first {
+				e.buf.WriteByte(',')
+			}
+			first = false
+			e.encodeStr(key)
+			e.buf.WriteByte(':')
+			if err := e.encode(newJSONType(fld.Type, fld.CustomType, fld.DeclarationSpecifiers, fld.Lengths), mem, off+fld.Offset); err != nil {
+				return err
+			}
+		}
+		e.buf.WriteByte('}')
+	case jsonKindSlice:
+		// Empty slices are nil in CX, so they are encoded as `[]`.
+		e.buf.WriteByte('[')
+		if ptr := ReadMemI32(mem, off); ptr != 0 {
+			data := int(ptr) + OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE
+			for i := 0; i < int(GetSliceLen(ptr)); i++ {
+				if i > 0 {
+					e.buf.WriteByte(',')
+				}
+				if err := e.encode(t.elem, PROGRAM.Memory, data+i*t.elem.size); err != nil {
+					return err
+				}
+			}
+		}
+		e.buf.WriteByte(']')
+	case jsonKindArray:
+		e.buf.WriteByte('[')
+		for i := 0; i < t.length; i++ {
+			if i > 0 {
+				e.buf.WriteByte(',')
+			}
+			if err := e.encode(t.elem, mem, off+i*t.elem.size); err != nil {
+				return err
+			}
+		}
+		e.buf.WriteByte(']')
+	case jsonKindPointer:
+		ptr := ReadMemI32(mem, off)
+		if ptr == 0 {
+			e.buf.WriteString("null")
+			return nil
+		}
+		return e.encode(t.elem, PROGRAM.Memory, jsonValueOffset(ptr))
+	}
+	return nil
+}
+
+func (e *jsonEncoder) encodeBasic(typ int, b []byte) error {
+	var out []byte
+	switch typ {
+	case TYPE_BOOL:
+		out = strconv.AppendBool(out, ReadMemBool(b, 0))
+	case TYPE_I8:
+		out = strconv.AppendInt(out, int64(ReadMemI8(b, 0)), 10)
+	case TYPE_I16:
+		out = strconv.AppendInt(out, int64(ReadMemI16(b, 0)), 10)
+	case TYPE_I32:
+		out = strconv.AppendInt(out, int64(ReadMemI32(b, 0)), 10)
+	case TYPE_I64:
+		out = strconv.AppendInt(out, ReadMemI64(b, 0), 10)
+	case TYPE_UI8:
+		out = strconv.AppendUint(out, uint64(ReadMemUI8(b, 0)), 10)
+	case TYPE_UI16:
+		out = strconv.AppendUint(out, uint64(ReadMemUI16(b, 0)), 10)
+	case TYPE_UI32:
+		out = strconv.AppendUint(out, uint64(ReadMemUI32(b, 0)), 10)
+	case TYPE_UI64:
+		out = strconv.AppendUint(out, ReadMemUI64(b, 0), 10)
+	case TYPE_F32, TYPE_F64:
+		var f interface{}
+		var v float64
+		if typ == TYPE_F32 {
+			f32 := ReadMemF32(b, 0)
+			f, v = f32, float64(f32)
+		} else {
+			v = ReadMemF64(b, 0)
+			f = v
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("json: unsupported value: %v", v)
+		}
+		out, _ = json.Marshal(f)
+	case TYPE_STR:
+		var str string
+		if off := ReadMemI32(b, 0); off != 0 {
+			str = ReadStringFromObject(off)
+		}
+		e.encodeStr(str)
+		return nil
+	default:
+		return fmt.Errorf("json: unsupported type: %s", TypeNames[typ])
+	}
+	e.buf.Write(out)
+	return nil
+}
+
+func (e *jsonEncoder) encodeStr(str string) {
+	out, _ := json.Marshal(str)
+	e.buf.Write(out)
+}
+
+// jsonMarshal encodes the value of `inp`.
+func jsonMarshal(fp int, inp *CXArgument) ([]byte, error) {
+	var e jsonEncoder
+	t := argJSONType(inp)
+	if t.kind == jsonKindBasic && t.typ == TYPE_STR {
+		// A string literal is not stored behind a pointer.
+		e.encodeStr(ReadStr(fp, inp))
+	} else if err := e.encode(t, PROGRAM.Memory, GetFinalOffset(fp, inp)); err != nil {
+		return nil, err
+	}
+	return e.buf.Bytes(), nil
+}
+
+// jsonImage holds the bytes of decoded values before they are written to
+// memory.
+type jsonImage struct {
+	data    []byte
+	fixups  []int  // Positions of pointers relative to the new heap objects.
+	touched []bool // Bytes that were decoded, if only those are written.
+}
+
+func (img *jsonImage) touch(pos, size int) {
+	if img.touched != nil {
+		for i := pos; i < pos+size; i++ {
+			img.touched[i] = true
+		}
+	}
+}
+
+// jsonDecoder converts decoded JSON values to the memory layout of CX values.
+// All the new heap objects are allocated at once when decoding finishes, as
+// the garbage collector would free the objects allocated before the ones
+// that reference them.
+type jsonDecoder struct {
+	value jsonImage // The decoded value.
+	heap  jsonImage // The new heap objects.
+}
+
+// newObject appends a zeroed heap object of `size` bytes and returns its
+// position.
+func (d *jsonDecoder) newObject(size int) int {
+	pos := len(d.heap.data)
+	d.heap.data = append(d.heap.data, make([]byte, OBJECT_HEADER_SIZE+size)...)
+	WriteMemI32(d.heap.data, pos+OBJECT_GC_HEADER_SIZE, int32(OBJECT_HEADER_SIZE+size))
+	return pos
+}
+
+func (d *jsonDecoder) writeRef(img *jsonImage, pos int, objPos int, isNil bool) {
+	if isNil {
+		WriteMemI32(img.data, pos, 0)
+	} else {
+		WriteMemI32(img.data, pos, int32(objPos))
+		img.fixups = append(img.fixups, pos)
+	}
+	img.touch(pos, TYPE_POINTER_SIZE)
+}
+
+func jsonKindName(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	}
+	return "null"
+}
+
+func jsonTypeError(v interface{}, path string, t *jsonType) error {
+	if n, ok := v.(json.Number); ok {
+		return fmt.Errorf("json: cannot unmarshal number %s into %s of type %s", n, path, t)
+	}
+	return fmt.Errorf("json: cannot unmarshal %s into %s of type %s", jsonKindName(v), path, t)
+}
+
+// decode writes `v` as a value of type `t` at `pos` in `img`. Null values
+// leave basic values and structs unchanged, and set slices and pointers to
+// nil.
+func (d *jsonDecoder) decode(t *jsonType, v interface{}, img *jsonImage, pos int, path string) error {
+	if v == nil {
+		if t.kind == jsonKindSlice || t.kind == jsonKindPointer {
+			d.writeRef(img, pos, 0, true)
+		}
+		return nil
+	}
+
+	switch t.kind {
+	case jsonKindBasic:
+		return d.decodeBasic(t, v, img, pos, path)
+	case jsonKindStruct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		used := map[string]bool{}
+		for _, fld := range t.strct.Fields {
+			key := jsonFieldKey(t.strct, fld)
+			if key == "" || key == "-" {
+				continue
+			}
+			name := key
+			val, found := obj[key]
+			if !found {
+				for k, kv := range obj {
+					if strings.EqualFold(k, key) {
+						name, val, found = k, kv, true
+						break
+					}
+				}
+			}
+			if !found {
+				if jsonRequireFields {
+					return fmt.Errorf("json: missing field %q in %s", key, path)
+				}
+				continue
+			}
+			used[name] = true
+			fldType := newJSONType(fld.Type, fld.CustomType, fld.DeclarationSpecifiers, fld.Lengths)
+			if err := d.decode(fldType, val, img, pos+fld.Offset, t.strct.Name+"."+fld.Name); err != nil {
+				return err
+			}
+		}
+		if jsonDisallowUnknownFields {
+			for k := range obj {
+				if !used[k] {
+					return fmt.Errorf("json: unknown field %q in %s", k, path)
+				}
+			}
+		}
+	case jsonKindSlice:
+		arr, ok := v.([]interface{})
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		if len(arr) == 0 {
+			d.writeRef(img, pos, 0, true)
+			return nil
+		}
+		objPos := d.newObject(SLICE_HEADER_SIZE + len(arr)*t.elem.size)
+		WriteMemI32(d.heap.data, objPos+OBJECT_HEADER_SIZE, int32(len(arr)))
+		WriteMemI32(d.heap.data, objPos+OBJECT_HEADER_SIZE+4, int32(len(arr)))
+		data := objPos + OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE
+		for i, elem := range arr {
+			if err := d.decode(t.elem, elem, &d.heap, data+i*t.elem.size, path); err != nil {
+				return err
+			}
+		}
+		d.writeRef(img, pos, objPos, false)
+	case jsonKindArray:
+		arr, ok := v.([]interface{})
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		// Elements past the end of the JSON array are zeroed, and elements
+		// past the length of the CX array are ignored.
+		for i := 0; i < t.length; i++ {
+			elemPos := pos + i*t.elem.size
+			if i >= len(arr) {
+				copy(img.data[elemPos:elemPos+t.elem.size], make([]byte, t.elem.size))
+				img.touch(elemPos, t.elem.size)
+				continue
+			}
+			if err := d.decode(t.elem, arr[i], img, elemPos, path); err != nil {
+				return err
+			}
+		}
+	case jsonKindPointer:
+		objPos := d.newObject(t.elem.size)
+		if err := d.decode(t.elem, v, &d.heap, objPos+OBJECT_HEADER_SIZE, path); err != nil {
+			return err
+		}
+		d.writeRef(img, pos, objPos, false)
+	}
+	return nil
+}
+
+func (d *jsonDecoder) decodeBasic(t *jsonType, v interface{}, img *jsonImage, pos int, path string) error {
+	mem := img.data
+	switch t.typ {
+	case TYPE_BOOL:
+		b, ok := v.(bool)
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		WriteMemBool(mem, pos, b)
+	case TYPE_STR:
+		str, ok := v.(string)
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		strB := encoder.Serialize(str)
+		objPos := d.newObject(len(strB))
+		copy(d.heap.data[objPos+OBJECT_HEADER_SIZE:], strB)
+		d.writeRef(img, pos, objPos, false)
+		return nil
+	case TYPE_I8, TYPE_I16, TYPE_I32, TYPE_I64:
+		n, ok := v.(json.Number)
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		i, err := strconv.ParseInt(string(n), 10, t.size*8)
+		if err != nil {
+			return jsonTypeError(v, path, t)
+		}
+		switch t.typ {
+		case TYPE_I8:
+			WriteMemI8(mem, pos, int8(i))
+		case TYPE_I16:
+			WriteMemI16(mem, pos, int16(i))
+		case TYPE_I32:
+			WriteMemI32(mem, pos, int32(i))
+		default:
+			WriteMemI64(mem, pos, i)
+		}
+	case TYPE_UI8, TYPE_UI16, TYPE_UI32, TYPE_UI64:
+		n, ok := v.(json.Number)
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		u, err := strconv.ParseUint(string(n), 10, t.size*8)
+		if err != nil {
+			return jsonTypeError(v, path, t)
+		}
+		switch t.typ {
+		case TYPE_UI8:
+			WriteMemUI8(mem, pos, uint8(u))
+		case TYPE_UI16:
+			WriteMemUI16(mem, pos, uint16(u))
+		case TYPE_UI32:
+			WriteMemUI32(mem, pos, uint32(u))
+		default:
+			WriteMemUI64(mem, pos, u)
+		}
+	case TYPE_F32, TYPE_F64:
+		n, ok := v.(json.Number)
+		if !ok {
+			return jsonTypeError(v, path, t)
+		}
+		f, err := strconv.ParseFloat(string(n), t.size*8)
+		if err != nil {
+			return jsonTypeError(v, path, t)
+		}
+		if t.typ == TYPE_F32 {
+			WriteMemF32(mem, pos, float32(f))
+		} else {
+			WriteMemF64(mem, pos, f)
+		}
+	default:
+		return fmt.Errorf("json: unsupported type: %s", t)
+	}
+	img.touch(pos, t.size)
+	return nil
+}
+
+// jsonTarget returns the offset and type of the value pointed to by `inp`,
+// as in `json.Unmarshal(s, &v)`.
+func jsonTarget(fp int, inp *CXArgument) (int, *jsonType, error) {
+	t := argJSONType(inp)
+	if inp.PassBy == PASSBY_REFERENCE {
+		return GetFinalOffset(fp, inp), t, nil
+	}
+	if t.kind != jsonKindPointer {
+		return 0, nil, fmt.Errorf("json: cannot unmarshal into a value of type %s, a pointer is needed", t)
+	}
+	ptr := ReadMemI32(PROGRAM.Memory, GetFinalOffset(fp, inp))
+	if ptr == 0 {
+		return 0, nil, fmt.Errorf("json: cannot unmarshal into a nil %s", t)
+	}
+	return jsonValueOffset(ptr), t.elem, nil
+}
+
+// jsonParse parses a single JSON value, keeping its numbers as json.Number.
+func jsonParse(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("json: invalid data after top-level value")
+	}
+	return v, nil
+}
+
+// allocate writes the new heap objects to memory, and makes the references
+// to them in `imgs` point to the memory.
+func (d *jsonDecoder) allocate(imgs ...*jsonImage) {
+	var base int
+	if len(d.heap.data) > 0 {
+		base = AllocateSeq(len(d.heap.data))
+	}
+	for _, img := range append([]*jsonImage{&d.heap}, imgs...) {
+		for _, pos := range img.fixups {
+			WriteMemI32(img.data, pos, ReadMemI32(img.data, pos)+int32(base))
+		}
+	}
+	if base != 0 {
+		WriteMemory(base, d.heap.data)
+	}
+}
+
+// jsonDecodeAt decodes `data` into a value of type `t`. `target` returns the
+// offset of the value, after the allocation of the new heap objects, as the
+// garbage collector moves the value if it is on the heap.
+func jsonDecodeAt(data []byte, t *jsonType, target func() int) error {
+	v, err := jsonParse(data)
+	if err != nil {
+		return err
+	}
+
+	d := jsonDecoder{value: jsonImage{data: make([]byte, t.size), touched: make([]bool, t.size)}}
+	if err := d.decode(t, v, &d.value, 0, "value"); err != nil {
+		return err
+	}
+	d.allocate(&d.value)
+
+	off := target()
+	for i, b := range d.value.data {
+		if d.value.touched[i] {
+			PROGRAM.Memory[off+i] = b
+		}
+	}
+	return nil
+}
+
+// jsonUnmarshal decodes `data` into the value pointed to by `inp`.
+func jsonUnmarshal(fp int, data []byte, inp *CXArgument) error {
+	_, t, err := jsonTarget(fp, inp)
+	if err != nil {
+		return err
+	}
+
+	return jsonDecodeAt(data, t, func() int {
+		off, _, _ := jsonTarget(fp, inp)
+		return off
+	})
+}
+
+func jsonErrorStr(err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// Marshal returns the JSON encoding of a value.
+func opJsonMarshal(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	out, err := jsonMarshal(fp, expr.Inputs[0])
+	WriteString(fp, string(out), expr.Outputs[0])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[1])
+}
+
+// MarshalIndent is like Marshal, with each element on a new line that starts
+// with prefix and is indented with indent.
+func opJsonMarshalIndent(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var out bytes.Buffer
+	data, err := jsonMarshal(fp, expr.Inputs[0])
+	if err == nil {
+		err = json.Indent(&out, data, ReadStr(fp, expr.Inputs[1]), ReadStr(fp, expr.Inputs[2]))
+	}
+	WriteString(fp, out.String(), expr.Outputs[0])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[1])
+}
+
+// MarshalBytes is like Marshal, returning the JSON encoding as a []ui8.
+func opJsonMarshalBytes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	out, err := jsonMarshal(fp, expr.Inputs[0])
+	WriteSliceData(fp, out, 1, expr.Outputs[0])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[1])
+}
+
+// Unmarshal decodes a JSON string into the value pointed to by its second
+// argument.
+func opJsonUnmarshal(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := jsonUnmarshal(fp, []byte(ReadStr(fp, expr.Inputs[0])), expr.Inputs[1])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[0])
+}
+
+// UnmarshalBytes is like Unmarshal, decoding a []ui8.
+func opJsonUnmarshalBytes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data := append([]byte(nil), GetSliceData(GetSliceOffset(fp, expr.Inputs[0]), 1)...)
+	err := jsonUnmarshal(fp, data, expr.Inputs[1])
+	WriteString(fp, jsonErrorStr(err), expr.Outputs[0])
+}
+
+// SetNaming sets how the keys of struct fields are derived from their names,
+// with json.NAMES_AS_IS, json.NAMES_SNAKE_CASE or json.NAMES_CAMEL_CASE.
+func opJsonSetNaming(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	naming := ReadI32(fp, expr.Inputs[0])
+	if naming < JSON_NAMES_AS_IS || naming > JSON_NAMES_CAMEL_CASE {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	jsonNaming = naming
+}
+
+// SetFieldName sets the key of a field of a struct, named as in "Person" or
+// "main.Person". The field is skipped if the key is "-".
+func opJsonSetFieldName(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	strct, field := ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])
+	jsonFieldNames[strct+"."+field] = ReadStr(fp, expr.Inputs[2])
+}
+
+// DisallowUnknownFields makes Unmarshal fail on keys that do not match a
+// field of the struct being decoded.
+func opJsonDisallowUnknownFields(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	jsonDisallowUnknownFields = ReadBool(fp, expr.Inputs[0])
+}
+
+// RequireFields makes Unmarshal fail when a field of the struct being decoded
+// has no key.
+func opJsonRequireFields(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	jsonRequireFields = ReadBool(fp, expr.Inputs[0])
+}
@@ -0,0 +1,314 @@
+// +build base
+
+package cxcore
+
+import (
+	"math"
+	"math/bits"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("math")
+}
+
+// The functions of the `math` package work on f64 values, and the functions
+// suffixed with F32 on f32 values, computed as f64.
+
+func opMathTan(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Tan(ReadF64(fp, expr.Inputs[0])))
+}
+
+func opMathAtan(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Atan(ReadF64(fp, expr.Inputs[0])))
+}
+
+func opMathExp(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Exp(ReadF64(fp, expr.Inputs[0])))
+}
+
+func opMathFloor(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Floor(ReadF64(fp, expr.Inputs[0])))
+}
+
+func opMathCeil(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Ceil(ReadF64(fp, expr.Inputs[0])))
+}
+
+func opMathRound(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Round(ReadF64(fp, expr.Inputs[0])))
+}
+
+func opMathTrunc(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Trunc(ReadF64(fp, expr.Inputs[0])))
+}
+
+func opMathAtan2(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Atan2(ReadF64(fp, expr.Inputs[0]), ReadF64(fp, expr.Inputs[1])))
+}
+
+func opMathHypot(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Hypot(ReadF64(fp, expr.Inputs[0]), ReadF64(fp, expr.Inputs[1])))
+}
+
+func opMathCopysign(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Copysign(ReadF64(fp, expr.Inputs[0]), ReadF64(fp, expr.Inputs[1])))
+}
+
+func opMathInf(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.Inf(int(ReadI32(fp, expr.Inputs[0]))))
+}
+
+func opMathIsInf(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), math.IsInf(ReadF64(fp, expr.Inputs[0]), int(ReadI32(fp, expr.Inputs[1]))))
+}
+
+func opMathNaN(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), math.NaN())
+}
+
+func opMathTanF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Tan(float64(ReadF32(fp, expr.Inputs[0])))))
+}
+
+func opMathAtanF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Atan(float64(ReadF32(fp, expr.Inputs[0])))))
+}
+
+func opMathExpF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Exp(float64(ReadF32(fp, expr.Inputs[0])))))
+}
+
+func opMathFloorF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Floor(float64(ReadF32(fp, expr.Inputs[0])))))
+}
+
+func opMathCeilF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Ceil(float64(ReadF32(fp, expr.Inputs[0])))))
+}
+
+func opMathRoundF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Round(float64(ReadF32(fp, expr.Inputs[0])))))
+}
+
+func opMathTruncF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Trunc(float64(ReadF32(fp, expr.Inputs[0])))))
+}
+
+func opMathAtan2F32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Atan2(float64(ReadF32(fp, expr.Inputs[0])), float64(ReadF32(fp, expr.Inputs[1])))))
+}
+
+func opMathHypotF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Hypot(float64(ReadF32(fp, expr.Inputs[0])), float64(ReadF32(fp, expr.Inputs[1])))))
+}
+
+func opMathCopysignF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Copysign(float64(ReadF32(fp, expr.Inputs[0])), float64(ReadF32(fp, expr.Inputs[1])))))
+}
+
+func opMathInfF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.Inf(int(ReadI32(fp, expr.Inputs[0])))))
+}
+
+func opMathIsInfF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), math.IsInf(float64(ReadF32(fp, expr.Inputs[0])), int(ReadI32(fp, expr.Inputs[1]))))
+}
+
+func opMathNaNF32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF32(GetFinalOffset(fp, expr.Outputs[0]), float32(math.NaN()))
+}
+
+// The bit helpers of the `math` package are suffixed with the width of their
+// unsigned operands, as in Go's math/bits.
+
+func opMathOnesCount8(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.OnesCount8(ReadUI8(fp, expr.Inputs[0]))))
+}
+
+func opMathLeadingZeros8(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.LeadingZeros8(ReadUI8(fp, expr.Inputs[0]))))
+}
+
+func opMathTrailingZeros8(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.TrailingZeros8(ReadUI8(fp, expr.Inputs[0]))))
+}
+
+func opMathRotateLeft8(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteUI8(GetFinalOffset(fp, expr.Outputs[0]), bits.RotateLeft8(ReadUI8(fp, expr.Inputs[0]), int(ReadI32(fp, expr.Inputs[1]))))
+}
+
+func opMathOnesCount16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.OnesCount16(ReadUI16(fp, expr.Inputs[0]))))
+}
+
+func opMathLeadingZeros16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.LeadingZeros16(ReadUI16(fp, expr.Inputs[0]))))
+}
+
+func opMathTrailingZeros16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.TrailingZeros16(ReadUI16(fp, expr.Inputs[0]))))
+}
+
+func opMathRotateLeft16(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteUI16(GetFinalOffset(fp, expr.Outputs[0]), bits.RotateLeft16(ReadUI16(fp, expr.Inputs[0]), int(ReadI32(fp, expr.Inputs[1]))))
+}
+
+func opMathOnesCount32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.OnesCount32(ReadUI32(fp, expr.Inputs[0]))))
+}
+
+func opMathLeadingZeros32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.LeadingZeros32(ReadUI32(fp, expr.Inputs[0]))))
+}
+
+func opMathTrailingZeros32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.TrailingZeros32(ReadUI32(fp, expr.Inputs[0]))))
+}
+
+func opMathRotateLeft32(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteUI32(GetFinalOffset(fp, expr.Outputs[0]), bits.RotateLeft32(ReadUI32(fp, expr.Inputs[0]), int(ReadI32(fp, expr.Inputs[1]))))
+}
+
+func opMathOnesCount64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.OnesCount64(ReadUI64(fp, expr.Inputs[0]))))
+}
+
+func opMathLeadingZeros64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.LeadingZeros64(ReadUI64(fp, expr.Inputs[0]))))
+}
+
+func opMathTrailingZeros64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(bits.TrailingZeros64(ReadUI64(fp, expr.Inputs[0]))))
+}
+
+func opMathRotateLeft64(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteUI64(GetFinalOffset(fp, expr.Outputs[0]), bits.RotateLeft64(ReadUI64(fp, expr.Inputs[0]), int(ReadI32(fp, expr.Inputs[1]))))
+}
@@ -0,0 +1,387 @@
+// +build base
+
+package cxcore
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"time"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("net")
+}
+
+// netHandle is a TCP or UDP connection, a TCP listener or a UDP socket
+// made by `net.ListenPacket`. Reads on connections are buffered, so lines
+// can be read without losing the data that follows them.
+type netHandle struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	ln     net.Listener
+	pc     net.PacketConn
+	err    error // the error of the last operation
+}
+
+var netHandles []*netHandle
+var freeNetHandles []int32
+
+// getNetHandle stores `h` in the first free handle.
+func getNetHandle(h *netHandle) int32 {
+	if n := len(freeNetHandles); n > 0 {
+		handle := freeNetHandles[n-1]
+		freeNetHandles = freeNetHandles[:n-1]
+		netHandles[handle] = h
+		return handle
+	}
+	netHandles = append(netHandles, h)
+	return int32(len(netHandles) - 1)
+}
+
+// validNetHandle returns the handle `arg`, or nil if it isn't open.
+func validNetHandle(fp int, arg *CXArgument) *netHandle {
+	handle := ReadI32(fp, arg)
+	if handle >= 0 && handle < int32(len(netHandles)) {
+		return netHandles[handle]
+	}
+	return nil
+}
+
+// newConnHandle returns the handle of a connection, or -1 if there's an error.
+func newConnHandle(conn net.Conn, err error) int32 {
+	if err != nil {
+		return -1
+	}
+	return getNetHandle(&netHandle{conn: conn, reader: bufio.NewReader(conn)})
+}
+
+// netDeadline returns the time after a duration from now, or the zero time,
+// which means no deadline, if the duration is zero.
+func netDeadline(d time.Duration) time.Time {
+	if d == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(d)
+}
+
+// opNetDial connects to an address, e.g. "example.com:80", on a network,
+// "tcp" or "udp", and returns the handle of the connection, or -1.
+func opNetDial(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	conn, err := net.Dial(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), newConnHandle(conn, err))
+}
+
+// opNetDialTimeout is `net.Dial` with a time limit to connect.
+func opNetDialTimeout(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	conn, err := net.DialTimeout(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]), readDuration(fp, expr.Inputs[2]))
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), newConnHandle(conn, err))
+}
+
+// opNetListen listens for TCP connections on an address, and returns the
+// handle of the listener, or -1. With the port 0 a free port is chosen, which
+// `net.LocalAddr` returns.
+func opNetListen(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	handle := int32(-1)
+	if ln, err := net.Listen(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])); err == nil {
+		handle = getNetHandle(&netHandle{ln: ln})
+	}
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), handle)
+}
+
+// opNetAccept waits for a connection to a listener, and returns its handle,
+// or -1.
+func opNetAccept(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	handle := int32(-1)
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.ln != nil {
+		var conn net.Conn
+		conn, h.err = h.ln.Accept()
+		handle = newConnHandle(conn, h.err)
+	}
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), handle)
+}
+
+// opNetListenPacket opens a UDP socket on an address, and returns its handle,
+// or -1.
+func opNetListenPacket(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	handle := int32(-1)
+	if pc, err := net.ListenPacket(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])); err == nil {
+		handle = getNetHandle(&netHandle{pc: pc})
+	}
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), handle)
+}
+
+func opNetClose(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	success := false
+	handle := ReadI32(fp, expr.Inputs[0])
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil {
+		var err error
+		switch {
+		case h.conn != nil:
+			err = h.conn.Close()
+		case h.ln != nil:
+			err = h.ln.Close()
+		case h.pc != nil:
+			err = h.pc.Close()
+		}
+		success = err == nil
+
+		netHandles[handle] = nil
+		freeNetHandles = append(freeNetHandles, handle)
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+// opNetLocalAddr returns the local address of a handle, or an empty string.
+func opNetLocalAddr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var addr string
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil {
+		switch {
+		case h.conn != nil:
+			addr = h.conn.LocalAddr().String()
+		case h.ln != nil:
+			addr = h.ln.Addr().String()
+		case h.pc != nil:
+			addr = h.pc.LocalAddr().String()
+		}
+	}
+	WriteString(fp, addr, expr.Outputs[0])
+}
+
+// opNetRemoteAddr returns the address at the other end of a connection, or an
+// empty string.
+func opNetRemoteAddr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var addr string
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.conn != nil {
+		addr = h.conn.RemoteAddr().String()
+	}
+	WriteString(fp, addr, expr.Outputs[0])
+}
+
+// setNetDeadline sets a deadline of a connection or a UDP socket, after which
+// its reads or writes fail and `net.Timeout` is true.
+func setNetDeadline(prgrm *CXProgram, set func(h *netHandle, t time.Time) error) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	success := false
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.ln == nil {
+		success = set(h, netDeadline(readDuration(fp, expr.Inputs[1]))) == nil
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+// deadlines returns the connection of `h`, or its UDP socket.
+func (h *netHandle) deadlines() interface {
+	SetDeadline(t time.Time) error
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+} {
+	if h.conn != nil {
+		return h.conn
+	}
+	return h.pc
+}
+
+func opNetSetDeadline(prgrm *CXProgram) {
+	setNetDeadline(prgrm, func(h *netHandle, t time.Time) error { return h.deadlines().SetDeadline(t) })
+}
+
+func opNetSetReadDeadline(prgrm *CXProgram) {
+	setNetDeadline(prgrm, func(h *netHandle, t time.Time) error { return h.deadlines().SetReadDeadline(t) })
+}
+
+func opNetSetWriteDeadline(prgrm *CXProgram) {
+	setNetDeadline(prgrm, func(h *netHandle, t time.Time) error { return h.deadlines().SetWriteDeadline(t) })
+}
+
+// netRead reads at most `max` bytes from a connection, waiting until there are
+// some. It returns false at the end of the data or if there's an error.
+func netRead(fp int, expr *CXExpression) ([]byte, bool) {
+	max := ReadI32(fp, expr.Inputs[1])
+	if max < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	h := validNetHandle(fp, expr.Inputs[0])
+	if h == nil || h.conn == nil {
+		return nil, false
+	}
+	data := make([]byte, max)
+	n, err := h.reader.Read(data)
+	h.err = err
+	return data[:n], err == nil
+}
+
+func opNetRead(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, success := netRead(fp, expr)
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+func opNetReadStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	data, success := netRead(fp, expr)
+	WriteString(fp, string(data), expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+// opNetReadLine reads a line from a connection, without its "\n" or "\r\n".
+// At the end of the data it returns the rest, and false.
+func opNetReadLine(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var line string
+	success := false
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.conn != nil {
+		line, h.err = h.reader.ReadString('\n')
+		if h.err == nil {
+			success = true
+			line = line[:len(line)-1]
+			if n := len(line); n > 0 && line[n-1] == '\r' {
+				line = line[:n-1]
+			}
+		}
+	}
+	WriteString(fp, line, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), success)
+}
+
+// netWrite writes all the data to a connection.
+func netWrite(fp int, expr *CXExpression, data []byte) {
+	success := false
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.conn != nil {
+		_, h.err = h.conn.Write(data)
+		success = h.err == nil
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+func opNetWrite(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	netWrite(fp, expr, ReadSliceBytes(fp, expr.Inputs[1], TYPE_UI8))
+}
+
+func opNetWriteStr(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	netWrite(fp, expr, []byte(ReadStr(fp, expr.Inputs[1])))
+}
+
+// opNetReadFrom reads a packet of at most `max` bytes from a UDP socket, and
+// returns it with the address it came from.
+func opNetReadFrom(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	max := ReadI32(fp, expr.Inputs[1])
+	if max < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+
+	var data []byte
+	var from string
+	success := false
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.pc != nil {
+		data = make([]byte, max)
+		var n int
+		var addr net.Addr
+		n, addr, h.err = h.pc.ReadFrom(data)
+		data = data[:n]
+		if h.err == nil {
+			from = addr.String()
+			success = true
+		}
+	}
+	WriteSliceData(fp, data, 1, expr.Outputs[0])
+	WriteString(fp, from, expr.Outputs[1])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[2]), success)
+}
+
+// opNetWriteTo sends a packet from a UDP socket to an address.
+func opNetWriteTo(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	success := false
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.pc != nil {
+		var addr *net.UDPAddr
+		if addr, h.err = net.ResolveUDPAddr("udp", ReadStr(fp, expr.Inputs[2])); h.err == nil {
+			_, h.err = h.pc.WriteTo(ReadSliceBytes(fp, expr.Inputs[1], TYPE_UI8), addr)
+			success = h.err == nil
+		}
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), success)
+}
+
+// opNetError returns the error of the last operation on a handle, or an empty
+// string.
+func opNetError(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	var msg string
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil && h.err != nil {
+		msg = h.err.Error()
+	}
+	WriteString(fp, msg, expr.Outputs[0])
+}
+
+// opNetTimeout reports whether the last operation on a handle failed because
+// of its deadline.
+func opNetTimeout(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	timeout := false
+	if h := validNetHandle(fp, expr.Inputs[0]); h != nil {
+		if err, ok := h.err.(net.Error); ok && err.Timeout() {
+			timeout = true
+		}
+	}
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), timeout)
+}
+
+// opNetEOF reports whether the last read from a connection failed because the
+// other end closed it.
+func opNetEOF(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	h := validNetHandle(fp, expr.Inputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), h != nil && h.err == io.EOF)
+}
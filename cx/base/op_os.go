@@ -5,7 +5,7 @@ package cxcore
 import (
 	"bytes"
 	"encoding/binary"
-
+	"io/ioutil"
 	"math"
 	"os"
 	"os/exec"
@@ -988,3 +988,186 @@ func opOsRun(prgrm *CXProgram) {
 	WriteI32(GetFinalOffset(fp, expr.Outputs[1]), cmdError)
 	WriteObject(GetFinalOffset(fp, expr.Outputs[2]), FromStr(string(stdOutBytes)))
 }
+
+func init() {
+	osPkg := MakePackage("os")
+
+	fileInfoStrct := MakeStruct("FileInfo")
+	fileInfoStrct.AddField(MakeArgument("Name", "", 0).AddType(TypeNames[TYPE_STR]).AddPackage(osPkg))
+	fileInfoStrct.AddField(MakeArgument("Size", "", 0).AddType(TypeNames[TYPE_I64]).AddPackage(osPkg))
+	fileInfoStrct.AddField(MakeArgument("Mode", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(osPkg))
+	fileInfoStrct.AddField(MakeArgument("ModTime", "", 0).AddType(TypeNames[TYPE_I64]).AddPackage(osPkg))
+	fileInfoStrct.AddField(MakeArgument("IsDir", "", 0).AddType(TypeNames[TYPE_BOOL]).AddPackage(osPkg))
+	osPkg.AddStruct(fileInfoStrct)
+
+	PROGRAM.AddPackage(osPkg)
+}
+
+// writeFileInfo writes an `os.FileInfo`. Its `Mode` has the mode bits that fit
+// in an i32, which are all of them but `IsDir`, and its `ModTime` is in
+// nanoseconds since the Unix epoch, as `time.Unix(0L, info.ModTime)` expects.
+func writeFileInfo(fp int, info os.FileInfo, out *CXArgument) {
+	var b [25]byte
+	if info != nil {
+		WriteMemI32(b[:], 0, int32(WriteStringObj(info.Name())))
+		WriteMemI64(b[:], 4, info.Size())
+		WriteMemI32(b[:], 12, int32(info.Mode()&^os.ModeDir))
+		WriteMemI64(b[:], 16, info.ModTime().UnixNano())
+		if info.IsDir() {
+			b[24] = 1
+		}
+	}
+	WriteMemory(GetFinalOffset(fp, out), b[:])
+}
+
+// opOsStat returns the `os.FileInfo` of a file or directory.
+func opOsStat(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	info, err := CXStatFile(ReadStr(fp, expr.Inputs[0]))
+	writeFileInfo(fp, info, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opOsReadDir returns the names of the entries of a directory, sorted.
+func opOsReadDir(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	infos, err := CXReadDir(ReadStr(fp, expr.Inputs[0]))
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = info.Name()
+	}
+
+	WriteStringSlice(fp, names, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opOsMkdir(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := CXMkdir(ReadStr(fp, expr.Inputs[0]), os.FileMode(ReadI32(fp, expr.Inputs[1])))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+func opOsMkdirAll(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := CXMkdirAll(ReadStr(fp, expr.Inputs[0]), os.FileMode(ReadI32(fp, expr.Inputs[1])))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opOsRemove removes a file or an empty directory.
+func opOsRemove(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := CXRemoveFile(ReadStr(fp, expr.Inputs[0]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opOsRemoveAll removes a file or a directory and its contents. Removing a
+// path that doesn't exist succeeds.
+func opOsRemoveAll(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := CXRemoveAll(ReadStr(fp, expr.Inputs[0]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+func opOsRename(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := CXRenameFile(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opOsGetenv returns the value of an environment variable, or an empty string
+// if it isn't set.
+func opOsGetenv(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, os.Getenv(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+// opOsLookupEnv returns the value of an environment variable and whether it
+// is set.
+func opOsLookupEnv(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	value, ok := os.LookupEnv(ReadStr(fp, expr.Inputs[0]))
+	WriteString(fp, value, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), ok)
+}
+
+// opOsSetenv sets an environment variable of the program, which is also
+// seen by the commands it runs.
+func opOsSetenv(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := os.Setenv(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+func opOsUnsetenv(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	err := os.Unsetenv(ReadStr(fp, expr.Inputs[0]))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), err == nil)
+}
+
+// opOsEnviron returns the environment as "key=value" strings.
+func opOsEnviron(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteStringSlice(fp, os.Environ(), expr.Outputs[0])
+}
+
+func opOsTempDir(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, os.TempDir(), expr.Outputs[0])
+}
+
+// opOsCreateTemp creates a new file in a directory, or in the default
+// directory for temporary files if it's empty, and opens it for reading and
+// writing. Its name is made of the pattern with a random string in place of
+// its last "*", or at its end. It returns the handle of the file, or -1 if it
+// couldn't be created, and its path.
+func opOsCreateTemp(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	handle := int32(-1)
+	var name string
+	if file, err := ioutil.TempFile(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])); err == nil {
+		handle = getFileHandle(file)
+		name = file.Name()
+	}
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), handle)
+	WriteString(fp, name, expr.Outputs[1])
+}
+
+// opOsMkdirTemp creates a new directory like `os.CreateTemp` creates files,
+// and returns its path.
+func opOsMkdirTemp(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	dir, err := ioutil.TempDir(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))
+	WriteString(fp, dir, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
@@ -10,6 +10,10 @@ import (
 	cxcore "github.com/skycoin/cx/cx"
 )
 
+// regexps caches the compiled expressions by their source. A CX
+// `regexp.Regexp` only holds its source in `exp`, so it survives the garbage
+// collector and the serialization of the program like any string, and it's
+// compiled again the first time it's used after deserializing the program.
 var regexps map[string]*regexp.Regexp = make(map[string]*regexp.Regexp, 0)
 
 func init() {
@@ -23,20 +27,11 @@ func init() {
 	cxcore.PROGRAM.AddPackage(regexpPkg)
 }
 
-// regexpCompile is a helper function for `opRegexpMustCompile` and
-// `opRegexpCompile`. `regexpCompile` compiles a `regexp.Regexp` structure
-// and adds it to global `regexps`. It also writes CX structure `regexp.Regexp`.
-func regexpCompile(prgrm *cxcore.CXProgram) error {
-	expr := prgrm.GetExpr()
-	fp := prgrm.GetFramePointer()
-	inp1, out1 := expr.Inputs[0], expr.Outputs[0]
-
-	// Extracting regular expression to work with, contained in `inp1`.
-	exp := cxcore.ReadStr(fp, inp1)
-
-	// Output structure `Regexp`.
+// regexpExpArg returns an argument accessing the `exp` field of the
+// `regexp.Regexp` argument `arg`.
+func regexpExpArg(arg *cxcore.CXArgument) *cxcore.CXArgument {
 	reg := cxcore.CXArgument{}
-	err := copier.Copy(&reg, out1)
+	err := copier.Copy(&reg, arg)
 	if err != nil {
 		panic(err)
 	}
@@ -59,18 +54,53 @@ func regexpCompile(prgrm *cxcore.CXProgram) error {
 		panic(err)
 	}
 
-	// Writing the regex provided by the user to `reg`.
+	reg.Fields = append(reg.Fields[:len(reg.Fields):len(reg.Fields)], expFld)
+	return &reg
+}
+
+// compileRegexp returns the cached compiled expression of `exp`, compiling
+// it if it isn't cached.
+func compileRegexp(exp string) (*regexp.Regexp, error) {
+	if r, ok := regexps[exp]; ok {
+		return r, nil
+	}
+	r, err := regexp.Compile(exp)
+	if err != nil {
+		return nil, err
+	}
+	regexps[exp] = r
+	return r, nil
+}
+
+// getRegexp returns the compiled expression of the `regexp.Regexp` argument
+// `arg`. An expression that doesn't compile can't be used.
+func getRegexp(fp int, arg *cxcore.CXArgument) *regexp.Regexp {
+	r, err := compileRegexp(cxcore.ReadStr(fp, regexpExpArg(arg)))
+	if err != nil {
+		panic(cxcore.CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return r
+}
+
+// regexpCompile is a helper function for `opRegexpMustCompile` and
+// `opRegexpCompile`. `regexpCompile` compiles a `regexp.Regexp` structure
+// and adds it to global `regexps`. It also writes CX structure `regexp.Regexp`.
+func regexpCompile(prgrm *cxcore.CXProgram) error {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+	inp1, out1 := expr.Inputs[0], expr.Outputs[0]
+
+	// Extracting regular expression to work with, contained in `inp1`.
+	exp := cxcore.ReadStr(fp, inp1)
+
+	// Writing the regex provided by the user to the output `Regexp`.
 	// This allows us to know what `Regexp` instance the user wants to use
-	// in other parts of CX code.
-	// TODO: I don't know what would happen if the user uses the same regex
-	// in two parts of a CX program. They'll be using the same instance
-	// internally.
-	accessExp := []*cxcore.CXArgument{expFld}
-	reg.Fields = accessExp
-	cxcore.WriteString(fp, exp, &reg)
+	// in other parts of CX code. The same regex used in two parts of a CX
+	// program shares its instance, as a `Regexp` is safe to reuse.
+	cxcore.WriteString(fp, exp, regexpExpArg(out1))
 
 	// Storing `Regexp` instance.
-	regexps[exp], err = regexp.Compile(exp)
+	_, err := compileRegexp(exp)
 
 	return err
 }
@@ -86,7 +116,7 @@ func opRegexpMustCompile(prgrm *cxcore.CXProgram) {
 
 }
 
-// opRegexpCompile is a wrapper for golang's `regexp`'s `MustCompile`.
+// opRegexpCompile is a wrapper for golang's `regexp`'s `Compile`.
 func opRegexpCompile(prgrm *cxcore.CXProgram) {
 	expr := prgrm.GetExpr()
 	fp := prgrm.GetFramePointer()
@@ -99,45 +129,153 @@ func opRegexpCompile(prgrm *cxcore.CXProgram) {
 	// Writing error message to `out2`.
 	if err != nil {
 		cxcore.WriteString(fp, err.Error(), out2)
+	} else {
+		cxcore.WriteString(fp, "", out2)
 	}
 }
 
-// opRegexpCompile is a wrapper for golang's `regexp`'s `MustCompile`.
-func opRegexpFind(prgrm *cxcore.CXProgram) {
+// opRegexpMatchString is a wrapper for golang's `regexp`'s `MatchString`,
+// which compiles `pattern` to check whether `s` contains a match.
+func opRegexpMatchString(prgrm *cxcore.CXProgram) {
 	expr := prgrm.GetExpr()
 	fp := prgrm.GetFramePointer()
-	inp1, inp2, out1 := expr.Inputs[0], expr.Inputs[1], expr.Outputs[0]
 
-	// Output structure `Regexp`.
-	reg := cxcore.CXArgument{}
-	err := copier.Copy(&reg, inp1)
+	var matched bool
+	var msg string
+	r, err := compileRegexp(cxcore.ReadStr(fp, expr.Inputs[0]))
 	if err != nil {
-		panic(err)
+		msg = err.Error()
+	} else {
+		matched = r.MatchString(cxcore.ReadStr(fp, expr.Inputs[1]))
 	}
+	cxcore.WriteBool(cxcore.GetFinalOffset(fp, expr.Outputs[0]), matched)
+	cxcore.WriteString(fp, msg, expr.Outputs[1])
+}
 
-	// Extracting CX `regexp` package.
-	regexpPkg, err := cxcore.PROGRAM.GetPackage("regexp")
-	if err != nil {
-		panic(err)
-	}
+// opRegexpQuoteMeta is a wrapper for golang's `regexp`'s `QuoteMeta`.
+func opRegexpQuoteMeta(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
 
-	// Extracting `regexp`'s Regexp structure.
-	regexpType, err := regexpPkg.GetStruct("Regexp")
-	if err != nil {
-		panic(err)
-	}
+	cxcore.WriteString(fp, regexp.QuoteMeta(cxcore.ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
 
-	// Extracting `regexp.Regexp`'s `exp` field.
-	expFld, err := regexpType.GetField("exp")
-	if err != nil {
-		panic(err)
+// opRegexpFind is a wrapper for golang's `regexp.Regexp`'s `FindString`.
+func opRegexpFind(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+	inp1, inp2, out1 := expr.Inputs[0], expr.Inputs[1], expr.Outputs[0]
+
+	cxcore.WriteString(fp, getRegexp(fp, inp1).FindString(cxcore.ReadStr(fp, inp2)), out1)
+}
+
+// opRegexpMatchStringMethod is a wrapper for golang's `regexp.Regexp`'s
+// `MatchString`.
+func opRegexpMatchStringMethod(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	matched := getRegexp(fp, expr.Inputs[0]).MatchString(cxcore.ReadStr(fp, expr.Inputs[1]))
+	cxcore.WriteBool(cxcore.GetFinalOffset(fp, expr.Outputs[0]), matched)
+}
+
+// opRegexpFindIndex returns the start and the end of the leftmost match, or
+// an empty slice if there isn't any.
+func opRegexpFindIndex(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	loc := getRegexp(fp, expr.Inputs[0]).FindStringIndex(cxcore.ReadStr(fp, expr.Inputs[1]))
+	data := make([]byte, len(loc)*4)
+	for i, n := range loc {
+		cxcore.WriteMemI32(data, i*4, int32(n))
 	}
+	cxcore.WriteSliceData(fp, data, 4, expr.Outputs[0])
+}
+
+// opRegexpFindAll returns up to `n` successive matches, or all of them if
+// `n` is negative.
+func opRegexpFindAll(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	r := getRegexp(fp, expr.Inputs[0])
+	matches := r.FindAllString(cxcore.ReadStr(fp, expr.Inputs[1]), int(cxcore.ReadI32(fp, expr.Inputs[2])))
+	cxcore.WriteStringSlice(fp, matches, expr.Outputs[0])
+}
+
+// opRegexpFindSubmatch returns the leftmost match followed by the matches
+// of its groups, which are "" for the groups that didn't take part in the
+// match. It returns an empty slice if there isn't any match.
+func opRegexpFindSubmatch(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	matches := getRegexp(fp, expr.Inputs[0]).FindStringSubmatch(cxcore.ReadStr(fp, expr.Inputs[1]))
+	cxcore.WriteStringSlice(fp, matches, expr.Outputs[0])
+}
+
+// opRegexpSubexpNames returns the names of the groups, indexed like the
+// matches of `FindSubmatch`. The first name, and the names of the unnamed
+// groups, are "".
+func opRegexpSubexpNames(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	cxcore.WriteStringSlice(fp, getRegexp(fp, expr.Inputs[0]).SubexpNames(), expr.Outputs[0])
+}
+
+// opRegexpSubexpIndex returns the index of the group with a name in the
+// matches of `FindSubmatch`, or -1 if there isn't any.
+func opRegexpSubexpIndex(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	index := getRegexp(fp, expr.Inputs[0]).SubexpIndex(cxcore.ReadStr(fp, expr.Inputs[1]))
+	cxcore.WriteI32(cxcore.GetFinalOffset(fp, expr.Outputs[0]), int32(index))
+}
 
-	// Getting corresponding `Regexp` instance.
-	accessExp := []*cxcore.CXArgument{expFld}
-	reg.Fields = accessExp
-	exp := cxcore.ReadStr(fp, &reg)
-	r := regexps[exp]
+// opRegexpReplaceAll replaces the matches with a replacement, where "$1"
+// or "${name}" are expanded to the matches of the groups.
+func opRegexpReplaceAll(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	r := getRegexp(fp, expr.Inputs[0])
+	result := r.ReplaceAllString(cxcore.ReadStr(fp, expr.Inputs[1]), cxcore.ReadStr(fp, expr.Inputs[2]))
+	cxcore.WriteString(fp, result, expr.Outputs[0])
+}
+
+// opRegexpReplaceAllFunc replaces the matches with the results of a
+// `fn(match str) (replacement str)` function, which aren't expanded.
+func opRegexpReplaceAllFunc(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	r := getRegexp(fp, expr.Inputs[0])
+	src := cxcore.ReadStr(fp, expr.Inputs[1])
+	fn := callbackFunction(prgrm, expr.Inputs[2])
+
+	result := r.ReplaceAllStringFunc(src, func(match string) string {
+		var m [4]byte
+		cxcore.WriteMemI32(m[:], 0, int32(cxcore.WriteStringObj(match)))
+		outs := prgrm.Callback(fn, [][]byte{m[:]})
+		off := cxcore.ReadMemI32(outs[0], 0)
+		if off == 0 {
+			return ""
+		}
+		return cxcore.ReadStringFromObject(off)
+	})
+	cxcore.WriteString(fp, result, expr.Outputs[0])
+}
+
+// opRegexpSplit splits a string around the matches into up to `n`
+// substrings, or all of them if `n` is negative.
+func opRegexpSplit(prgrm *cxcore.CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
 
-	cxcore.WriteString(fp, string(r.Find([]byte(cxcore.ReadStr(fp, inp2)))), out1)
+	r := getRegexp(fp, expr.Inputs[0])
+	parts := r.Split(cxcore.ReadStr(fp, expr.Inputs[1]), int(cxcore.ReadI32(fp, expr.Inputs[2])))
+	cxcore.WriteStringSlice(fp, parts, expr.Outputs[0])
 }
@@ -0,0 +1,305 @@
+// +build base
+
+package cxcore
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("sort")
+	RegisterPackage("slices")
+}
+
+// The functions in these packages work on the heap data of the slices they
+// receive. As the garbage collector can move a slice while a CX callback
+// runs, the offset of its data is read again from its argument after every
+// call.
+
+// rawSlice sorts the elements of a slice by swapping their bytes in place.
+type rawSlice struct {
+	fp   int
+	arg  *CXArgument
+	size int
+	less func(i, j int) bool
+	tmp  []byte
+}
+
+func (s *rawSlice) data() []byte {
+	return GetSliceData(GetSliceOffset(s.fp, s.arg), s.size)
+}
+
+func (s *rawSlice) Len() int {
+	return len(s.data()) / s.size
+}
+
+func (s *rawSlice) Less(i, j int) bool {
+	return s.less(i, j)
+}
+
+func (s *rawSlice) Swap(i, j int) {
+	data := s.data()
+	a := data[i*s.size : (i+1)*s.size]
+	b := data[j*s.size : (j+1)*s.size]
+	copy(s.tmp, a)
+	copy(a, b)
+	copy(b, s.tmp)
+}
+
+func newRawSlice(fp int, arg *CXArgument) *rawSlice {
+	size := GetAssignmentElement(arg).Size
+	return &rawSlice{fp: fp, arg: arg, size: size, tmp: make([]byte, size)}
+}
+
+// elementValue converts the bytes of a slice element of basic type `typ`
+// to a Go value. Strings are stored as offsets to their objects.
+func elementValue(typ int, b []byte) interface{} {
+	switch typ {
+	case TYPE_BOOL:
+		return ReadMemBool(b, 0)
+	case TYPE_I8:
+		return int64(ReadMemI8(b, 0))
+	case TYPE_I16:
+		return int64(ReadMemI16(b, 0))
+	case TYPE_I32:
+		return int64(ReadMemI32(b, 0))
+	case TYPE_I64:
+		return ReadMemI64(b, 0)
+	case TYPE_UI8:
+		return uint64(ReadMemUI8(b, 0))
+	case TYPE_UI16:
+		return uint64(ReadMemUI16(b, 0))
+	case TYPE_UI32:
+		return uint64(ReadMemUI32(b, 0))
+	case TYPE_UI64:
+		return ReadMemUI64(b, 0)
+	case TYPE_F32:
+		return float64(ReadMemF32(b, 0))
+	case TYPE_F64:
+		return ReadMemF64(b, 0)
+	case TYPE_STR:
+		if off := ReadMemI32(b, 0); off != 0 {
+			return ReadStringFromObject(off)
+		}
+		return ""
+	}
+	panic(CX_RUNTIME_INVALID_ARGUMENT)
+}
+
+// argValue reads the value of `arg`, which must have the element type of the
+// slice it is compared against.
+func argValue(fp int, arg *CXArgument, typ int) interface{} {
+	if GetAssignmentElement(arg).Type != typ {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	if typ == TYPE_STR {
+		return ReadStr(fp, arg)
+	}
+	return elementValue(typ, ReadMemory(GetFinalOffset(fp, arg), arg))
+}
+
+// compareValues returns -1, 0 or 1 if `x` is less than, equal to or greater
+// than `y`, both values returned by `elementValue`. Bools are only compared
+// for equality, and false is less than true.
+func compareValues(x, y interface{}) int {
+	switch a := x.(type) {
+	case bool:
+		b := y.(bool)
+		switch {
+		case a == b:
+			return 0
+		case b:
+			return -1
+		}
+		return 1
+	case int64:
+		b := y.(int64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case uint64:
+		b := y.(uint64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case float64:
+		b := y.(float64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case string:
+		return strings.Compare(a, y.(string))
+	}
+	return 0
+}
+
+// sortBasic sorts a slice of basic type in ascending order.
+func sortBasic(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s := newRawSlice(fp, expr.Inputs[0])
+	typ := GetAssignmentElement(expr.Inputs[0]).Type
+	s.less = func(i, j int) bool {
+		data := s.data()
+		return compareValues(elementValue(typ, data[i*s.size:]), elementValue(typ, data[j*s.size:])) < 0
+	}
+	sort.Sort(s)
+}
+
+func opSortInts(prgrm *CXProgram) {
+	sortBasic(prgrm)
+}
+
+func opSortFloats(prgrm *CXProgram) {
+	sortBasic(prgrm)
+}
+
+func opSortStrs(prgrm *CXProgram) {
+	sortBasic(prgrm)
+}
+
+// opSortSlice sorts a slice of any type with a `less(i i32, j i32) (b bool)`
+// function, which compares the elements at indexes `i` and `j`, usually of
+// a global slice.
+func opSortSlice(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+	inp1, inp2 := expr.Inputs[0], expr.Inputs[1]
+
+	// Getting comparison function.
+	lessPkg, err := prgrm.GetPackage(inp2.Package.Name)
+	if err != nil {
+		panic(err)
+	}
+	lessFn, err := lessPkg.GetFunction(inp2.Name)
+	if err != nil {
+		panic(err)
+	}
+
+	s := newRawSlice(fp, inp1)
+	s.less = func(i, j int) bool {
+		var a, b [4]byte
+		WriteMemI32(a[:], 0, int32(i))
+		WriteMemI32(b[:], 0, int32(j))
+		outs := prgrm.Callback(lessFn, [][]byte{a[:], b[:]})
+		return ReadMemBool(outs[0], 0)
+	}
+	sort.Sort(s)
+}
+
+func opSlicesReverse(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s := newRawSlice(fp, expr.Inputs[0])
+	for i, j := 0, s.Len()-1; i < j; i, j = i+1, j-1 {
+		s.Swap(i, j)
+	}
+}
+
+// opSlicesBinarySearch searches for a value in a sorted slice. It returns the
+// index where the value is or would be inserted, and whether it was found.
+func opSlicesBinarySearch(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s := newRawSlice(fp, expr.Inputs[0])
+	typ := GetAssignmentElement(expr.Inputs[0]).Type
+	v := argValue(fp, expr.Inputs[1], typ)
+	data := s.data()
+	n := s.Len()
+
+	i := sort.Search(n, func(i int) bool {
+		return compareValues(elementValue(typ, data[i*s.size:]), v) >= 0
+	})
+	found := i < n && compareValues(elementValue(typ, data[i*s.size:]), v) == 0
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(i))
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), found)
+}
+
+// indexOf returns the index of the first element of the slice `arg` equal to
+// `v`, or -1.
+func indexOf(fp int, arg *CXArgument, v *CXArgument) int32 {
+	s := newRawSlice(fp, arg)
+	typ := GetAssignmentElement(arg).Type
+	val := argValue(fp, v, typ)
+	data := s.data()
+	for i := 0; i < s.Len(); i++ {
+		if compareValues(elementValue(typ, data[i*s.size:]), val) == 0 {
+			return int32(i)
+		}
+	}
+	return -1
+}
+
+func opSlicesContains(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), indexOf(fp, expr.Inputs[0], expr.Inputs[1]) >= 0)
+}
+
+func opSlicesIndexOf(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), indexOf(fp, expr.Inputs[0], expr.Inputs[1]))
+}
+
+// opSlicesEqual reports whether two slices have the same length and equal
+// elements. Strings are compared by content, other types by their bytes.
+func opSlicesEqual(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+	inp1, inp2 := expr.Inputs[0], expr.Inputs[1]
+
+	elt1, elt2 := GetAssignmentElement(inp1), GetAssignmentElement(inp2)
+	if elt1.Type != elt2.Type || elt1.Size != elt2.Size {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+
+	a, b := newRawSlice(fp, inp1), newRawSlice(fp, inp2)
+	data1, data2 := a.data(), b.data()
+	equal := len(data1) == len(data2)
+	if equal && elt1.Type == TYPE_STR {
+		for i := 0; i < a.Len() && equal; i++ {
+			equal = compareValues(elementValue(TYPE_STR, data1[i*a.size:]), elementValue(TYPE_STR, data2[i*b.size:])) == 0
+		}
+	} else if equal {
+		equal = bytes.Equal(data1, data2)
+	}
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), equal)
+}
+
+// opSlicesClone returns a new slice with the elements of its input.
+func opSlicesClone(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+	inp1, out1 := expr.Inputs[0], expr.Outputs[0]
+
+	size := GetAssignmentElement(inp1).Size
+	var count int32
+	if off := GetSliceOffset(fp, inp1); off != 0 {
+		count = GetSliceLen(off)
+	}
+
+	outputSliceOffset := int32(SliceResizeEx(0, count, size))
+	SliceCopyEx(outputSliceOffset, GetSliceOffset(fp, inp1), count, size)
+	WriteI32(GetFinalOffset(fp, out1), outputSliceOffset)
+}
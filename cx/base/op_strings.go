@@ -0,0 +1,275 @@
+// +build base
+
+package cxcore
+
+import (
+	"strings"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// builderBufFld and builderLenFld are the fields of `strings.Builder`. `buf`
+// holds the bytes written to the builder in a slice on the CX heap, so
+// builders are collected and serialized like any other value. A copy of a
+// builder shares `buf` with the original, so the builder that writes to it
+// first appends to the shared slice and the other one notices that the
+// slice is longer than its `len` and moves to a new slice instead.
+var builderBufFld, builderLenFld *CXArgument
+
+func init() {
+	RegisterPackage("strings")
+
+	stringsPkg := MakePackage("strings")
+	builderStrct := MakeStruct("Builder")
+
+	builderBufFld = MakeArgument("buf", "", 0).AddType(TypeNames[TYPE_UI8]).AddPackage(stringsPkg)
+	builderBufFld.DeclarationSpecifiers = append(builderBufFld.DeclarationSpecifiers, DECL_SLICE)
+	builderBufFld.IsSlice = true
+	builderBufFld.TotalSize = TYPE_POINTER_SIZE
+	builderStrct.AddField(builderBufFld)
+	builderLenFld = MakeArgument("len", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(stringsPkg)
+	builderStrct.AddField(builderLenFld)
+
+	stringsPkg.AddStruct(builderStrct)
+
+	PROGRAM.AddPackage(stringsPkg)
+}
+
+func opStringsSplit(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteStringSlice(fp, strings.Split(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opStringsFields(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteStringSlice(fp, strings.Fields(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opStringsJoin(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.Join(ReadStringSlice(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opStringsReplace(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s, old, new := ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]), ReadStr(fp, expr.Inputs[2])
+	WriteString(fp, strings.Replace(s, old, new, int(ReadI32(fp, expr.Inputs[3]))), expr.Outputs[0])
+}
+
+func opStringsReplaceAll(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	s, old, new := ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]), ReadStr(fp, expr.Inputs[2])
+	WriteString(fp, strings.Replace(s, old, new, -1), expr.Outputs[0])
+}
+
+func opStringsRepeat(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	count := ReadI32(fp, expr.Inputs[1])
+	if count < 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	WriteString(fp, strings.Repeat(ReadStr(fp, expr.Inputs[0]), int(count)), expr.Outputs[0])
+}
+
+func opStringsContains(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), strings.Contains(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])))
+}
+
+func opStringsHasPrefix(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), strings.HasPrefix(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])))
+}
+
+func opStringsHasSuffix(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), strings.HasSuffix(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])))
+}
+
+func opStringsEqualFold(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), strings.EqualFold(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])))
+}
+
+func opStringsCount(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(strings.Count(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1]))))
+}
+
+func opStringsToUpper(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.ToUpper(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opStringsToLower(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.ToLower(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opStringsTrim(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.Trim(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opStringsTrimLeft(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.TrimLeft(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opStringsTrimRight(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.TrimRight(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opStringsTrimPrefix(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.TrimPrefix(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opStringsTrimSuffix(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.TrimSuffix(ReadStr(fp, expr.Inputs[0]), ReadStr(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opStringsTrimSpace(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, strings.TrimSpace(ReadStr(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+// builderOffset returns the offset of the `*strings.Builder` `inp`.
+func builderOffset(fp int, inp *CXArgument) int {
+	offset := GetFinalOffset(fp, inp)
+
+	// The receiver is passed by reference, so it only needs to be
+	// dereferenced if it's a pointer variable.
+	if elt := GetAssignmentElement(inp); elt.IsPointer && len(elt.DereferenceOperations) == 0 {
+		offset = int(ReadMemI32(PROGRAM.Memory, offset))
+		if offset >= PROGRAM.HeapStartsAt {
+			offset += OBJECT_HEADER_SIZE
+		}
+	}
+	return offset
+}
+
+// builderBytes returns the bytes written to the `*strings.Builder` `inp`.
+func builderBytes(fp int, inp *CXArgument) []byte {
+	offset := builderOffset(fp, inp)
+	buf := ReadMemI32(PROGRAM.Memory, offset+builderBufFld.Offset)
+	if buf == 0 {
+		return nil
+	}
+	return GetSliceData(buf, 1)[:ReadMemI32(PROGRAM.Memory, offset+builderLenFld.Offset)]
+}
+
+// builderWrite appends `byts` to the `*strings.Builder` `inp`. The bytes are
+// written to a new slice, with twice the capacity, if they don't fit in
+// `buf` or if a copy of the builder already wrote after its bytes.
+func builderWrite(fp int, inp *CXArgument, byts []byte) {
+	offset := builderOffset(fp, inp)
+	buf := ReadMemI32(PROGRAM.Memory, offset+builderBufFld.Offset)
+	bufLen := ReadMemI32(PROGRAM.Memory, offset+builderLenFld.Offset)
+	newLen := bufLen + int32(len(byts))
+
+	if buf == 0 || GetSliceLen(buf) != bufLen || newLen > ReadMemI32(GetSliceHeader(buf), 0) {
+		newCap := 2 * bufLen
+		if newCap < newLen {
+			newCap = newLen
+		}
+		size := OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE + int(newCap)
+		newBuf := int32(AllocateSeq(size))
+
+		// The allocation can run the garbage collector, which moves the
+		// objects of the heap, including the builder and its buffer.
+		offset = builderOffset(fp, inp)
+		buf = ReadMemI32(PROGRAM.Memory, offset+builderBufFld.Offset)
+
+		WriteMemI32(PROGRAM.Memory, int(newBuf)+OBJECT_GC_HEADER_SIZE, int32(size))
+		WriteMemI32(GetSliceHeader(newBuf), 0, newCap)
+		WriteMemI32(GetSliceHeader(newBuf), 4, bufLen)
+		if buf != 0 {
+			copy(GetSliceData(newBuf, 1), GetSliceData(buf, 1)[:bufLen])
+		}
+		WriteI32(offset+builderBufFld.Offset, newBuf)
+		buf = newBuf
+	}
+
+	WriteMemI32(GetSliceHeader(buf), 4, newLen)
+	copy(GetSliceData(buf, 1)[bufLen:], byts)
+	WriteI32(offset+builderLenFld.Offset, newLen)
+}
+
+func opStringsBuilderWriteString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	builderWrite(fp, expr.Inputs[0], []byte(ReadStr(fp, expr.Inputs[1])))
+}
+
+func opStringsBuilderWriteByte(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	builderWrite(fp, expr.Inputs[0], []byte{ReadUI8(fp, expr.Inputs[1])})
+}
+
+func opStringsBuilderLen(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(len(builderBytes(fp, expr.Inputs[0]))))
+}
+
+func opStringsBuilderString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, string(builderBytes(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+// opStringsBuilderReset empties the builder and releases its buffer.
+func opStringsBuilderReset(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	offset := builderOffset(fp, expr.Inputs[0])
+	WriteI32(offset+builderBufFld.Offset, 0)
+	WriteI32(offset+builderLenFld.Offset, 0)
+}
@@ -3,15 +3,226 @@
 package cxcore
 
 import (
+	"fmt"
 	"time"
 
 	. "github.com/skycoin/cx/cx"
 )
 
+// A `time.Time` keeps its instant as the seconds since January 1, year 1
+// UTC and the nanoseconds within that second, so its zero value is the zero
+// time of Go. Its location is an index in `timeLocations`, and its monotonic
+// clock reading, if it was returned by `time.Now`, the nanoseconds since the
+// program started plus one, so zero means it has none. As in Go, the
+// monotonic readings are used to measure the time between two instants and
+// are stripped by the functions that change the wall clock time.
+//
+// A `time.Duration` keeps its nanoseconds as an i64, and the `time.Timer`s
+// and `time.Ticker`s are indexes in `timers`.
+
+const (
+	// seconds from January 1, year 1 to January 1, 1970
+	timeUnixToInternal int64 = (1969*365 + 1969/4 - 1969/100 + 1969/400) * 24 * 60 * 60
+
+	TIME_LOCATION_UTC   = 0
+	TIME_LOCATION_LOCAL = 1
+)
+
+var (
+	timeStart = time.Now()
+
+	timeLocations   = []*time.Location{time.UTC, time.Local}
+	timeLocationIDs = map[string]int32{"UTC": TIME_LOCATION_UTC, "Local": TIME_LOCATION_LOCAL}
+
+	// timeLayouts are the names of the layouts of the Go `time` package that
+	// can be used instead of the layouts themselves.
+	timeLayouts = map[string]string{
+		"ANSIC":       time.ANSIC,
+		"UnixDate":    time.UnixDate,
+		"RubyDate":    time.RubyDate,
+		"RFC822":      time.RFC822,
+		"RFC822Z":     time.RFC822Z,
+		"RFC850":      time.RFC850,
+		"RFC1123":     time.RFC1123,
+		"RFC1123Z":    time.RFC1123Z,
+		"RFC3339":     time.RFC3339,
+		"RFC3339Nano": time.RFC3339Nano,
+		"Kitchen":     time.Kitchen,
+		"Stamp":       time.Stamp,
+		"StampMilli":  time.StampMilli,
+		"StampMicro":  time.StampMicro,
+		"StampNano":   time.StampNano,
+		"DateTime":    "2006-01-02 15:04:05",
+		"DateOnly":    "2006-01-02",
+		"TimeOnly":    "15:04:05",
+	}
+)
+
+func init() {
+	timePkg := MakePackage("time")
+
+	timeStrct := MakeStruct("Time")
+	timeStrct.AddField(MakeArgument("sec", "", 0).AddType(TypeNames[TYPE_I64]).AddPackage(timePkg))
+	timeStrct.AddField(MakeArgument("nsec", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(timePkg))
+	timeStrct.AddField(MakeArgument("loc", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(timePkg))
+	timeStrct.AddField(MakeArgument("mono", "", 0).AddType(TypeNames[TYPE_I64]).AddPackage(timePkg))
+	timePkg.AddStruct(timeStrct)
+
+	durationStrct := MakeStruct("Duration")
+	durationStrct.AddField(MakeArgument("ns", "", 0).AddType(TypeNames[TYPE_I64]).AddPackage(timePkg))
+	timePkg.AddStruct(durationStrct)
+
+	locationStrct := MakeStruct("Location")
+	locationStrct.AddField(MakeArgument("id", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(timePkg))
+	timePkg.AddStruct(locationStrct)
+
+	timerStrct := MakeStruct("Timer")
+	timerStrct.AddField(MakeArgument("id", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(timePkg))
+	timePkg.AddStruct(timerStrct)
+
+	tickerStrct := MakeStruct("Ticker")
+	tickerStrct.AddField(MakeArgument("id", "", 0).AddType(TypeNames[TYPE_I32]).AddPackage(timePkg))
+	timePkg.AddStruct(tickerStrct)
+
+	PROGRAM.AddPackage(timePkg)
+}
+
 func makeTimestamp() int64 {
 	return time.Now().UnixNano() / (int64(time.Millisecond) / int64(time.Nanosecond))
 }
 
+// monoNow returns the monotonic clock reading of the current instant.
+func monoNow() int64 {
+	return int64(time.Since(timeStart)) + 1
+}
+
+// timeLocation returns the location with index `id`.
+func timeLocation(id int32) *time.Location {
+	if id < 0 || int(id) >= len(timeLocations) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return timeLocations[id]
+}
+
+// addTimeLocation returns the index of the location named `key`, adding
+// `loc` to `timeLocations` if it's the first time it's used.
+func addTimeLocation(key string, loc *time.Location) int32 {
+	if id, ok := timeLocationIDs[key]; ok {
+		return id
+	}
+	id := int32(len(timeLocations))
+	timeLocations = append(timeLocations, loc)
+	timeLocationIDs[key] = id
+	return id
+}
+
+// fixedTimeLocation returns the index of a location with a fixed offset.
+func fixedTimeLocation(name string, offset int) int32 {
+	return addTimeLocation(fmt.Sprintf("%s%+d", name, offset), time.FixedZone(name, offset))
+}
+
+// timeLocationOf returns the index of the location of `t`, which is `loc`
+// unless `t` was parsed with a time zone offset that isn't the one of `loc`.
+func timeLocationOf(t time.Time, loc int32) int32 {
+	if t.Location() == timeLocation(loc) {
+		return loc
+	}
+	if t.Location() == time.UTC {
+		return TIME_LOCATION_UTC
+	}
+	return fixedTimeLocation(t.Zone())
+}
+
+// timeLayout returns the layout named `layout`, or `layout` itself.
+func timeLayout(layout string) string {
+	if l, ok := timeLayouts[layout]; ok {
+		return l
+	}
+	return layout
+}
+
+// cxTime is the value of a `time.Time`.
+type cxTime struct {
+	t    time.Time
+	loc  int32
+	mono int64
+}
+
+func readTime(fp int, arg *CXArgument) cxTime {
+	b := ReadMemory(GetFinalOffset(fp, arg), arg)
+	loc := ReadMemI32(b, 12)
+	t := time.Unix(ReadMemI64(b, 0)-timeUnixToInternal, int64(ReadMemI32(b, 8))).In(timeLocation(loc))
+	return cxTime{t: t, loc: loc, mono: ReadMemI64(b, 16)}
+}
+
+func writeTime(fp int, t cxTime, out *CXArgument) {
+	var b [24]byte
+	WriteMemI64(b[:], 0, t.t.Unix()+timeUnixToInternal)
+	WriteMemI32(b[:], 8, int32(t.t.Nanosecond()))
+	WriteMemI32(b[:], 12, t.loc)
+	WriteMemI64(b[:], 16, t.mono)
+	WriteMemory(GetFinalOffset(fp, out), b[:])
+}
+
+// wallTime returns `t` in location `loc` and without monotonic reading.
+func wallTime(t time.Time, loc int32) cxTime {
+	return cxTime{t: t.In(timeLocation(loc)), loc: loc}
+}
+
+func readDuration(fp int, arg *CXArgument) time.Duration {
+	return time.Duration(ReadMemI64(ReadMemory(GetFinalOffset(fp, arg), arg), 0))
+}
+
+func writeDuration(fp int, d time.Duration, out *CXArgument) {
+	var b [8]byte
+	WriteMemI64(b[:], 0, int64(d))
+	WriteMemory(GetFinalOffset(fp, out), b[:])
+}
+
+// readID reads the `id` field of a handle struct, such as a `time.Timer`
+// or an `http.Router`.
+func readID(fp int, arg *CXArgument) int32 {
+	return ReadMemI32(ReadMemory(GetFinalOffset(fp, arg), arg), 0)
+}
+
+func writeID(fp int, id int32, out *CXArgument) {
+	var b [4]byte
+	WriteMemI32(b[:], 0, id)
+	WriteMemory(GetFinalOffset(fp, out), b[:])
+}
+
+// sub returns t - u, measured with the monotonic clock if both have a
+// reading.
+func (t cxTime) sub(u cxTime) time.Duration {
+	if t.mono != 0 && u.mono != 0 {
+		return time.Duration(t.mono - u.mono)
+	}
+	return t.t.Sub(u.t)
+}
+
+func (t cxTime) compare(u cxTime) int32 {
+	if t.mono != 0 && u.mono != 0 {
+		switch {
+		case t.mono < u.mono:
+			return -1
+		case t.mono > u.mono:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case t.t.Before(u.t):
+		return -1
+	case t.t.After(u.t):
+		return 1
+	}
+	return 0
+}
+
+func now() cxTime {
+	return cxTime{t: time.Now().In(time.Local), loc: TIME_LOCATION_LOCAL, mono: monoNow()}
+}
+
 func opTimeUnixMilli(prgrm *CXProgram) {
 	expr := prgrm.GetExpr()
 	fp := prgrm.GetFramePointer()
@@ -32,3 +243,436 @@ func opTimeSleep(prgrm *CXProgram) {
 
 	time.Sleep(time.Duration(ReadI32(fp, expr.Inputs[0])) * time.Millisecond)
 }
+
+func opTimeSleepFor(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	time.Sleep(readDuration(fp, expr.Inputs[0]))
+}
+
+// opTimeNow returns the current local time, with a monotonic clock reading.
+func opTimeNow(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeTime(fp, now(), expr.Outputs[0])
+}
+
+// opTimeUnix returns the local time of a Unix time in seconds and
+// nanoseconds.
+func opTimeUnix(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := time.Unix(ReadI64(fp, expr.Inputs[0]), ReadI64(fp, expr.Inputs[1]))
+	writeTime(fp, wallTime(t, TIME_LOCATION_LOCAL), expr.Outputs[0])
+}
+
+// opTimeDate returns the time of a date in a location. As in Go, the values
+// out of their ranges are normalized, so October 32 is November 1.
+func opTimeDate(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+	inps := expr.Inputs
+
+	loc := readID(fp, inps[7])
+	t := time.Date(int(ReadI32(fp, inps[0])), time.Month(ReadI32(fp, inps[1])), int(ReadI32(fp, inps[2])),
+		int(ReadI32(fp, inps[3])), int(ReadI32(fp, inps[4])), int(ReadI32(fp, inps[5])), int(ReadI32(fp, inps[6])),
+		timeLocation(loc))
+	writeTime(fp, cxTime{t: t, loc: loc}, expr.Outputs[0])
+}
+
+func opTimeSince(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDuration(fp, now().sub(readTime(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opTimeUntil(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDuration(fp, readTime(fp, expr.Inputs[0]).sub(now()), expr.Outputs[0])
+}
+
+// parseTime parses `value` with `layout` in location `loc`.
+func parseTime(fp int, layout, value *CXArgument, loc int32, out1, out2 *CXArgument) {
+	t, err := time.ParseInLocation(timeLayout(ReadStr(fp, layout)), ReadStr(fp, value), timeLocation(loc))
+	if err == nil {
+		loc = timeLocationOf(t, loc)
+	} else {
+		t, loc = time.Time{}, TIME_LOCATION_UTC
+	}
+	writeTime(fp, cxTime{t: t, loc: loc}, out1)
+	WriteBool(GetFinalOffset(fp, out2), err == nil)
+}
+
+// opTimeParse parses a time formatted with a layout, which is UTC unless it
+// has a time zone offset.
+func opTimeParse(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	parseTime(fp, expr.Inputs[0], expr.Inputs[1], TIME_LOCATION_UTC, expr.Outputs[0], expr.Outputs[1])
+}
+
+func opTimeParseInLocation(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	parseTime(fp, expr.Inputs[0], expr.Inputs[1], readID(fp, expr.Inputs[2]), expr.Outputs[0], expr.Outputs[1])
+}
+
+// opTimeLoadLocation loads a location of the system time zone database, as
+// "Europe/Madrid". "" and "UTC" are UTC, and "Local" is the local time zone.
+func opTimeLoadLocation(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	name := ReadStr(fp, expr.Inputs[0])
+	if name == "" {
+		name = "UTC"
+	}
+
+	var id int32
+	loc, err := time.LoadLocation(name)
+	if err == nil {
+		id = addTimeLocation(name, loc)
+	}
+	writeID(fp, id, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+// opTimeFixedZone returns a location with a name and a fixed offset in
+// seconds east of UTC.
+func opTimeFixedZone(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	id := fixedTimeLocation(ReadStr(fp, expr.Inputs[0]), int(ReadI32(fp, expr.Inputs[1])))
+	writeID(fp, id, expr.Outputs[0])
+}
+
+func opTimeLocationString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, timeLocation(readID(fp, expr.Inputs[0])).String(), expr.Outputs[0])
+}
+
+func opTimeMonthName(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, time.Month(ReadI32(fp, expr.Inputs[0])).String(), expr.Outputs[0])
+}
+
+func opTimeWeekdayName(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, time.Weekday(ReadI32(fp, expr.Inputs[0])).String(), expr.Outputs[0])
+}
+
+func opTimeTimeAdd(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := readTime(fp, expr.Inputs[0])
+	d := readDuration(fp, expr.Inputs[1])
+	t.t = t.t.Add(d)
+	if t.mono != 0 {
+		t.mono += int64(d)
+	}
+	writeTime(fp, t, expr.Outputs[0])
+}
+
+func opTimeTimeSub(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDuration(fp, readTime(fp, expr.Inputs[0]).sub(readTime(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opTimeTimeAddDate(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := readTime(fp, expr.Inputs[0])
+	d := t.t.AddDate(int(ReadI32(fp, expr.Inputs[1])), int(ReadI32(fp, expr.Inputs[2])), int(ReadI32(fp, expr.Inputs[3])))
+	writeTime(fp, wallTime(d, t.loc), expr.Outputs[0])
+}
+
+func opTimeTimeBefore(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), readTime(fp, expr.Inputs[0]).compare(readTime(fp, expr.Inputs[1])) < 0)
+}
+
+func opTimeTimeAfter(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), readTime(fp, expr.Inputs[0]).compare(readTime(fp, expr.Inputs[1])) > 0)
+}
+
+// opTimeTimeEqual reports whether two times are the same instant, even if
+// they are in different locations.
+func opTimeTimeEqual(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), readTime(fp, expr.Inputs[0]).compare(readTime(fp, expr.Inputs[1])) == 0)
+}
+
+func opTimeTimeCompare(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), readTime(fp, expr.Inputs[0]).compare(readTime(fp, expr.Inputs[1])))
+}
+
+func opTimeTimeIsZero(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), readTime(fp, expr.Inputs[0]).t.IsZero())
+}
+
+// timeComponent writes a component of the time `expr.Inputs[0]`.
+func timeComponent(prgrm *CXProgram, component func(t time.Time) int) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(component(readTime(fp, expr.Inputs[0]).t)))
+}
+
+func opTimeTimeYear(prgrm *CXProgram) {
+	timeComponent(prgrm, time.Time.Year)
+}
+
+// opTimeTimeMonth returns the month of a time, from time.JANUARY (1) to
+// time.DECEMBER (12).
+func opTimeTimeMonth(prgrm *CXProgram) {
+	timeComponent(prgrm, func(t time.Time) int { return int(t.Month()) })
+}
+
+func opTimeTimeDay(prgrm *CXProgram) {
+	timeComponent(prgrm, time.Time.Day)
+}
+
+func opTimeTimeHour(prgrm *CXProgram) {
+	timeComponent(prgrm, time.Time.Hour)
+}
+
+func opTimeTimeMinute(prgrm *CXProgram) {
+	timeComponent(prgrm, time.Time.Minute)
+}
+
+func opTimeTimeSecond(prgrm *CXProgram) {
+	timeComponent(prgrm, time.Time.Second)
+}
+
+func opTimeTimeNanosecond(prgrm *CXProgram) {
+	timeComponent(prgrm, time.Time.Nanosecond)
+}
+
+// opTimeTimeWeekday returns the day of the week of a time, from time.SUNDAY
+// (0) to time.SATURDAY (6).
+func opTimeTimeWeekday(prgrm *CXProgram) {
+	timeComponent(prgrm, func(t time.Time) int { return int(t.Weekday()) })
+}
+
+func opTimeTimeYearDay(prgrm *CXProgram) {
+	timeComponent(prgrm, time.Time.YearDay)
+}
+
+func opTimeTimeUnix(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), readTime(fp, expr.Inputs[0]).t.Unix())
+}
+
+func opTimeTimeUnixMilli(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := readTime(fp, expr.Inputs[0]).t
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), t.Unix()*1e3+int64(t.Nanosecond())/1e6)
+}
+
+func opTimeTimeUnixNano(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), readTime(fp, expr.Inputs[0]).t.UnixNano())
+}
+
+// opTimeTimeFormat formats a time with a layout of the Go `time` package,
+// as "2006-01-02 15:04:05", or with the name of one of its constants, as
+// "RFC3339".
+func opTimeTimeFormat(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readTime(fp, expr.Inputs[0]).t.Format(timeLayout(ReadStr(fp, expr.Inputs[1]))), expr.Outputs[0])
+}
+
+func opTimeTimeString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readTime(fp, expr.Inputs[0]).t.Format("2006-01-02 15:04:05.999999999 -0700 MST"), expr.Outputs[0])
+}
+
+func opTimeTimeUTC(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeTime(fp, wallTime(readTime(fp, expr.Inputs[0]).t, TIME_LOCATION_UTC), expr.Outputs[0])
+}
+
+func opTimeTimeLocal(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeTime(fp, wallTime(readTime(fp, expr.Inputs[0]).t, TIME_LOCATION_LOCAL), expr.Outputs[0])
+}
+
+func opTimeTimeIn(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeTime(fp, wallTime(readTime(fp, expr.Inputs[0]).t, readID(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opTimeTimeLocation(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeID(fp, readTime(fp, expr.Inputs[0]).loc, expr.Outputs[0])
+}
+
+// opTimeTimeZone returns the abbreviated name of the time zone of a time,
+// as "CET", and its offset in seconds east of UTC.
+func opTimeTimeZone(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	name, offset := readTime(fp, expr.Inputs[0]).t.Zone()
+	WriteString(fp, name, expr.Outputs[0])
+	WriteI32(GetFinalOffset(fp, expr.Outputs[1]), int32(offset))
+}
+
+func opTimeTimeTruncate(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := readTime(fp, expr.Inputs[0])
+	writeTime(fp, wallTime(t.t.Truncate(readDuration(fp, expr.Inputs[1])), t.loc), expr.Outputs[0])
+}
+
+func opTimeTimeRound(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := readTime(fp, expr.Inputs[0])
+	writeTime(fp, wallTime(t.t.Round(readDuration(fp, expr.Inputs[1])), t.loc), expr.Outputs[0])
+}
+
+// opTimeNewDuration returns a duration of n nanoseconds, which are usually
+// a multiple of time.MILLISECOND, time.SECOND, etc.
+func opTimeNewDuration(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDuration(fp, time.Duration(ReadI64(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+// opTimeParseDuration parses a duration as "1h30m" or "-2.5s".
+func opTimeParseDuration(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	d, err := time.ParseDuration(ReadStr(fp, expr.Inputs[0]))
+	writeDuration(fp, d, expr.Outputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[1]), err == nil)
+}
+
+func opTimeDurationNanoseconds(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), readDuration(fp, expr.Inputs[0]).Nanoseconds())
+}
+
+func opTimeDurationMicroseconds(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), int64(readDuration(fp, expr.Inputs[0])/time.Microsecond))
+}
+
+func opTimeDurationMilliseconds(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI64(GetFinalOffset(fp, expr.Outputs[0]), int64(readDuration(fp, expr.Inputs[0])/time.Millisecond))
+}
+
+func opTimeDurationSeconds(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), readDuration(fp, expr.Inputs[0]).Seconds())
+}
+
+func opTimeDurationMinutes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), readDuration(fp, expr.Inputs[0]).Minutes())
+}
+
+func opTimeDurationHours(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteF64(GetFinalOffset(fp, expr.Outputs[0]), readDuration(fp, expr.Inputs[0]).Hours())
+}
+
+func opTimeDurationString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, readDuration(fp, expr.Inputs[0]).String(), expr.Outputs[0])
+}
+
+func opTimeDurationTruncate(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDuration(fp, readDuration(fp, expr.Inputs[0]).Truncate(readDuration(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opTimeDurationRound(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	writeDuration(fp, readDuration(fp, expr.Inputs[0]).Round(readDuration(fp, expr.Inputs[1])), expr.Outputs[0])
+}
+
+func opTimeDurationAbs(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	d := readDuration(fp, expr.Inputs[0])
+	if d < 0 {
+		d = -d
+	}
+	writeDuration(fp, d, expr.Outputs[0])
+}
@@ -0,0 +1,252 @@
+// +build base
+
+package cxcore
+
+import (
+	"time"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// The timers and tickers don't run in the background, as the CX callbacks
+// can only be called between the expressions of the program. A program
+// polls them with `Timer.Expired` and `Ticker.Tick`, blocks until they fire
+// with their `Wait` methods, or gives them functions that are called by
+// `time.RunTimers`, which calls the functions whose time has come, and by
+// `time.Run`, the event loop that calls them until there aren't any
+// active timers or tickers with functions.
+
+type timer struct {
+	next   time.Time
+	period time.Duration // zero for a timer
+	fn     *CXFunction
+	active bool
+}
+
+var (
+	// timers are indexed by the `id` of their `time.Timer`s and
+	// `time.Ticker`s minus one, so the zero values aren't valid.
+	timers []*timer
+
+	// runningTimers is true while `time.RunTimers` calls the functions of
+	// the timers, which can't run the timers again.
+	runningTimers bool
+)
+
+func getTimer(fp int, arg *CXArgument) *timer {
+	id := readID(fp, arg)
+	if id < 1 || int(id) > len(timers) {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return timers[id-1]
+}
+
+// newTimer adds a timer that fires after `d`, and then every `period` if
+// it's a ticker.
+func newTimer(fp int, d, period time.Duration, fn *CXFunction, out *CXArgument) {
+	timers = append(timers, &timer{next: time.Now().Add(d), period: period, fn: fn, active: true})
+	writeID(fp, int32(len(timers)), out)
+}
+
+// callbackFunction returns the function passed as the argument `arg`.
+func callbackFunction(prgrm *CXProgram, arg *CXArgument) *CXFunction {
+	pkg, err := prgrm.GetPackage(arg.Package.Name)
+	if err != nil {
+		panic(err)
+	}
+	fn, err := pkg.GetFunction(arg.Name)
+	if err != nil {
+		panic(err)
+	}
+	return fn
+}
+
+// tickerPeriod reads the period of a ticker, which must be positive.
+func tickerPeriod(fp int, arg *CXArgument) time.Duration {
+	period := readDuration(fp, arg)
+	if period <= 0 {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return period
+}
+
+// fire reports whether the time of `t` has come, and if it has, it stops a
+// timer or schedules the next tick of a ticker. A ticker that falls behind
+// drops the ticks it missed.
+func (t *timer) fire(now time.Time) bool {
+	if !t.active || now.Before(t.next) {
+		return false
+	}
+	if t.period == 0 {
+		t.active = false
+		return true
+	}
+	for !now.Before(t.next) {
+		t.next = t.next.Add(t.period)
+	}
+	return true
+}
+
+// wait blocks until the time of `t` comes, unless it's stopped.
+func (t *timer) wait() {
+	if t.active {
+		time.Sleep(time.Until(t.next))
+		t.fire(time.Now())
+	}
+}
+
+// opTimeNewTimer returns a timer that expires after a duration.
+func opTimeNewTimer(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	newTimer(fp, readDuration(fp, expr.Inputs[0]), 0, nil, expr.Outputs[0])
+}
+
+// opTimeAfterFunc returns a timer that calls a function without inputs and
+// outputs when it expires, during `time.RunTimers` or `time.Run`.
+func opTimeAfterFunc(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	newTimer(fp, readDuration(fp, expr.Inputs[0]), 0, callbackFunction(prgrm, expr.Inputs[1]), expr.Outputs[0])
+}
+
+// opTimeTimerExpired reports whether the time of a timer has come. An
+// expired timer isn't active anymore, so after calling it the first time
+// `true` is returned, it returns `false` until the timer is reset.
+func opTimeTimerExpired(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := getTimer(fp, expr.Inputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), t.fn == nil && t.fire(time.Now()))
+}
+
+func opTimeTimerWait(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getTimer(fp, expr.Inputs[0]).wait()
+}
+
+// opTimeTimerStop stops a timer. It returns false if the timer had already
+// expired or been stopped.
+func opTimeTimerStop(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := getTimer(fp, expr.Inputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), t.active)
+	t.active = false
+}
+
+// opTimeTimerReset makes a timer expire after a duration. It returns true if
+// the timer was active.
+func opTimeTimerReset(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := getTimer(fp, expr.Inputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), t.active)
+	t.next = time.Now().Add(readDuration(fp, expr.Inputs[1]))
+	t.active = true
+}
+
+// opTimeNewTicker returns a ticker that ticks every period.
+func opTimeNewTicker(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	period := tickerPeriod(fp, expr.Inputs[0])
+	newTimer(fp, period, period, nil, expr.Outputs[0])
+}
+
+// opTimeTickFunc returns a ticker that calls a function without inputs and
+// outputs every period, during `time.RunTimers` or `time.Run`.
+func opTimeTickFunc(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	period := tickerPeriod(fp, expr.Inputs[0])
+	newTimer(fp, period, period, callbackFunction(prgrm, expr.Inputs[1]), expr.Outputs[0])
+}
+
+// opTimeTickerTick reports whether a ticker ticked since the last call.
+func opTimeTickerTick(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := getTimer(fp, expr.Inputs[0])
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), t.fn == nil && t.fire(time.Now()))
+}
+
+func opTimeTickerWait(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getTimer(fp, expr.Inputs[0]).wait()
+}
+
+func opTimeTickerStop(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	getTimer(fp, expr.Inputs[0]).active = false
+}
+
+// opTimeTickerReset restarts a ticker with a new period.
+func opTimeTickerReset(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	t := getTimer(fp, expr.Inputs[0])
+	t.period = tickerPeriod(fp, expr.Inputs[1])
+	t.next = time.Now().Add(t.period)
+	t.active = true
+}
+
+// runTimers calls the functions of the timers and tickers whose time has
+// come, and returns how many it called.
+func runTimers(prgrm *CXProgram) int32 {
+	if runningTimers {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	runningTimers = true
+	defer func() { runningTimers = false }()
+
+	var calls int32
+	// the timers added by the functions run the next time
+	for _, t := range timers {
+		if t.fn != nil && t.fire(time.Now()) {
+			prgrm.Callback(t.fn, nil)
+			calls++
+		}
+	}
+	return calls
+}
+
+func opTimeRunTimers(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), runTimers(prgrm))
+}
+
+// opTimeRun calls the functions of the timers and tickers when their time
+// comes, until all of them are stopped or expired.
+func opTimeRun(prgrm *CXProgram) {
+	for {
+		var next *timer
+		for _, t := range timers {
+			if t.fn != nil && t.active && (next == nil || t.next.Before(next.next)) {
+				next = t
+			}
+		}
+		if next == nil {
+			return
+		}
+		time.Sleep(time.Until(next.next))
+		runTimers(prgrm)
+	}
+}
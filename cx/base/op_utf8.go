@@ -0,0 +1,105 @@
+// +build base
+
+package cxcore
+
+import (
+	"unicode/utf8"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+func init() {
+	RegisterPackage("utf8")
+}
+
+// Runes are i32 values, as in `var r rune`, and strings are UTF-8 encoded.
+
+func opUtf8RuneCount(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(utf8.RuneCountInString(ReadStr(fp, expr.Inputs[0]))))
+}
+
+func opUtf8RuneLen(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(utf8.RuneLen(ReadI32(fp, expr.Inputs[0]))))
+}
+
+func opUtf8DecodeRune(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	r, size := utf8.DecodeRuneInString(ReadStr(fp, expr.Inputs[0]))
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), r)
+	WriteI32(GetFinalOffset(fp, expr.Outputs[1]), int32(size))
+}
+
+func opUtf8DecodeLastRune(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	r, size := utf8.DecodeLastRuneInString(ReadStr(fp, expr.Inputs[0]))
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), r)
+	WriteI32(GetFinalOffset(fp, expr.Outputs[1]), int32(size))
+}
+
+func opUtf8EncodeRune(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteString(fp, string(ReadI32(fp, expr.Inputs[0])), expr.Outputs[0])
+}
+
+func opUtf8ValidString(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), utf8.ValidString(ReadStr(fp, expr.Inputs[0])))
+}
+
+func opUtf8ValidRune(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteBool(GetFinalOffset(fp, expr.Outputs[0]), utf8.ValidRune(ReadI32(fp, expr.Inputs[0])))
+}
+
+// opUtf8Runes returns the runes of a string as a new []i32 slice.
+func opUtf8Runes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	runes := []rune(ReadStr(fp, expr.Inputs[0]))
+	data := make([]byte, len(runes)*4)
+	for i, r := range runes {
+		WriteMemI32(data, i*4, r)
+	}
+	WriteSliceData(fp, data, 4, expr.Outputs[0])
+}
+
+func opUtf8FromRunes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	runes, _ := ReadData(fp, expr.Inputs[0], TYPE_I32).([]int32)
+	WriteString(fp, string(runes), expr.Outputs[0])
+}
+
+// opUtf8Bytes returns the UTF-8 encoding of a string as a new []ui8 slice.
+func opUtf8Bytes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	WriteSliceData(fp, []byte(ReadStr(fp, expr.Inputs[0])), 1, expr.Outputs[0])
+}
+
+func opUtf8FromBytes(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	bytes, _ := ReadData(fp, expr.Inputs[0], TYPE_UI8).([]uint8)
+	WriteString(fp, string(bytes), expr.Outputs[0])
+}
@@ -12,6 +12,74 @@ const (
 	OP_TIME_SLEEP = iota + END_OF_CORE_OPS
 	OP_TIME_UNIX_MILLI
 	OP_TIME_UNIX_NANO
+	OP_TIME_SLEEP_FOR
+	OP_TIME_NOW
+	OP_TIME_UNIX
+	OP_TIME_DATE
+	OP_TIME_SINCE
+	OP_TIME_UNTIL
+	OP_TIME_PARSE
+	OP_TIME_PARSE_IN_LOCATION
+	OP_TIME_LOAD_LOCATION
+	OP_TIME_FIXED_ZONE
+	OP_TIME_LOCATION_STRING
+	OP_TIME_MONTH_NAME
+	OP_TIME_WEEKDAY_NAME
+	OP_TIME_TIME_ADD
+	OP_TIME_TIME_SUB
+	OP_TIME_TIME_ADD_DATE
+	OP_TIME_TIME_BEFORE
+	OP_TIME_TIME_AFTER
+	OP_TIME_TIME_EQUAL
+	OP_TIME_TIME_COMPARE
+	OP_TIME_TIME_IS_ZERO
+	OP_TIME_TIME_YEAR
+	OP_TIME_TIME_MONTH
+	OP_TIME_TIME_DAY
+	OP_TIME_TIME_HOUR
+	OP_TIME_TIME_MINUTE
+	OP_TIME_TIME_SECOND
+	OP_TIME_TIME_NANOSECOND
+	OP_TIME_TIME_WEEKDAY
+	OP_TIME_TIME_YEAR_DAY
+	OP_TIME_TIME_UNIX
+	OP_TIME_TIME_UNIX_MILLI
+	OP_TIME_TIME_UNIX_NANO
+	OP_TIME_TIME_FORMAT
+	OP_TIME_TIME_STRING
+	OP_TIME_TIME_UTC
+	OP_TIME_TIME_LOCAL
+	OP_TIME_TIME_IN
+	OP_TIME_TIME_LOCATION
+	OP_TIME_TIME_ZONE
+	OP_TIME_TIME_TRUNCATE
+	OP_TIME_TIME_ROUND
+	OP_TIME_NEW_DURATION
+	OP_TIME_PARSE_DURATION
+	OP_TIME_DURATION_NANOSECONDS
+	OP_TIME_DURATION_MICROSECONDS
+	OP_TIME_DURATION_MILLISECONDS
+	OP_TIME_DURATION_SECONDS
+	OP_TIME_DURATION_MINUTES
+	OP_TIME_DURATION_HOURS
+	OP_TIME_DURATION_STRING
+	OP_TIME_DURATION_TRUNCATE
+	OP_TIME_DURATION_ROUND
+	OP_TIME_DURATION_ABS
+	OP_TIME_NEW_TIMER
+	OP_TIME_AFTER_FUNC
+	OP_TIME_TIMER_EXPIRED
+	OP_TIME_TIMER_WAIT
+	OP_TIME_TIMER_STOP
+	OP_TIME_TIMER_RESET
+	OP_TIME_NEW_TICKER
+	OP_TIME_TICK_FUNC
+	OP_TIME_TICKER_TICK
+	OP_TIME_TICKER_WAIT
+	OP_TIME_TICKER_STOP
+	OP_TIME_TICKER_RESET
+	OP_TIME_RUN_TIMERS
+	OP_TIME_RUN
 
 	// serialize
 	OP_SERIAL_PROGRAM
@@ -70,6 +138,21 @@ const (
 	OP_OS_WRITE_I8_SLICE
 	OP_OS_RUN
 	OP_OS_EXIT
+	OP_OS_STAT
+	OP_OS_READ_DIR
+	OP_OS_MKDIR
+	OP_OS_MKDIR_ALL
+	OP_OS_REMOVE
+	OP_OS_REMOVE_ALL
+	OP_OS_RENAME
+	OP_OS_GETENV
+	OP_OS_LOOKUP_ENV
+	OP_OS_SETENV
+	OP_OS_UNSETENV
+	OP_OS_ENVIRON
+	OP_OS_TEMP_DIR
+	OP_OS_CREATE_TEMP
+	OP_OS_MKDIR_TEMP
 
 	// json
 	OP_JSON_OPEN
@@ -82,6 +165,15 @@ const (
 	OP_JSON_TOKEN_F64
 	OP_JSON_TOKEN_I64
 	OP_JSON_TOKEN_STR
+	OP_JSON_MARSHAL
+	OP_JSON_MARSHAL_INDENT
+	OP_JSON_MARSHAL_BYTES
+	OP_JSON_UNMARSHAL
+	OP_JSON_UNMARSHAL_BYTES
+	OP_JSON_SET_NAMING
+	OP_JSON_SET_FIELD_NAME
+	OP_JSON_DISALLOW_UNKNOWN_FIELDS
+	OP_JSON_REQUIRE_FIELDS
 
 	// profile
 	OP_START_CPU_PROFILE
@@ -89,23 +181,446 @@ const (
 
 	// object explorer
 	OP_OBJ_QUERY
+	OP_EXPLORER_PACKAGES
+	OP_EXPLORER_FUNCTIONS
+	OP_EXPLORER_STRUCTS
+	OP_EXPLORER_GLOBALS
+	OP_EXPLORER_FIELDS
+	OP_EXPLORER_FIELD_TYPES
+	OP_EXPLORER_INPUTS
+	OP_EXPLORER_OUTPUTS
+	OP_EXPLORER_TYPE_OF
+	OP_EXPLORER_GET_FIELD
+	OP_EXPLORER_SET_FIELD
+	OP_EXPLORER_GET_GLOBAL
+	OP_EXPLORER_SET_GLOBAL
+	OP_EXPLORER_CALL
 
 	// regexp
 	OP_REGEXP_COMPILE
 	OP_REGEXP_MUST_COMPILE
 	OP_REGEXP_FIND
+	OP_REGEXP_MATCH_STRING
+	OP_REGEXP_QUOTE_META
+	OP_REGEXP_REGEXP_MATCH_STRING
+	OP_REGEXP_FIND_INDEX
+	OP_REGEXP_FIND_ALL
+	OP_REGEXP_FIND_SUBMATCH
+	OP_REGEXP_SUBEXP_NAMES
+	OP_REGEXP_SUBEXP_INDEX
+	OP_REGEXP_REPLACE_ALL
+	OP_REGEXP_REPLACE_ALL_FUNC
+	OP_REGEXP_SPLIT
 
 	// cipher
 	OP_CIPHER_GENERATE_KEY_PAIR
+	OP_CIPHER_GENERATE_DETERMINISTIC_KEY_PAIR
+	OP_CIPHER_DETERMINISTIC_KEY_PAIR_ITERATOR
+	OP_CIPHER_PUB_KEY_FROM_SEC_KEY
+	OP_CIPHER_PUB_KEY_HEX
+	OP_CIPHER_PUB_KEY_FROM_HEX
+	OP_CIPHER_SEC_KEY_HEX
+	OP_CIPHER_SEC_KEY_FROM_HEX
+	OP_CIPHER_SUM_SHA256
+	OP_CIPHER_DOUBLE_SHA256
+	OP_CIPHER_HASH_RIPEMD160
+	OP_CIPHER_SHA256_HEX
+	OP_CIPHER_SHA256_FROM_HEX
+	OP_CIPHER_SIGN_HASH
+	OP_CIPHER_VERIFY_SIGNATURE
+	OP_CIPHER_VERIFY_ADDRESS_SIGNATURE
+	OP_CIPHER_PUB_KEY_FROM_SIG
+	OP_CIPHER_SIG_HEX
+	OP_CIPHER_SIG_FROM_HEX
+	OP_CIPHER_ADDRESS_FROM_PUB_KEY
+	OP_CIPHER_ADDRESS_FROM_SEC_KEY
+	OP_CIPHER_ADDRESS_STRING
+	OP_CIPHER_DECODE_BASE58_ADDRESS
+	OP_CIPHER_VERIFY_ADDRESS
+	OP_CIPHER_BASE58_ENCODE
+	OP_CIPHER_BASE58_DECODE
+
+	// strings
+	OP_STRINGS_SPLIT
+	OP_STRINGS_FIELDS
+	OP_STRINGS_JOIN
+	OP_STRINGS_REPLACE
+	OP_STRINGS_REPLACE_ALL
+	OP_STRINGS_REPEAT
+	OP_STRINGS_CONTAINS
+	OP_STRINGS_HAS_PREFIX
+	OP_STRINGS_HAS_SUFFIX
+	OP_STRINGS_EQUAL_FOLD
+	OP_STRINGS_COUNT
+	OP_STRINGS_TO_UPPER
+	OP_STRINGS_TO_LOWER
+	OP_STRINGS_TRIM
+	OP_STRINGS_TRIM_LEFT
+	OP_STRINGS_TRIM_RIGHT
+	OP_STRINGS_TRIM_PREFIX
+	OP_STRINGS_TRIM_SUFFIX
+	OP_STRINGS_TRIM_SPACE
+	OP_STRINGS_BUILDER_WRITE_STRING
+	OP_STRINGS_BUILDER_WRITE_BYTE
+	OP_STRINGS_BUILDER_LEN
+	OP_STRINGS_BUILDER_STRING
+	OP_STRINGS_BUILDER_RESET
+
+	// math
+	OP_MATH_TAN
+	OP_MATH_ATAN
+	OP_MATH_EXP
+	OP_MATH_FLOOR
+	OP_MATH_CEIL
+	OP_MATH_ROUND
+	OP_MATH_TRUNC
+	OP_MATH_ATAN2
+	OP_MATH_HYPOT
+	OP_MATH_COPYSIGN
+	OP_MATH_INF
+	OP_MATH_IS_INF
+	OP_MATH_NAN
+	OP_MATH_TAN_F32
+	OP_MATH_ATAN_F32
+	OP_MATH_EXP_F32
+	OP_MATH_FLOOR_F32
+	OP_MATH_CEIL_F32
+	OP_MATH_ROUND_F32
+	OP_MATH_TRUNC_F32
+	OP_MATH_ATAN2_F32
+	OP_MATH_HYPOT_F32
+	OP_MATH_COPYSIGN_F32
+	OP_MATH_INF_F32
+	OP_MATH_IS_INF_F32
+	OP_MATH_NAN_F32
+	OP_MATH_ONES_COUNT_8
+	OP_MATH_LEADING_ZEROS_8
+	OP_MATH_TRAILING_ZEROS_8
+	OP_MATH_ROTATE_LEFT_8
+	OP_MATH_ONES_COUNT_16
+	OP_MATH_LEADING_ZEROS_16
+	OP_MATH_TRAILING_ZEROS_16
+	OP_MATH_ROTATE_LEFT_16
+	OP_MATH_ONES_COUNT_32
+	OP_MATH_LEADING_ZEROS_32
+	OP_MATH_TRAILING_ZEROS_32
+	OP_MATH_ROTATE_LEFT_32
+	OP_MATH_ONES_COUNT_64
+	OP_MATH_LEADING_ZEROS_64
+	OP_MATH_TRAILING_ZEROS_64
+	OP_MATH_ROTATE_LEFT_64
+
+	// big
+	OP_BIG_NEW_INT
+	OP_BIG_NEW_INT_UI64
+	OP_BIG_PARSE_INT
+	OP_BIG_INT_STRING
+	OP_BIG_INT_TEXT
+	OP_BIG_INT_ADD
+	OP_BIG_INT_SUB
+	OP_BIG_INT_MUL
+	OP_BIG_INT_QUO
+	OP_BIG_INT_REM
+	OP_BIG_INT_DIV
+	OP_BIG_INT_MOD
+	OP_BIG_INT_EXP
+	OP_BIG_INT_NEG
+	OP_BIG_INT_ABS
+	OP_BIG_INT_CMP
+	OP_BIG_INT_SIGN
+	OP_BIG_INT_I64
+	OP_BIG_INT_UI64
+	OP_BIG_INT_F64
+	OP_BIG_INT_DEC
+	OP_BIG_NEW_DEC
+	OP_BIG_PARSE_DEC
+	OP_BIG_DEC_STRING
+	OP_BIG_DEC_SCALE
+	OP_BIG_DEC_ADD
+	OP_BIG_DEC_SUB
+	OP_BIG_DEC_MUL
+	OP_BIG_DEC_QUO
+	OP_BIG_DEC_ROUND
+	OP_BIG_DEC_NEG
+	OP_BIG_DEC_ABS
+	OP_BIG_DEC_CMP
+	OP_BIG_DEC_SIGN
+	OP_BIG_DEC_INT
+	OP_BIG_DEC_F64
+
+	// utf8
+	OP_UTF8_RUNE_COUNT
+	OP_UTF8_RUNE_LEN
+	OP_UTF8_DECODE_RUNE
+	OP_UTF8_DECODE_LAST_RUNE
+	OP_UTF8_ENCODE_RUNE
+	OP_UTF8_VALID_STRING
+	OP_UTF8_VALID_RUNE
+	OP_UTF8_RUNES
+	OP_UTF8_FROM_RUNES
+	OP_UTF8_BYTES
+	OP_UTF8_FROM_BYTES
+
+	// sort
+	OP_SORT_INTS
+	OP_SORT_FLOATS
+	OP_SORT_STRS
+	OP_SORT_SLICE
+
+	// slices
+	OP_SLICES_REVERSE
+	OP_SLICES_BINARY_SEARCH
+	OP_SLICES_CONTAINS
+	OP_SLICES_INDEX_OF
+	OP_SLICES_EQUAL
+	OP_SLICES_CLONE
+
+	// base64
+	OP_BASE64_ENCODE
+	OP_BASE64_DECODE
+	OP_BASE64_ENCODE_STR
+	OP_BASE64_DECODE_STR
+	OP_BASE64_READ_FILE
+	OP_BASE64_WRITE_FILE
+
+	// hex
+	OP_HEX_ENCODE
+	OP_HEX_DECODE
+	OP_HEX_ENCODE_STR
+	OP_HEX_DECODE_STR
+	OP_HEX_DUMP
+	OP_HEX_READ_FILE
+	OP_HEX_WRITE_FILE
+
+	// csv
+	OP_CSV_PARSE
+	OP_CSV_FORMAT
+	OP_CSV_READ_FILE
+	OP_CSV_WRITE_FILE
+
+	// binary
+	OP_BINARY_UI16
+	OP_BINARY_UI32
+	OP_BINARY_UI64
+	OP_BINARY_I16
+	OP_BINARY_I32
+	OP_BINARY_I64
+	OP_BINARY_F32
+	OP_BINARY_F64
+	OP_BINARY_PUT_UI16
+	OP_BINARY_PUT_UI32
+	OP_BINARY_PUT_UI64
+	OP_BINARY_PUT_I16
+	OP_BINARY_PUT_I32
+	OP_BINARY_PUT_I64
+	OP_BINARY_PUT_F32
+	OP_BINARY_PUT_F64
+
+	// crypto
+	OP_CRYPTO_HMAC_SHA256
+	OP_CRYPTO_EQUAL
+	OP_CRYPTO_RANDOM_BYTES
+
+	// filepath
+	OP_FILEPATH_JOIN
+	OP_FILEPATH_DIR
+	OP_FILEPATH_BASE
+	OP_FILEPATH_EXT
+	OP_FILEPATH_CLEAN
+	OP_FILEPATH_SPLIT
+	OP_FILEPATH_IS_ABS
+	OP_FILEPATH_ABS
+	OP_FILEPATH_REL
+	OP_FILEPATH_MATCH
+	OP_FILEPATH_GLOB
+	OP_FILEPATH_WALK
+
+	// exec
+	OP_EXEC_COMMAND
+	OP_EXEC_SET_DIR
+	OP_EXEC_SET_ENV
+	OP_EXEC_ADD_ENV
+	OP_EXEC_SET_STDIN
+	OP_EXEC_STDIN_PIPE
+	OP_EXEC_WRITE_STDIN
+	OP_EXEC_CLOSE_STDIN
+	OP_EXEC_START
+	OP_EXEC_WAIT
+	OP_EXEC_RUN
+	OP_EXEC_RUNNING
+	OP_EXEC_KILL
+	OP_EXEC_PID
+	OP_EXEC_READ_STDOUT
+	OP_EXEC_READ_STDERR
+	OP_EXEC_STDOUT
+	OP_EXEC_STDERR
+	OP_EXEC_RELEASE
+	OP_EXEC_LOOK_PATH
+
+	// net
+	OP_NET_DIAL
+	OP_NET_DIAL_TIMEOUT
+	OP_NET_LISTEN
+	OP_NET_ACCEPT
+	OP_NET_LISTEN_PACKET
+	OP_NET_CLOSE
+	OP_NET_LOCAL_ADDR
+	OP_NET_REMOTE_ADDR
+	OP_NET_SET_DEADLINE
+	OP_NET_SET_READ_DEADLINE
+	OP_NET_SET_WRITE_DEADLINE
+	OP_NET_READ
+	OP_NET_READ_STR
+	OP_NET_READ_LINE
+	OP_NET_WRITE
+	OP_NET_WRITE_STR
+	OP_NET_READ_FROM
+	OP_NET_WRITE_TO
+	OP_NET_ERROR
+	OP_NET_TIMEOUT
+	OP_NET_EOF
+
+	OP_HTTP_HEADER_GET
+	OP_HTTP_HEADER_VALUES
+	OP_HTTP_HEADER_SET
+	OP_HTTP_HEADER_ADD
+	OP_HTTP_HEADER_DEL
+	OP_HTTP_HEADER_KEYS
+	OP_HTTP_RESPONSE_WRITER_HEADER
+	OP_HTTP_RESPONSE_WRITER_WRITE_HEADER
+	OP_HTTP_RESPONSE_WRITER_WRITE
+	OP_HTTP_RESPONSE_WRITER_WRITE_STR
+	OP_HTTP_ERROR
+	OP_HTTP_REDIRECT
+	OP_HTTP_SERVER_REQUEST_METHOD
+	OP_HTTP_SERVER_REQUEST_URL
+	OP_HTTP_SERVER_REQUEST_PATH
+	OP_HTTP_SERVER_REQUEST_HOST
+	OP_HTTP_SERVER_REQUEST_REMOTE_ADDR
+	OP_HTTP_SERVER_REQUEST_HEADER
+	OP_HTTP_SERVER_REQUEST_QUERY
+	OP_HTTP_SERVER_REQUEST_QUERY_VALUES
+	OP_HTTP_SERVER_REQUEST_FORM_VALUE
+	OP_HTTP_SERVER_REQUEST_POST_FORM_VALUE
+	OP_HTTP_SERVER_REQUEST_PATH_PARAM
+	OP_HTTP_SERVER_REQUEST_CONTENT_LENGTH
+	OP_HTTP_SERVER_REQUEST_READ
+	OP_HTTP_SERVER_REQUEST_READ_STR
+	OP_HTTP_SERVER_REQUEST_BODY
+	OP_HTTP_NEW_ROUTER
+	OP_HTTP_ROUTER_HANDLE_FUNC
+	OP_HTTP_ROUTER_METHOD_FUNC
+	OP_HTTP_ROUTER_USE
+	OP_HTTP_ROUTER_MOUNT
+	OP_HTTP_ROUTER_NOT_FOUND
+	OP_HTTP_NEW_SERVER
+	OP_HTTP_SERVER_START
+	OP_HTTP_SERVER_ADDR
+	OP_HTTP_SERVER_RUN
+	OP_HTTP_SERVER_SHUTDOWN
+	OP_HTTP_SERVER_CLOSE
+	OP_HTTP_POLL
+
+	OP_HTTP_NEW_HEADER
+	OP_HTTP_REQUEST_SET_BASIC_AUTH
+	OP_HTTP_REQUEST_SET_BEARER_AUTH
+	OP_HTTP_REQUEST_ADD_COOKIE
+	OP_HTTP_NEW_CLIENT
+	OP_HTTP_CLIENT_SET_TIMEOUT
+	OP_HTTP_CLIENT_SET_MAX_REDIRECTS
+	OP_HTTP_CLIENT_DO
+	OP_HTTP_CLIENT_GET
+	OP_HTTP_CLIENT_DOWNLOAD
+	OP_HTTP_CLIENT_SET_COOKIE
+	OP_HTTP_CLIENT_COOKIE
+	OP_HTTP_NEW_DMSG_CLIENT
+	OP_HTTP_DMSG_CLIENT_PUBLIC_KEY
+	OP_HTTP_DMSG_CLIENT_CLOSE
+	OP_HTTP_DMSG_SERVE
 
 	END_OF_BASE_OPS
 )
 
 func init() {
 	// time
+	timeArg := Struct("time", "Time", "t")
+	durationArg := Struct("time", "Duration", "d")
+	locationArg := Struct("time", "Location", "loc")
+	timerArg := Struct("time", "Timer", "timer")
+	tickerArg := Struct("time", "Ticker", "ticker")
+	timerFn := Param(TYPE_FUNC)
+
 	Op(OP_TIME_SLEEP, "time.Sleep", opTimeSleep, In(AI32), nil)
 	Op(OP_TIME_UNIX_MILLI, "time.UnixMilli", opTimeUnixMilli, nil, Out(AI64))
 	Op(OP_TIME_UNIX_NANO, "time.UnixNano", opTimeUnixNano, nil, Out(AI64))
+	Op(OP_TIME_SLEEP_FOR, "time.SleepFor", opTimeSleepFor, In(durationArg), nil)
+	Op(OP_TIME_NOW, "time.Now", opTimeNow, nil, Out(timeArg))
+	Op(OP_TIME_UNIX, "time.Unix", opTimeUnix, In(AI64, AI64), Out(timeArg))
+	Op(OP_TIME_DATE, "time.Date", opTimeDate, In(AI32, AI32, AI32, AI32, AI32, AI32, AI32, locationArg), Out(timeArg))
+	Op(OP_TIME_SINCE, "time.Since", opTimeSince, In(timeArg), Out(durationArg))
+	Op(OP_TIME_UNTIL, "time.Until", opTimeUntil, In(timeArg), Out(durationArg))
+	Op(OP_TIME_PARSE, "time.Parse", opTimeParse, In(ASTR, ASTR), Out(timeArg, ABOOL))
+	Op(OP_TIME_PARSE_IN_LOCATION, "time.ParseInLocation", opTimeParseInLocation, In(ASTR, ASTR, locationArg), Out(timeArg, ABOOL))
+	Op(OP_TIME_LOAD_LOCATION, "time.LoadLocation", opTimeLoadLocation, In(ASTR), Out(locationArg, ABOOL))
+	Op(OP_TIME_FIXED_ZONE, "time.FixedZone", opTimeFixedZone, In(ASTR, AI32), Out(locationArg))
+	Op(OP_TIME_LOCATION_STRING, "time.Location.String", opTimeLocationString, In(locationArg), Out(ASTR))
+	Op(OP_TIME_MONTH_NAME, "time.MonthName", opTimeMonthName, In(AI32), Out(ASTR))
+	Op(OP_TIME_WEEKDAY_NAME, "time.WeekdayName", opTimeWeekdayName, In(AI32), Out(ASTR))
+	Op(OP_TIME_TIME_ADD, "time.Time.Add", opTimeTimeAdd, In(timeArg, durationArg), Out(timeArg))
+	Op(OP_TIME_TIME_SUB, "time.Time.Sub", opTimeTimeSub, In(timeArg, timeArg), Out(durationArg))
+	Op(OP_TIME_TIME_ADD_DATE, "time.Time.AddDate", opTimeTimeAddDate, In(timeArg, AI32, AI32, AI32), Out(timeArg))
+	Op(OP_TIME_TIME_BEFORE, "time.Time.Before", opTimeTimeBefore, In(timeArg, timeArg), Out(ABOOL))
+	Op(OP_TIME_TIME_AFTER, "time.Time.After", opTimeTimeAfter, In(timeArg, timeArg), Out(ABOOL))
+	Op(OP_TIME_TIME_EQUAL, "time.Time.Equal", opTimeTimeEqual, In(timeArg, timeArg), Out(ABOOL))
+	Op(OP_TIME_TIME_COMPARE, "time.Time.Compare", opTimeTimeCompare, In(timeArg, timeArg), Out(AI32))
+	Op(OP_TIME_TIME_IS_ZERO, "time.Time.IsZero", opTimeTimeIsZero, In(timeArg), Out(ABOOL))
+	Op(OP_TIME_TIME_YEAR, "time.Time.Year", opTimeTimeYear, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_MONTH, "time.Time.Month", opTimeTimeMonth, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_DAY, "time.Time.Day", opTimeTimeDay, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_HOUR, "time.Time.Hour", opTimeTimeHour, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_MINUTE, "time.Time.Minute", opTimeTimeMinute, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_SECOND, "time.Time.Second", opTimeTimeSecond, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_NANOSECOND, "time.Time.Nanosecond", opTimeTimeNanosecond, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_WEEKDAY, "time.Time.Weekday", opTimeTimeWeekday, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_YEAR_DAY, "time.Time.YearDay", opTimeTimeYearDay, In(timeArg), Out(AI32))
+	Op(OP_TIME_TIME_UNIX, "time.Time.Unix", opTimeTimeUnix, In(timeArg), Out(AI64))
+	Op(OP_TIME_TIME_UNIX_MILLI, "time.Time.UnixMilli", opTimeTimeUnixMilli, In(timeArg), Out(AI64))
+	Op(OP_TIME_TIME_UNIX_NANO, "time.Time.UnixNano", opTimeTimeUnixNano, In(timeArg), Out(AI64))
+	Op(OP_TIME_TIME_FORMAT, "time.Time.Format", opTimeTimeFormat, In(timeArg, ASTR), Out(ASTR))
+	Op(OP_TIME_TIME_STRING, "time.Time.String", opTimeTimeString, In(timeArg), Out(ASTR))
+	Op(OP_TIME_TIME_UTC, "time.Time.UTC", opTimeTimeUTC, In(timeArg), Out(timeArg))
+	Op(OP_TIME_TIME_LOCAL, "time.Time.Local", opTimeTimeLocal, In(timeArg), Out(timeArg))
+	Op(OP_TIME_TIME_IN, "time.Time.In", opTimeTimeIn, In(timeArg, locationArg), Out(timeArg))
+	Op(OP_TIME_TIME_LOCATION, "time.Time.Location", opTimeTimeLocation, In(timeArg), Out(locationArg))
+	Op(OP_TIME_TIME_ZONE, "time.Time.Zone", opTimeTimeZone, In(timeArg), Out(ASTR, AI32))
+	Op(OP_TIME_TIME_TRUNCATE, "time.Time.Truncate", opTimeTimeTruncate, In(timeArg, durationArg), Out(timeArg))
+	Op(OP_TIME_TIME_ROUND, "time.Time.Round", opTimeTimeRound, In(timeArg, durationArg), Out(timeArg))
+	Op(OP_TIME_NEW_DURATION, "time.NewDuration", opTimeNewDuration, In(AI64), Out(durationArg))
+	Op(OP_TIME_PARSE_DURATION, "time.ParseDuration", opTimeParseDuration, In(ASTR), Out(durationArg, ABOOL))
+	Op(OP_TIME_DURATION_NANOSECONDS, "time.Duration.Nanoseconds", opTimeDurationNanoseconds, In(durationArg), Out(AI64))
+	Op(OP_TIME_DURATION_MICROSECONDS, "time.Duration.Microseconds", opTimeDurationMicroseconds, In(durationArg), Out(AI64))
+	Op(OP_TIME_DURATION_MILLISECONDS, "time.Duration.Milliseconds", opTimeDurationMilliseconds, In(durationArg), Out(AI64))
+	Op(OP_TIME_DURATION_SECONDS, "time.Duration.Seconds", opTimeDurationSeconds, In(durationArg), Out(AF64))
+	Op(OP_TIME_DURATION_MINUTES, "time.Duration.Minutes", opTimeDurationMinutes, In(durationArg), Out(AF64))
+	Op(OP_TIME_DURATION_HOURS, "time.Duration.Hours", opTimeDurationHours, In(durationArg), Out(AF64))
+	Op(OP_TIME_DURATION_STRING, "time.Duration.String", opTimeDurationString, In(durationArg), Out(ASTR))
+	Op(OP_TIME_DURATION_TRUNCATE, "time.Duration.Truncate", opTimeDurationTruncate, In(durationArg, durationArg), Out(durationArg))
+	Op(OP_TIME_DURATION_ROUND, "time.Duration.Round", opTimeDurationRound, In(durationArg, durationArg), Out(durationArg))
+	Op(OP_TIME_DURATION_ABS, "time.Duration.Abs", opTimeDurationAbs, In(durationArg), Out(durationArg))
+	Op(OP_TIME_NEW_TIMER, "time.NewTimer", opTimeNewTimer, In(durationArg), Out(timerArg))
+	Op(OP_TIME_AFTER_FUNC, "time.AfterFunc", opTimeAfterFunc, In(durationArg, timerFn), Out(timerArg))
+	Op(OP_TIME_TIMER_EXPIRED, "time.Timer.Expired", opTimeTimerExpired, In(timerArg), Out(ABOOL))
+	Op(OP_TIME_TIMER_WAIT, "time.Timer.Wait", opTimeTimerWait, In(timerArg), nil)
+	Op(OP_TIME_TIMER_STOP, "time.Timer.Stop", opTimeTimerStop, In(timerArg), Out(ABOOL))
+	Op(OP_TIME_TIMER_RESET, "time.Timer.Reset", opTimeTimerReset, In(timerArg, durationArg), Out(ABOOL))
+	Op(OP_TIME_NEW_TICKER, "time.NewTicker", opTimeNewTicker, In(durationArg), Out(tickerArg))
+	Op(OP_TIME_TICK_FUNC, "time.TickFunc", opTimeTickFunc, In(durationArg, timerFn), Out(tickerArg))
+	Op(OP_TIME_TICKER_TICK, "time.Ticker.Tick", opTimeTickerTick, In(tickerArg), Out(ABOOL))
+	Op(OP_TIME_TICKER_WAIT, "time.Ticker.Wait", opTimeTickerWait, In(tickerArg), nil)
+	Op(OP_TIME_TICKER_STOP, "time.Ticker.Stop", opTimeTickerStop, In(tickerArg), nil)
+	Op(OP_TIME_TICKER_RESET, "time.Ticker.Reset", opTimeTickerReset, In(tickerArg, durationArg), nil)
+	Op(OP_TIME_RUN_TIMERS, "time.RunTimers", opTimeRunTimers, nil, Out(AI32))
+	Op(OP_TIME_RUN, "time.Run", opTimeRun, nil, nil)
 
 	// http
 	// Op(OP_HTTP_GET, "http.Get", opHttpGet, In(ASTR), Out(ASTR))
@@ -166,6 +681,22 @@ func init() {
 	Op(OP_OS_RUN, "os.Run", opOsRun, In(ASTR, AI32, AI32, ASTR), Out(AI32, AI32, ASTR))
 	Op(OP_OS_EXIT, "os.Exit", opOsExit, In(AI32), nil)
 
+	Op(OP_OS_STAT, "os.Stat", opOsStat, In(ASTR), Out(Struct("os", "FileInfo", "info"), ABOOL))
+	Op(OP_OS_READ_DIR, "os.ReadDir", opOsReadDir, In(ASTR), Out(Slice(TYPE_STR), ABOOL))
+	Op(OP_OS_MKDIR, "os.Mkdir", opOsMkdir, In(ASTR, AI32), Out(ABOOL))
+	Op(OP_OS_MKDIR_ALL, "os.MkdirAll", opOsMkdirAll, In(ASTR, AI32), Out(ABOOL))
+	Op(OP_OS_REMOVE, "os.Remove", opOsRemove, In(ASTR), Out(ABOOL))
+	Op(OP_OS_REMOVE_ALL, "os.RemoveAll", opOsRemoveAll, In(ASTR), Out(ABOOL))
+	Op(OP_OS_RENAME, "os.Rename", opOsRename, In(ASTR, ASTR), Out(ABOOL))
+	Op(OP_OS_GETENV, "os.Getenv", opOsGetenv, In(ASTR), Out(ASTR))
+	Op(OP_OS_LOOKUP_ENV, "os.LookupEnv", opOsLookupEnv, In(ASTR), Out(ASTR, ABOOL))
+	Op(OP_OS_SETENV, "os.Setenv", opOsSetenv, In(ASTR, ASTR), Out(ABOOL))
+	Op(OP_OS_UNSETENV, "os.Unsetenv", opOsUnsetenv, In(ASTR), Out(ABOOL))
+	Op(OP_OS_ENVIRON, "os.Environ", opOsEnviron, nil, Out(Slice(TYPE_STR)))
+	Op(OP_OS_TEMP_DIR, "os.TempDir", opOsTempDir, nil, Out(ASTR))
+	Op(OP_OS_CREATE_TEMP, "os.CreateTemp", opOsCreateTemp, In(ASTR, ASTR), Out(AI32, ASTR))
+	Op(OP_OS_MKDIR_TEMP, "os.MkdirTemp", opOsMkdirTemp, In(ASTR, ASTR), Out(ASTR, ABOOL))
+
 	// json
 	Op(OP_JSON_OPEN, "json.Open", opJsonOpen, In(ASTR), Out(AI32))
 	Op(OP_JSON_CLOSE, "json.Close", opJsonClose, In(AI32), Out(ABOOL))
@@ -177,16 +708,428 @@ func init() {
 	Op(OP_JSON_TOKEN_F64, "json.Float64", opJsonTokenF64, In(AI32), Out(AF64, ABOOL))
 	Op(OP_JSON_TOKEN_I64, "json.Int64", opJsonTokenI64, In(AI32), Out(AI64, ABOOL))
 	Op(OP_JSON_TOKEN_STR, "json.Str", opJsonTokenStr, In(AI32), Out(ASTR, ABOOL))
+	Op(OP_JSON_MARSHAL, "json.Marshal", opJsonMarshal, In(AUND), Out(ASTR, ASTR))
+	Op(OP_JSON_MARSHAL_INDENT, "json.MarshalIndent", opJsonMarshalIndent, In(AUND, ASTR, ASTR), Out(ASTR, ASTR))
+	Op(OP_JSON_MARSHAL_BYTES, "json.MarshalBytes", opJsonMarshalBytes, In(AUND), Out(Slice(TYPE_UI8), ASTR))
+	Op(OP_JSON_UNMARSHAL, "json.Unmarshal", opJsonUnmarshal, In(ASTR, AUND), Out(ASTR))
+	Op(OP_JSON_UNMARSHAL_BYTES, "json.UnmarshalBytes", opJsonUnmarshalBytes, In(Slice(TYPE_UI8), AUND), Out(ASTR))
+	Op(OP_JSON_SET_NAMING, "json.SetNaming", opJsonSetNaming, In(AI32), nil)
+	Op(OP_JSON_SET_FIELD_NAME, "json.SetFieldName", opJsonSetFieldName, In(ASTR, ASTR, ASTR), nil)
+	Op(OP_JSON_DISALLOW_UNKNOWN_FIELDS, "json.DisallowUnknownFields", opJsonDisallowUnknownFields, In(ABOOL), nil)
+	Op(OP_JSON_REQUIRE_FIELDS, "json.RequireFields", opJsonRequireFields, In(ABOOL), nil)
 
 	// profile
 	Op(OP_START_CPU_PROFILE, "StartCPUProfile", opStartProfile, In(ASTR, AI32), nil)
 	Op(OP_STOP_CPU_PROFILE, "StopCPUProfile", opStopProfile, In(ASTR), nil)
 
+	// explorer
+	Op(OP_EXPLORER_PACKAGES, "explorer.Packages", opExplorerPackages, nil, Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_FUNCTIONS, "explorer.Functions", opExplorerFunctions, In(ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_STRUCTS, "explorer.Structs", opExplorerStructs, In(ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_GLOBALS, "explorer.Globals", opExplorerGlobals, In(ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_FIELDS, "explorer.Fields", opExplorerFields, In(ASTR, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_FIELD_TYPES, "explorer.FieldTypes", opExplorerFieldTypes, In(ASTR, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_INPUTS, "explorer.Inputs", opExplorerInputs, In(ASTR, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_OUTPUTS, "explorer.Outputs", opExplorerOutputs, In(ASTR, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_EXPLORER_TYPE_OF, "explorer.TypeOf", opExplorerTypeOf, In(AUND), Out(ASTR))
+	Op(OP_EXPLORER_GET_FIELD, "explorer.GetField", opExplorerGetField, In(AUND, ASTR), Out(ASTR, ASTR))
+	Op(OP_EXPLORER_SET_FIELD, "explorer.SetField", opExplorerSetField, In(AUND, ASTR, ASTR), Out(ASTR))
+	Op(OP_EXPLORER_GET_GLOBAL, "explorer.GetGlobal", opExplorerGetGlobal, In(ASTR, ASTR), Out(ASTR, ASTR))
+	Op(OP_EXPLORER_SET_GLOBAL, "explorer.SetGlobal", opExplorerSetGlobal, In(ASTR, ASTR, ASTR), Out(ASTR))
+	Op(OP_EXPLORER_CALL, "explorer.Call", opExplorerCall, In(ASTR, ASTR, Slice(TYPE_STR)), Out(Slice(TYPE_STR), ASTR))
+
 	// regexp
-	Op(OP_REGEXP_COMPILE, "regexp.Compile", opRegexpCompile, In(ASTR), Out(Struct("regexp", "Regexp", "r"), ASTR))
-	Op(OP_REGEXP_MUST_COMPILE, "regexp.MustCompile", opRegexpMustCompile, In(ASTR), Out(Struct("regexp", "Regexp", "r")))
-	Op(OP_REGEXP_FIND, "regexp.Regexp.Find", opRegexpFind, In(Struct("regexp", "Regexp", "r"), ASTR), Out(ASTR))
+	re := Struct("regexp", "Regexp", "r")
+	replaceFn := Param(TYPE_FUNC)
+	replaceFn.Inputs = In(ASTR)
+	replaceFn.Outputs = Out(ASTR)
+
+	Op(OP_REGEXP_COMPILE, "regexp.Compile", opRegexpCompile, In(ASTR), Out(re, ASTR))
+	Op(OP_REGEXP_MUST_COMPILE, "regexp.MustCompile", opRegexpMustCompile, In(ASTR), Out(re))
+	Op(OP_REGEXP_FIND, "regexp.Regexp.Find", opRegexpFind, In(re, ASTR), Out(ASTR))
+	Op(OP_REGEXP_MATCH_STRING, "regexp.MatchString", opRegexpMatchString, In(ASTR, ASTR), Out(ABOOL, ASTR))
+	Op(OP_REGEXP_QUOTE_META, "regexp.QuoteMeta", opRegexpQuoteMeta, In(ASTR), Out(ASTR))
+	Op(OP_REGEXP_REGEXP_MATCH_STRING, "regexp.Regexp.MatchString", opRegexpMatchStringMethod, In(re, ASTR), Out(ABOOL))
+	Op(OP_REGEXP_FIND_INDEX, "regexp.Regexp.FindIndex", opRegexpFindIndex, In(re, ASTR), Out(Slice(TYPE_I32)))
+	Op(OP_REGEXP_FIND_ALL, "regexp.Regexp.FindAll", opRegexpFindAll, In(re, ASTR, AI32), Out(Slice(TYPE_STR)))
+	Op(OP_REGEXP_FIND_SUBMATCH, "regexp.Regexp.FindSubmatch", opRegexpFindSubmatch, In(re, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_REGEXP_SUBEXP_NAMES, "regexp.Regexp.SubexpNames", opRegexpSubexpNames, In(re), Out(Slice(TYPE_STR)))
+	Op(OP_REGEXP_SUBEXP_INDEX, "regexp.Regexp.SubexpIndex", opRegexpSubexpIndex, In(re, ASTR), Out(AI32))
+	Op(OP_REGEXP_REPLACE_ALL, "regexp.Regexp.ReplaceAll", opRegexpReplaceAll, In(re, ASTR, ASTR), Out(ASTR))
+	Op(OP_REGEXP_REPLACE_ALL_FUNC, "regexp.Regexp.ReplaceAllFunc", opRegexpReplaceAllFunc, In(re, ASTR, replaceFn), Out(ASTR))
+	Op(OP_REGEXP_SPLIT, "regexp.Regexp.Split", opRegexpSplit, In(re, ASTR, AI32), Out(Slice(TYPE_STR)))
 
 	// cipher
 	Op(OP_CIPHER_GENERATE_KEY_PAIR, "cipher.GenerateKeyPair", opCipherGenerateKeyPair, nil, Out(Struct("cipher", "PubKey", "pubKey"), Struct("cipher", "SecKey", "sec")))
+	Op(OP_CIPHER_GENERATE_DETERMINISTIC_KEY_PAIR, "cipher.GenerateDeterministicKeyPair", opCipherGenerateDeterministicKeyPair, In(Slice(TYPE_UI8)), Out(Struct("cipher", "PubKey", "pubKey"), Struct("cipher", "SecKey", "sec"), ABOOL))
+	Op(OP_CIPHER_DETERMINISTIC_KEY_PAIR_ITERATOR, "cipher.DeterministicKeyPairIterator", opCipherDeterministicKeyPairIterator, In(Slice(TYPE_UI8)), Out(Slice(TYPE_UI8), Struct("cipher", "PubKey", "pubKey"), Struct("cipher", "SecKey", "sec"), ABOOL))
+	Op(OP_CIPHER_PUB_KEY_FROM_SEC_KEY, "cipher.PubKeyFromSecKey", opCipherPubKeyFromSecKey, In(Struct("cipher", "SecKey", "sec")), Out(Struct("cipher", "PubKey", "pubKey"), ABOOL))
+	Op(OP_CIPHER_PUB_KEY_HEX, "cipher.PubKeyHex", opCipherPubKeyHex, In(Struct("cipher", "PubKey", "pubKey")), Out(ASTR))
+	Op(OP_CIPHER_PUB_KEY_FROM_HEX, "cipher.PubKeyFromHex", opCipherPubKeyFromHex, In(ASTR), Out(Struct("cipher", "PubKey", "pubKey"), ABOOL))
+	Op(OP_CIPHER_SEC_KEY_HEX, "cipher.SecKeyHex", opCipherSecKeyHex, In(Struct("cipher", "SecKey", "sec")), Out(ASTR))
+	Op(OP_CIPHER_SEC_KEY_FROM_HEX, "cipher.SecKeyFromHex", opCipherSecKeyFromHex, In(ASTR), Out(Struct("cipher", "SecKey", "sec"), ABOOL))
+	Op(OP_CIPHER_SUM_SHA256, "cipher.SumSHA256", opCipherSumSHA256, In(Slice(TYPE_UI8)), Out(Struct("cipher", "SHA256", "hash")))
+	Op(OP_CIPHER_DOUBLE_SHA256, "cipher.DoubleSHA256", opCipherDoubleSHA256, In(Slice(TYPE_UI8)), Out(Struct("cipher", "SHA256", "hash")))
+	Op(OP_CIPHER_HASH_RIPEMD160, "cipher.HashRipemd160", opCipherHashRipemd160, In(Slice(TYPE_UI8)), Out(Struct("cipher", "Ripemd160", "hash")))
+	Op(OP_CIPHER_SHA256_HEX, "cipher.SHA256Hex", opCipherSHA256Hex, In(Struct("cipher", "SHA256", "hash")), Out(ASTR))
+	Op(OP_CIPHER_SHA256_FROM_HEX, "cipher.SHA256FromHex", opCipherSHA256FromHex, In(ASTR), Out(Struct("cipher", "SHA256", "hash"), ABOOL))
+	Op(OP_CIPHER_SIGN_HASH, "cipher.SignHash", opCipherSignHash, In(Struct("cipher", "SHA256", "hash"), Struct("cipher", "SecKey", "sec")), Out(Struct("cipher", "Sig", "sig"), ABOOL))
+	Op(OP_CIPHER_VERIFY_SIGNATURE, "cipher.VerifySignature", opCipherVerifySignature, In(Struct("cipher", "PubKey", "pubKey"), Struct("cipher", "Sig", "sig"), Struct("cipher", "SHA256", "hash")), Out(ABOOL))
+	Op(OP_CIPHER_VERIFY_ADDRESS_SIGNATURE, "cipher.VerifyAddressSignature", opCipherVerifyAddressSignature, In(Struct("cipher", "Address", "addr"), Struct("cipher", "Sig", "sig"), Struct("cipher", "SHA256", "hash")), Out(ABOOL))
+	Op(OP_CIPHER_PUB_KEY_FROM_SIG, "cipher.PubKeyFromSig", opCipherPubKeyFromSig, In(Struct("cipher", "Sig", "sig"), Struct("cipher", "SHA256", "hash")), Out(Struct("cipher", "PubKey", "pubKey"), ABOOL))
+	Op(OP_CIPHER_SIG_HEX, "cipher.SigHex", opCipherSigHex, In(Struct("cipher", "Sig", "sig")), Out(ASTR))
+	Op(OP_CIPHER_SIG_FROM_HEX, "cipher.SigFromHex", opCipherSigFromHex, In(ASTR), Out(Struct("cipher", "Sig", "sig"), ABOOL))
+	Op(OP_CIPHER_ADDRESS_FROM_PUB_KEY, "cipher.AddressFromPubKey", opCipherAddressFromPubKey, In(Struct("cipher", "PubKey", "pubKey")), Out(Struct("cipher", "Address", "addr")))
+	Op(OP_CIPHER_ADDRESS_FROM_SEC_KEY, "cipher.AddressFromSecKey", opCipherAddressFromSecKey, In(Struct("cipher", "SecKey", "sec")), Out(Struct("cipher", "Address", "addr"), ABOOL))
+	Op(OP_CIPHER_ADDRESS_STRING, "cipher.AddressString", opCipherAddressString, In(Struct("cipher", "Address", "addr")), Out(ASTR))
+	Op(OP_CIPHER_DECODE_BASE58_ADDRESS, "cipher.DecodeBase58Address", opCipherDecodeBase58Address, In(ASTR), Out(Struct("cipher", "Address", "addr"), ABOOL))
+	Op(OP_CIPHER_VERIFY_ADDRESS, "cipher.VerifyAddress", opCipherVerifyAddress, In(Struct("cipher", "Address", "addr"), Struct("cipher", "PubKey", "pubKey")), Out(ABOOL))
+	Op(OP_CIPHER_BASE58_ENCODE, "cipher.Base58Encode", opCipherBase58Encode, In(Slice(TYPE_UI8)), Out(ASTR))
+	Op(OP_CIPHER_BASE58_DECODE, "cipher.Base58Decode", opCipherBase58Decode, In(ASTR), Out(Slice(TYPE_UI8), ABOOL))
+
+	// strings
+	Op(OP_STRINGS_SPLIT, "strings.Split", opStringsSplit, In(ASTR, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_STRINGS_FIELDS, "strings.Fields", opStringsFields, In(ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_STRINGS_JOIN, "strings.Join", opStringsJoin, In(Slice(TYPE_STR), ASTR), Out(ASTR))
+	Op(OP_STRINGS_REPLACE, "strings.Replace", opStringsReplace, In(ASTR, ASTR, ASTR, AI32), Out(ASTR))
+	Op(OP_STRINGS_REPLACE_ALL, "strings.ReplaceAll", opStringsReplaceAll, In(ASTR, ASTR, ASTR), Out(ASTR))
+	Op(OP_STRINGS_REPEAT, "strings.Repeat", opStringsRepeat, In(ASTR, AI32), Out(ASTR))
+	Op(OP_STRINGS_CONTAINS, "strings.Contains", opStringsContains, In(ASTR, ASTR), Out(ABOOL))
+	Op(OP_STRINGS_HAS_PREFIX, "strings.HasPrefix", opStringsHasPrefix, In(ASTR, ASTR), Out(ABOOL))
+	Op(OP_STRINGS_HAS_SUFFIX, "strings.HasSuffix", opStringsHasSuffix, In(ASTR, ASTR), Out(ABOOL))
+	Op(OP_STRINGS_EQUAL_FOLD, "strings.EqualFold", opStringsEqualFold, In(ASTR, ASTR), Out(ABOOL))
+	Op(OP_STRINGS_COUNT, "strings.Count", opStringsCount, In(ASTR, ASTR), Out(AI32))
+	Op(OP_STRINGS_TO_UPPER, "strings.ToUpper", opStringsToUpper, In(ASTR), Out(ASTR))
+	Op(OP_STRINGS_TO_LOWER, "strings.ToLower", opStringsToLower, In(ASTR), Out(ASTR))
+	Op(OP_STRINGS_TRIM, "strings.Trim", opStringsTrim, In(ASTR, ASTR), Out(ASTR))
+	Op(OP_STRINGS_TRIM_LEFT, "strings.TrimLeft", opStringsTrimLeft, In(ASTR, ASTR), Out(ASTR))
+	Op(OP_STRINGS_TRIM_RIGHT, "strings.TrimRight", opStringsTrimRight, In(ASTR, ASTR), Out(ASTR))
+	Op(OP_STRINGS_TRIM_PREFIX, "strings.TrimPrefix", opStringsTrimPrefix, In(ASTR, ASTR), Out(ASTR))
+	Op(OP_STRINGS_TRIM_SUFFIX, "strings.TrimSuffix", opStringsTrimSuffix, In(ASTR, ASTR), Out(ASTR))
+	Op(OP_STRINGS_TRIM_SPACE, "strings.TrimSpace", opStringsTrimSpace, In(ASTR), Out(ASTR))
+	Op(OP_STRINGS_BUILDER_WRITE_STRING, "strings.Builder.WriteString", opStringsBuilderWriteString, In(Pointer(Struct("strings", "Builder", "b")), ASTR), nil)
+	Op(OP_STRINGS_BUILDER_WRITE_BYTE, "strings.Builder.WriteByte", opStringsBuilderWriteByte, In(Pointer(Struct("strings", "Builder", "b")), AUI8), nil)
+	Op(OP_STRINGS_BUILDER_LEN, "strings.Builder.Len", opStringsBuilderLen, In(Pointer(Struct("strings", "Builder", "b"))), Out(AI32))
+	Op(OP_STRINGS_BUILDER_STRING, "strings.Builder.String", opStringsBuilderString, In(Pointer(Struct("strings", "Builder", "b"))), Out(ASTR))
+	Op(OP_STRINGS_BUILDER_RESET, "strings.Builder.Reset", opStringsBuilderReset, In(Pointer(Struct("strings", "Builder", "b"))), nil)
+
+	// math
+	Op(OP_MATH_TAN, "math.Tan", opMathTan, In(AF64), Out(AF64))
+	Op(OP_MATH_ATAN, "math.Atan", opMathAtan, In(AF64), Out(AF64))
+	Op(OP_MATH_EXP, "math.Exp", opMathExp, In(AF64), Out(AF64))
+	Op(OP_MATH_FLOOR, "math.Floor", opMathFloor, In(AF64), Out(AF64))
+	Op(OP_MATH_CEIL, "math.Ceil", opMathCeil, In(AF64), Out(AF64))
+	Op(OP_MATH_ROUND, "math.Round", opMathRound, In(AF64), Out(AF64))
+	Op(OP_MATH_TRUNC, "math.Trunc", opMathTrunc, In(AF64), Out(AF64))
+	Op(OP_MATH_ATAN2, "math.Atan2", opMathAtan2, In(AF64, AF64), Out(AF64))
+	Op(OP_MATH_HYPOT, "math.Hypot", opMathHypot, In(AF64, AF64), Out(AF64))
+	Op(OP_MATH_COPYSIGN, "math.Copysign", opMathCopysign, In(AF64, AF64), Out(AF64))
+	Op(OP_MATH_INF, "math.Inf", opMathInf, In(AI32), Out(AF64))
+	Op(OP_MATH_IS_INF, "math.IsInf", opMathIsInf, In(AF64, AI32), Out(ABOOL))
+	Op(OP_MATH_NAN, "math.NaN", opMathNaN, nil, Out(AF64))
+	Op(OP_MATH_TAN_F32, "math.TanF32", opMathTanF32, In(AF32), Out(AF32))
+	Op(OP_MATH_ATAN_F32, "math.AtanF32", opMathAtanF32, In(AF32), Out(AF32))
+	Op(OP_MATH_EXP_F32, "math.ExpF32", opMathExpF32, In(AF32), Out(AF32))
+	Op(OP_MATH_FLOOR_F32, "math.FloorF32", opMathFloorF32, In(AF32), Out(AF32))
+	Op(OP_MATH_CEIL_F32, "math.CeilF32", opMathCeilF32, In(AF32), Out(AF32))
+	Op(OP_MATH_ROUND_F32, "math.RoundF32", opMathRoundF32, In(AF32), Out(AF32))
+	Op(OP_MATH_TRUNC_F32, "math.TruncF32", opMathTruncF32, In(AF32), Out(AF32))
+	Op(OP_MATH_ATAN2_F32, "math.Atan2F32", opMathAtan2F32, In(AF32, AF32), Out(AF32))
+	Op(OP_MATH_HYPOT_F32, "math.HypotF32", opMathHypotF32, In(AF32, AF32), Out(AF32))
+	Op(OP_MATH_COPYSIGN_F32, "math.CopysignF32", opMathCopysignF32, In(AF32, AF32), Out(AF32))
+	Op(OP_MATH_INF_F32, "math.InfF32", opMathInfF32, In(AI32), Out(AF32))
+	Op(OP_MATH_IS_INF_F32, "math.IsInfF32", opMathIsInfF32, In(AF32, AI32), Out(ABOOL))
+	Op(OP_MATH_NAN_F32, "math.NaNF32", opMathNaNF32, nil, Out(AF32))
+	Op(OP_MATH_ONES_COUNT_8, "math.OnesCount8", opMathOnesCount8, In(AUI8), Out(AI32))
+	Op(OP_MATH_LEADING_ZEROS_8, "math.LeadingZeros8", opMathLeadingZeros8, In(AUI8), Out(AI32))
+	Op(OP_MATH_TRAILING_ZEROS_8, "math.TrailingZeros8", opMathTrailingZeros8, In(AUI8), Out(AI32))
+	Op(OP_MATH_ROTATE_LEFT_8, "math.RotateLeft8", opMathRotateLeft8, In(AUI8, AI32), Out(AUI8))
+	Op(OP_MATH_ONES_COUNT_16, "math.OnesCount16", opMathOnesCount16, In(AUI16), Out(AI32))
+	Op(OP_MATH_LEADING_ZEROS_16, "math.LeadingZeros16", opMathLeadingZeros16, In(AUI16), Out(AI32))
+	Op(OP_MATH_TRAILING_ZEROS_16, "math.TrailingZeros16", opMathTrailingZeros16, In(AUI16), Out(AI32))
+	Op(OP_MATH_ROTATE_LEFT_16, "math.RotateLeft16", opMathRotateLeft16, In(AUI16, AI32), Out(AUI16))
+	Op(OP_MATH_ONES_COUNT_32, "math.OnesCount32", opMathOnesCount32, In(AUI32), Out(AI32))
+	Op(OP_MATH_LEADING_ZEROS_32, "math.LeadingZeros32", opMathLeadingZeros32, In(AUI32), Out(AI32))
+	Op(OP_MATH_TRAILING_ZEROS_32, "math.TrailingZeros32", opMathTrailingZeros32, In(AUI32), Out(AI32))
+	Op(OP_MATH_ROTATE_LEFT_32, "math.RotateLeft32", opMathRotateLeft32, In(AUI32, AI32), Out(AUI32))
+	Op(OP_MATH_ONES_COUNT_64, "math.OnesCount64", opMathOnesCount64, In(AUI64), Out(AI32))
+	Op(OP_MATH_LEADING_ZEROS_64, "math.LeadingZeros64", opMathLeadingZeros64, In(AUI64), Out(AI32))
+	Op(OP_MATH_TRAILING_ZEROS_64, "math.TrailingZeros64", opMathTrailingZeros64, In(AUI64), Out(AI32))
+	Op(OP_MATH_ROTATE_LEFT_64, "math.RotateLeft64", opMathRotateLeft64, In(AUI64, AI32), Out(AUI64))
+
+	// big
+	Op(OP_BIG_NEW_INT, "big.NewInt", opBigNewInt, In(AI64), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_NEW_INT_UI64, "big.NewIntUI64", opBigNewIntUI64, In(AUI64), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_PARSE_INT, "big.ParseInt", opBigParseInt, In(ASTR, AI32), Out(Struct("big", "Int", "z"), ABOOL))
+	Op(OP_BIG_INT_STRING, "big.Int.String", opBigIntString, In(Struct("big", "Int", "x")), Out(ASTR))
+	Op(OP_BIG_INT_TEXT, "big.Int.Text", opBigIntText, In(Struct("big", "Int", "x"), AI32), Out(ASTR))
+	Op(OP_BIG_INT_ADD, "big.Int.Add", opBigIntAdd, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_SUB, "big.Int.Sub", opBigIntSub, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_MUL, "big.Int.Mul", opBigIntMul, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_QUO, "big.Int.Quo", opBigIntQuo, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_REM, "big.Int.Rem", opBigIntRem, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_DIV, "big.Int.Div", opBigIntDiv, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_MOD, "big.Int.Mod", opBigIntMod, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_EXP, "big.Int.Exp", opBigIntExp, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_NEG, "big.Int.Neg", opBigIntNeg, In(Struct("big", "Int", "x")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_ABS, "big.Int.Abs", opBigIntAbs, In(Struct("big", "Int", "x")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_INT_CMP, "big.Int.Cmp", opBigIntCmp, In(Struct("big", "Int", "x"), Struct("big", "Int", "y")), Out(AI32))
+	Op(OP_BIG_INT_SIGN, "big.Int.Sign", opBigIntSign, In(Struct("big", "Int", "x")), Out(AI32))
+	Op(OP_BIG_INT_I64, "big.Int.I64", opBigIntI64, In(Struct("big", "Int", "x")), Out(AI64, ABOOL))
+	Op(OP_BIG_INT_UI64, "big.Int.UI64", opBigIntUI64, In(Struct("big", "Int", "x")), Out(AUI64, ABOOL))
+	Op(OP_BIG_INT_F64, "big.Int.F64", opBigIntF64, In(Struct("big", "Int", "x")), Out(AF64))
+	Op(OP_BIG_INT_DEC, "big.Int.Dec", opBigIntDec, In(Struct("big", "Int", "x")), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_NEW_DEC, "big.NewDec", opBigNewDec, In(AI64, AI32), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_PARSE_DEC, "big.ParseDec", opBigParseDec, In(ASTR), Out(Struct("big", "Dec", "z"), ABOOL))
+	Op(OP_BIG_DEC_STRING, "big.Dec.String", opBigDecString, In(Struct("big", "Dec", "x")), Out(ASTR))
+	Op(OP_BIG_DEC_SCALE, "big.Dec.Scale", opBigDecScale, In(Struct("big", "Dec", "x")), Out(AI32))
+	Op(OP_BIG_DEC_ADD, "big.Dec.Add", opBigDecAdd, In(Struct("big", "Dec", "x"), Struct("big", "Dec", "y")), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_DEC_SUB, "big.Dec.Sub", opBigDecSub, In(Struct("big", "Dec", "x"), Struct("big", "Dec", "y")), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_DEC_MUL, "big.Dec.Mul", opBigDecMul, In(Struct("big", "Dec", "x"), Struct("big", "Dec", "y")), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_DEC_QUO, "big.Dec.Quo", opBigDecQuo, In(Struct("big", "Dec", "x"), Struct("big", "Dec", "y"), AI32), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_DEC_ROUND, "big.Dec.Round", opBigDecRound, In(Struct("big", "Dec", "x"), AI32), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_DEC_NEG, "big.Dec.Neg", opBigDecNeg, In(Struct("big", "Dec", "x")), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_DEC_ABS, "big.Dec.Abs", opBigDecAbs, In(Struct("big", "Dec", "x")), Out(Struct("big", "Dec", "z")))
+	Op(OP_BIG_DEC_CMP, "big.Dec.Cmp", opBigDecCmp, In(Struct("big", "Dec", "x"), Struct("big", "Dec", "y")), Out(AI32))
+	Op(OP_BIG_DEC_SIGN, "big.Dec.Sign", opBigDecSign, In(Struct("big", "Dec", "x")), Out(AI32))
+	Op(OP_BIG_DEC_INT, "big.Dec.Int", opBigDecInt, In(Struct("big", "Dec", "x")), Out(Struct("big", "Int", "z")))
+	Op(OP_BIG_DEC_F64, "big.Dec.F64", opBigDecF64, In(Struct("big", "Dec", "x")), Out(AF64))
+
+	// utf8
+	Op(OP_UTF8_RUNE_COUNT, "utf8.RuneCount", opUtf8RuneCount, In(ASTR), Out(AI32))
+	Op(OP_UTF8_RUNE_LEN, "utf8.RuneLen", opUtf8RuneLen, In(AI32), Out(AI32))
+	Op(OP_UTF8_DECODE_RUNE, "utf8.DecodeRune", opUtf8DecodeRune, In(ASTR), Out(AI32, AI32))
+	Op(OP_UTF8_DECODE_LAST_RUNE, "utf8.DecodeLastRune", opUtf8DecodeLastRune, In(ASTR), Out(AI32, AI32))
+	Op(OP_UTF8_ENCODE_RUNE, "utf8.EncodeRune", opUtf8EncodeRune, In(AI32), Out(ASTR))
+	Op(OP_UTF8_VALID_STRING, "utf8.ValidString", opUtf8ValidString, In(ASTR), Out(ABOOL))
+	Op(OP_UTF8_VALID_RUNE, "utf8.ValidRune", opUtf8ValidRune, In(AI32), Out(ABOOL))
+	Op(OP_UTF8_RUNES, "utf8.Runes", opUtf8Runes, In(ASTR), Out(Slice(TYPE_I32)))
+	Op(OP_UTF8_FROM_RUNES, "utf8.FromRunes", opUtf8FromRunes, In(Slice(TYPE_I32)), Out(ASTR))
+	Op(OP_UTF8_BYTES, "utf8.Bytes", opUtf8Bytes, In(ASTR), Out(Slice(TYPE_UI8)))
+	Op(OP_UTF8_FROM_BYTES, "utf8.FromBytes", opUtf8FromBytes, In(Slice(TYPE_UI8)), Out(ASTR))
+
+	// sort
+	lessFn := Param(TYPE_FUNC)
+	lessFn.Inputs = In(AI32, AI32)
+	lessFn.Outputs = Out(ABOOL)
+
+	Op(OP_SORT_INTS, "sort.Ints", opSortInts, In(Slice(TYPE_I32)), nil)
+	Op(OP_SORT_FLOATS, "sort.Floats", opSortFloats, In(Slice(TYPE_F64)), nil)
+	Op(OP_SORT_STRS, "sort.Strs", opSortStrs, In(Slice(TYPE_STR)), nil)
+	Op(OP_SORT_SLICE, "sort.Slice", opSortSlice, In(Slice(TYPE_UNDEFINED), lessFn), nil)
+
+	// slices
+	Op(OP_SLICES_REVERSE, "slices.Reverse", opSlicesReverse, In(Slice(TYPE_UNDEFINED)), nil)
+	Op(OP_SLICES_BINARY_SEARCH, "slices.BinarySearch", opSlicesBinarySearch, In(Slice(TYPE_UNDEFINED), AUND), Out(AI32, ABOOL))
+	Op(OP_SLICES_CONTAINS, "slices.Contains", opSlicesContains, In(Slice(TYPE_UNDEFINED), AUND), Out(ABOOL))
+	Op(OP_SLICES_INDEX_OF, "slices.IndexOf", opSlicesIndexOf, In(Slice(TYPE_UNDEFINED), AUND), Out(AI32))
+	Op(OP_SLICES_EQUAL, "slices.Equal", opSlicesEqual, In(Slice(TYPE_UNDEFINED), Slice(TYPE_UNDEFINED)), Out(ABOOL))
+	Op(OP_SLICES_CLONE, "slices.Clone", opSlicesClone, In(Slice(TYPE_UNDEFINED)), Out(Slice(TYPE_UNDEFINED)))
+
+	// base64
+	Op(OP_BASE64_ENCODE, "base64.Encode", opBase64Encode, In(AI32, Slice(TYPE_UI8)), Out(ASTR))
+	Op(OP_BASE64_DECODE, "base64.Decode", opBase64Decode, In(AI32, ASTR), Out(Slice(TYPE_UI8), ABOOL))
+	Op(OP_BASE64_ENCODE_STR, "base64.EncodeStr", opBase64EncodeStr, In(AI32, ASTR), Out(ASTR))
+	Op(OP_BASE64_DECODE_STR, "base64.DecodeStr", opBase64DecodeStr, In(AI32, ASTR), Out(ASTR, ABOOL))
+	Op(OP_BASE64_READ_FILE, "base64.ReadFile", opBase64ReadFile, In(AI32, AI32), Out(Slice(TYPE_UI8), ABOOL))
+	Op(OP_BASE64_WRITE_FILE, "base64.WriteFile", opBase64WriteFile, In(AI32, AI32, Slice(TYPE_UI8)), Out(ABOOL))
+
+	// hex
+	Op(OP_HEX_ENCODE, "hex.Encode", opHexEncode, In(Slice(TYPE_UI8)), Out(ASTR))
+	Op(OP_HEX_DECODE, "hex.Decode", opHexDecode, In(ASTR), Out(Slice(TYPE_UI8), ABOOL))
+	Op(OP_HEX_ENCODE_STR, "hex.EncodeStr", opHexEncodeStr, In(ASTR), Out(ASTR))
+	Op(OP_HEX_DECODE_STR, "hex.DecodeStr", opHexDecodeStr, In(ASTR), Out(ASTR, ABOOL))
+	Op(OP_HEX_DUMP, "hex.Dump", opHexDump, In(Slice(TYPE_UI8)), Out(ASTR))
+	Op(OP_HEX_READ_FILE, "hex.ReadFile", opHexReadFile, In(AI32), Out(Slice(TYPE_UI8), ABOOL))
+	Op(OP_HEX_WRITE_FILE, "hex.WriteFile", opHexWriteFile, In(AI32, Slice(TYPE_UI8)), Out(ABOOL))
+
+	// csv
+	records := Slice(TYPE_STR)
+	records.DeclarationSpecifiers = append(records.DeclarationSpecifiers, DECL_SLICE)
+
+	Op(OP_CSV_PARSE, "csv.Parse", opCsvParse, In(ASTR, AI32), Out(records, ABOOL))
+	Op(OP_CSV_FORMAT, "csv.Format", opCsvFormat, In(records, AI32), Out(ASTR))
+	Op(OP_CSV_READ_FILE, "csv.ReadFile", opCsvReadFile, In(AI32, AI32), Out(records, ABOOL))
+	Op(OP_CSV_WRITE_FILE, "csv.WriteFile", opCsvWriteFile, In(AI32, records, AI32), Out(ABOOL))
+
+	// binary
+	Op(OP_BINARY_UI16, "binary.UI16", opBinaryUI16, In(AI32, Slice(TYPE_UI8), AI32), Out(AUI16))
+	Op(OP_BINARY_UI32, "binary.UI32", opBinaryUI32, In(AI32, Slice(TYPE_UI8), AI32), Out(AUI32))
+	Op(OP_BINARY_UI64, "binary.UI64", opBinaryUI64, In(AI32, Slice(TYPE_UI8), AI32), Out(AUI64))
+	Op(OP_BINARY_I16, "binary.I16", opBinaryI16, In(AI32, Slice(TYPE_UI8), AI32), Out(AI16))
+	Op(OP_BINARY_I32, "binary.I32", opBinaryI32, In(AI32, Slice(TYPE_UI8), AI32), Out(AI32))
+	Op(OP_BINARY_I64, "binary.I64", opBinaryI64, In(AI32, Slice(TYPE_UI8), AI32), Out(AI64))
+	Op(OP_BINARY_F32, "binary.F32", opBinaryF32, In(AI32, Slice(TYPE_UI8), AI32), Out(AF32))
+	Op(OP_BINARY_F64, "binary.F64", opBinaryF64, In(AI32, Slice(TYPE_UI8), AI32), Out(AF64))
+	Op(OP_BINARY_PUT_UI16, "binary.PutUI16", opBinaryPutUI16, In(AI32, Slice(TYPE_UI8), AI32, AUI16), nil)
+	Op(OP_BINARY_PUT_UI32, "binary.PutUI32", opBinaryPutUI32, In(AI32, Slice(TYPE_UI8), AI32, AUI32), nil)
+	Op(OP_BINARY_PUT_UI64, "binary.PutUI64", opBinaryPutUI64, In(AI32, Slice(TYPE_UI8), AI32, AUI64), nil)
+	Op(OP_BINARY_PUT_I16, "binary.PutI16", opBinaryPutI16, In(AI32, Slice(TYPE_UI8), AI32, AI16), nil)
+	Op(OP_BINARY_PUT_I32, "binary.PutI32", opBinaryPutI32, In(AI32, Slice(TYPE_UI8), AI32, AI32), nil)
+	Op(OP_BINARY_PUT_I64, "binary.PutI64", opBinaryPutI64, In(AI32, Slice(TYPE_UI8), AI32, AI64), nil)
+	Op(OP_BINARY_PUT_F32, "binary.PutF32", opBinaryPutF32, In(AI32, Slice(TYPE_UI8), AI32, AF32), nil)
+	Op(OP_BINARY_PUT_F64, "binary.PutF64", opBinaryPutF64, In(AI32, Slice(TYPE_UI8), AI32, AF64), nil)
+
+	// crypto
+	Op(OP_CRYPTO_HMAC_SHA256, "crypto.HMACSHA256", opCryptoHMACSHA256, In(Slice(TYPE_UI8), Slice(TYPE_UI8)), Out(Slice(TYPE_UI8)))
+	Op(OP_CRYPTO_EQUAL, "crypto.Equal", opCryptoEqual, In(Slice(TYPE_UI8), Slice(TYPE_UI8)), Out(ABOOL))
+	Op(OP_CRYPTO_RANDOM_BYTES, "crypto.RandomBytes", opCryptoRandomBytes, In(AI32), Out(Slice(TYPE_UI8), ABOOL))
+
+	// filepath
+	walkFn := Param(TYPE_FUNC)
+	walkFn.Inputs = In(ASTR, ABOOL)
+	walkFn.Outputs = Out(ABOOL)
+
+	Op(OP_FILEPATH_JOIN, "filepath.Join", opFilepathJoin, In(ASTR, ASTR), Out(ASTR))
+	Op(OP_FILEPATH_DIR, "filepath.Dir", opFilepathDir, In(ASTR), Out(ASTR))
+	Op(OP_FILEPATH_BASE, "filepath.Base", opFilepathBase, In(ASTR), Out(ASTR))
+	Op(OP_FILEPATH_EXT, "filepath.Ext", opFilepathExt, In(ASTR), Out(ASTR))
+	Op(OP_FILEPATH_CLEAN, "filepath.Clean", opFilepathClean, In(ASTR), Out(ASTR))
+	Op(OP_FILEPATH_SPLIT, "filepath.Split", opFilepathSplit, In(ASTR), Out(ASTR, ASTR))
+	Op(OP_FILEPATH_IS_ABS, "filepath.IsAbs", opFilepathIsAbs, In(ASTR), Out(ABOOL))
+	Op(OP_FILEPATH_ABS, "filepath.Abs", opFilepathAbs, In(ASTR), Out(ASTR, ABOOL))
+	Op(OP_FILEPATH_REL, "filepath.Rel", opFilepathRel, In(ASTR, ASTR), Out(ASTR, ABOOL))
+	Op(OP_FILEPATH_MATCH, "filepath.Match", opFilepathMatch, In(ASTR, ASTR), Out(ABOOL, ABOOL))
+	Op(OP_FILEPATH_GLOB, "filepath.Glob", opFilepathGlob, In(ASTR), Out(Slice(TYPE_STR), ABOOL))
+	Op(OP_FILEPATH_WALK, "filepath.Walk", opFilepathWalk, In(ASTR, walkFn), Out(ABOOL))
+
+	// exec
+	exitStatus := Struct("exec", "ExitStatus", "status")
+
+	Op(OP_EXEC_COMMAND, "exec.Command", opExecCommand, In(ASTR, Slice(TYPE_STR)), Out(AI32))
+	Op(OP_EXEC_SET_DIR, "exec.SetDir", opExecSetDir, In(AI32, ASTR), nil)
+	Op(OP_EXEC_SET_ENV, "exec.SetEnv", opExecSetEnv, In(AI32, Slice(TYPE_STR)), nil)
+	Op(OP_EXEC_ADD_ENV, "exec.AddEnv", opExecAddEnv, In(AI32, ASTR), nil)
+	Op(OP_EXEC_SET_STDIN, "exec.SetStdin", opExecSetStdin, In(AI32, ASTR), nil)
+	Op(OP_EXEC_STDIN_PIPE, "exec.StdinPipe", opExecStdinPipe, In(AI32), Out(ABOOL))
+	Op(OP_EXEC_WRITE_STDIN, "exec.WriteStdin", opExecWriteStdin, In(AI32, ASTR), Out(ABOOL))
+	Op(OP_EXEC_CLOSE_STDIN, "exec.CloseStdin", opExecCloseStdin, In(AI32), Out(ABOOL))
+	Op(OP_EXEC_START, "exec.Start", opExecStart, In(AI32), Out(ABOOL))
+	Op(OP_EXEC_WAIT, "exec.Wait", opExecWait, In(AI32), Out(exitStatus))
+	Op(OP_EXEC_RUN, "exec.Run", opExecRun, In(AI32), Out(exitStatus))
+	Op(OP_EXEC_RUNNING, "exec.Running", opExecRunning, In(AI32), Out(ABOOL))
+	Op(OP_EXEC_KILL, "exec.Kill", opExecKill, In(AI32), Out(ABOOL))
+	Op(OP_EXEC_PID, "exec.Pid", opExecPid, In(AI32), Out(AI32))
+	Op(OP_EXEC_READ_STDOUT, "exec.ReadStdout", opExecReadStdout, In(AI32), Out(ASTR, ABOOL))
+	Op(OP_EXEC_READ_STDERR, "exec.ReadStderr", opExecReadStderr, In(AI32), Out(ASTR, ABOOL))
+	Op(OP_EXEC_STDOUT, "exec.Stdout", opExecStdout, In(AI32), Out(ASTR))
+	Op(OP_EXEC_STDERR, "exec.Stderr", opExecStderr, In(AI32), Out(ASTR))
+	Op(OP_EXEC_RELEASE, "exec.Release", opExecRelease, In(AI32), nil)
+	Op(OP_EXEC_LOOK_PATH, "exec.LookPath", opExecLookPath, In(ASTR), Out(ASTR, ABOOL))
+
+	// net
+	duration := Struct("time", "Duration", "d")
+
+	Op(OP_NET_DIAL, "net.Dial", opNetDial, In(ASTR, ASTR), Out(AI32))
+	Op(OP_NET_DIAL_TIMEOUT, "net.DialTimeout", opNetDialTimeout, In(ASTR, ASTR, duration), Out(AI32))
+	Op(OP_NET_LISTEN, "net.Listen", opNetListen, In(ASTR, ASTR), Out(AI32))
+	Op(OP_NET_ACCEPT, "net.Accept", opNetAccept, In(AI32), Out(AI32))
+	Op(OP_NET_LISTEN_PACKET, "net.ListenPacket", opNetListenPacket, In(ASTR, ASTR), Out(AI32))
+	Op(OP_NET_CLOSE, "net.Close", opNetClose, In(AI32), Out(ABOOL))
+	Op(OP_NET_LOCAL_ADDR, "net.LocalAddr", opNetLocalAddr, In(AI32), Out(ASTR))
+	Op(OP_NET_REMOTE_ADDR, "net.RemoteAddr", opNetRemoteAddr, In(AI32), Out(ASTR))
+	Op(OP_NET_SET_DEADLINE, "net.SetDeadline", opNetSetDeadline, In(AI32, duration), Out(ABOOL))
+	Op(OP_NET_SET_READ_DEADLINE, "net.SetReadDeadline", opNetSetReadDeadline, In(AI32, duration), Out(ABOOL))
+	Op(OP_NET_SET_WRITE_DEADLINE, "net.SetWriteDeadline", opNetSetWriteDeadline, In(AI32, duration), Out(ABOOL))
+	Op(OP_NET_READ, "net.Read", opNetRead, In(AI32, AI32), Out(Slice(TYPE_UI8), ABOOL))
+	Op(OP_NET_READ_STR, "net.ReadStr", opNetReadStr, In(AI32, AI32), Out(ASTR, ABOOL))
+	Op(OP_NET_READ_LINE, "net.ReadLine", opNetReadLine, In(AI32), Out(ASTR, ABOOL))
+	Op(OP_NET_WRITE, "net.Write", opNetWrite, In(AI32, Slice(TYPE_UI8)), Out(ABOOL))
+	Op(OP_NET_WRITE_STR, "net.WriteStr", opNetWriteStr, In(AI32, ASTR), Out(ABOOL))
+	Op(OP_NET_READ_FROM, "net.ReadFrom", opNetReadFrom, In(AI32, AI32), Out(Slice(TYPE_UI8), ASTR, ABOOL))
+	Op(OP_NET_WRITE_TO, "net.WriteTo", opNetWriteTo, In(AI32, Slice(TYPE_UI8), ASTR), Out(ABOOL))
+	Op(OP_NET_ERROR, "net.Error", opNetError, In(AI32), Out(ASTR))
+	Op(OP_NET_TIMEOUT, "net.Timeout", opNetTimeout, In(AI32), Out(ABOOL))
+	Op(OP_NET_EOF, "net.EOF", opNetEOF, In(AI32), Out(ABOOL))
+
+	// http server
+	header := Struct("http", "Header", "h")
+	w := Struct("http", "ResponseWriter", "w")
+	r := Struct("http", "ServerRequest", "r")
+	router := Struct("http", "Router", "router")
+	server := Struct("http", "Server", "server")
+	handlerFn := Param(TYPE_FUNC)
+	handlerFn.Inputs = In(w, r)
+	middlewareFn := Param(TYPE_FUNC)
+	middlewareFn.Inputs = In(w, r)
+	middlewareFn.Outputs = Out(ABOOL)
+
+	Op(OP_HTTP_HEADER_GET, "http.Header.Get", opHTTPHeaderGet, In(header, ASTR), Out(ASTR))
+	Op(OP_HTTP_HEADER_VALUES, "http.Header.Values", opHTTPHeaderValues, In(header, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_HTTP_HEADER_SET, "http.Header.Set", opHTTPHeaderSet, In(header, ASTR, ASTR), nil)
+	Op(OP_HTTP_HEADER_ADD, "http.Header.Add", opHTTPHeaderAdd, In(header, ASTR, ASTR), nil)
+	Op(OP_HTTP_HEADER_DEL, "http.Header.Del", opHTTPHeaderDel, In(header, ASTR), nil)
+	Op(OP_HTTP_HEADER_KEYS, "http.Header.Keys", opHTTPHeaderKeys, In(header), Out(Slice(TYPE_STR)))
+	Op(OP_HTTP_RESPONSE_WRITER_HEADER, "http.ResponseWriter.Header", opHTTPResponseWriterHeader, In(w), Out(header))
+	Op(OP_HTTP_RESPONSE_WRITER_WRITE_HEADER, "http.ResponseWriter.WriteHeader", opHTTPResponseWriterWriteHeader, In(w, AI32), nil)
+	Op(OP_HTTP_RESPONSE_WRITER_WRITE, "http.ResponseWriter.Write", opHTTPResponseWriterWrite, In(w, Slice(TYPE_UI8)), Out(ABOOL))
+	Op(OP_HTTP_RESPONSE_WRITER_WRITE_STR, "http.ResponseWriter.WriteStr", opHTTPResponseWriterWriteStr, In(w, ASTR), Out(ABOOL))
+	Op(OP_HTTP_ERROR, "http.Error", opHTTPError, In(w, ASTR, AI32), nil)
+	Op(OP_HTTP_REDIRECT, "http.Redirect", opHTTPRedirect, In(w, r, ASTR, AI32), nil)
+	Op(OP_HTTP_SERVER_REQUEST_METHOD, "http.ServerRequest.Method", opHTTPServerRequestMethod, In(r), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_URL, "http.ServerRequest.URL", opHTTPServerRequestURL, In(r), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_PATH, "http.ServerRequest.Path", opHTTPServerRequestPath, In(r), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_HOST, "http.ServerRequest.Host", opHTTPServerRequestHost, In(r), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_REMOTE_ADDR, "http.ServerRequest.RemoteAddr", opHTTPServerRequestRemoteAddr, In(r), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_HEADER, "http.ServerRequest.Header", opHTTPServerRequestHeader, In(r), Out(header))
+	Op(OP_HTTP_SERVER_REQUEST_QUERY, "http.ServerRequest.Query", opHTTPServerRequestQuery, In(r, ASTR), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_QUERY_VALUES, "http.ServerRequest.QueryValues", opHTTPServerRequestQueryValues, In(r, ASTR), Out(Slice(TYPE_STR)))
+	Op(OP_HTTP_SERVER_REQUEST_FORM_VALUE, "http.ServerRequest.FormValue", opHTTPServerRequestFormValue, In(r, ASTR), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_POST_FORM_VALUE, "http.ServerRequest.PostFormValue", opHTTPServerRequestPostFormValue, In(r, ASTR), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_PATH_PARAM, "http.ServerRequest.PathParam", opHTTPServerRequestPathParam, In(r, ASTR), Out(ASTR))
+	Op(OP_HTTP_SERVER_REQUEST_CONTENT_LENGTH, "http.ServerRequest.ContentLength", opHTTPServerRequestContentLength, In(r), Out(AI64))
+	Op(OP_HTTP_SERVER_REQUEST_READ, "http.ServerRequest.Read", opHTTPServerRequestRead, In(r, AI32), Out(Slice(TYPE_UI8), ABOOL))
+	Op(OP_HTTP_SERVER_REQUEST_READ_STR, "http.ServerRequest.ReadStr", opHTTPServerRequestReadStr, In(r, AI32), Out(ASTR, ABOOL))
+	Op(OP_HTTP_SERVER_REQUEST_BODY, "http.ServerRequest.Body", opHTTPServerRequestBody, In(r), Out(ASTR, ABOOL))
+	Op(OP_HTTP_NEW_ROUTER, "http.NewRouter", opHTTPNewRouter, nil, Out(router))
+	Op(OP_HTTP_ROUTER_HANDLE_FUNC, "http.Router.HandleFunc", opHTTPRouterHandleFunc, In(router, ASTR, handlerFn), nil)
+	Op(OP_HTTP_ROUTER_METHOD_FUNC, "http.Router.MethodFunc", opHTTPRouterMethodFunc, In(router, ASTR, ASTR, handlerFn), nil)
+	Op(OP_HTTP_ROUTER_USE, "http.Router.Use", opHTTPRouterUse, In(router, middlewareFn), nil)
+	Op(OP_HTTP_ROUTER_MOUNT, "http.Router.Mount", opHTTPRouterMount, In(router, ASTR, router), nil)
+	Op(OP_HTTP_ROUTER_NOT_FOUND, "http.Router.NotFound", opHTTPRouterNotFound, In(router, handlerFn), nil)
+	Op(OP_HTTP_NEW_SERVER, "http.NewServer", opHTTPNewServer, In(ASTR, router), Out(server))
+	Op(OP_HTTP_SERVER_START, "http.Server.Start", opHTTPServerStart, In(server), Out(ABOOL))
+	Op(OP_HTTP_SERVER_ADDR, "http.Server.Addr", opHTTPServerAddr, In(server), Out(ASTR))
+	Op(OP_HTTP_SERVER_RUN, "http.Server.Run", opHTTPServerRun, In(server), Out(ABOOL))
+	Op(OP_HTTP_SERVER_SHUTDOWN, "http.Server.Shutdown", opHTTPServerShutdown, In(server, duration), Out(ABOOL))
+	Op(OP_HTTP_SERVER_CLOSE, "http.Server.Close", opHTTPServerClose, In(server), Out(ABOOL))
+	Op(OP_HTTP_POLL, "http.Poll", opHTTPPoll, In(duration), Out(AI32))
+
+	// the default server, calling `fn(w str, r *http.Request)`
+	handleFn := Param(TYPE_FUNC)
+	handleFn.Inputs = In(ASTR, Pointer(Struct("http", "Request", "r")))
+
+	Op(OP_HTTP_HANDLE, "http.Handle", opHTTPHandle, In(ASTR, handleFn), nil)
+	Op(OP_HTTP_SERVE, "http.Serve", opHTTPServe, In(ASTR), Out(ASTR))
+	Op(OP_HTTP_LISTEN_AND_SERVE, "http.ListenAndServe", opHTTPListenAndServe, In(ASTR), Out(ASTR))
+	Op(OP_HTTP_CLOSE, "http.Close", opHTTPClose, nil, nil)
+
+	// http client
+	request := Struct("http", "Request", "req")
+	response := Struct("http", "Response", "resp")
+	client := Struct("http", "Client", "client")
+
+	Op(OP_HTTP_NEW_HEADER, "http.NewHeader", opHTTPNewHeader, nil, Out(header))
+	Op(OP_HTTP_NEW_REQUEST, "http.NewRequest", opHTTPNewRequest, In(ASTR, ASTR, ASTR), Out(request, ASTR))
+	Op(OP_HTTP_REQUEST_SET_BASIC_AUTH, "http.Request.SetBasicAuth", opHTTPRequestSetBasicAuth, In(request, ASTR, ASTR), nil)
+	Op(OP_HTTP_REQUEST_SET_BEARER_AUTH, "http.Request.SetBearerAuth", opHTTPRequestSetBearerAuth, In(request, ASTR), nil)
+	Op(OP_HTTP_REQUEST_ADD_COOKIE, "http.Request.AddCookie", opHTTPRequestAddCookie, In(request, ASTR, ASTR), nil)
+	Op(OP_HTTP_DO, "http.Do", opHTTPDo, In(request), Out(response, ASTR))
+	Op(OP_HTTP_NEW_CLIENT, "http.NewClient", opHTTPNewClient, nil, Out(client))
+	Op(OP_HTTP_CLIENT_SET_TIMEOUT, "http.Client.SetTimeout", opHTTPClientSetTimeout, In(client, duration), nil)
+	Op(OP_HTTP_CLIENT_SET_MAX_REDIRECTS, "http.Client.SetMaxRedirects", opHTTPClientSetMaxRedirects, In(client, AI32), nil)
+	Op(OP_HTTP_CLIENT_DO, "http.Client.Do", opHTTPClientDo, In(client, request), Out(response, ASTR))
+	Op(OP_HTTP_CLIENT_GET, "http.Client.Get", opHTTPClientGet, In(client, ASTR), Out(response, ASTR))
+	Op(OP_HTTP_CLIENT_DOWNLOAD, "http.Client.Download", opHTTPClientDownload, In(client, request, ASTR), Out(response, ASTR))
+	Op(OP_HTTP_CLIENT_SET_COOKIE, "http.Client.SetCookie", opHTTPClientSetCookie, In(client, ASTR, ASTR, ASTR), Out(ABOOL))
+	Op(OP_HTTP_CLIENT_COOKIE, "http.Client.Cookie", opHTTPClientCookie, In(client, ASTR, ASTR), Out(ASTR))
+
+	// dmsg
+	dmsgClient := Struct("http", "DmsgClient", "dc")
+
+	Op(OP_HTTP_NEW_DMSG_CLIENT, "http.NewDmsgClient", opHTTPNewDmsgClient, In(ASTR), Out(dmsgClient, ASTR))
+	Op(OP_HTTP_DMSG_CLIENT_PUBLIC_KEY, "http.DmsgClient.PublicKey", opHTTPDmsgClientPublicKey, In(dmsgClient), Out(ASTR))
+	Op(OP_HTTP_DMSG_CLIENT_CLOSE, "http.DmsgClient.Close", opHTTPDmsgClientClose, In(dmsgClient), Out(ABOOL))
+	Op(OP_DMSG_DO, "http.DmsgDo", opHTTPDmsgDo, In(dmsgClient, request), Out(response, ASTR))
+	Op(OP_HTTP_DMSG_SERVE, "http.DmsgServe", opHTTPDmsgServe, In(dmsgClient, AI32, router), Out(server, ASTR))
 }
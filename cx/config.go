@@ -21,7 +21,7 @@ var PROGRAM *CXProgram
 
 var CXPATH = os.Getenv("CXPATH") + "/"
 var BINPATH = CXPATH + "bin/" // TODO @evanlinjin: Not used.
-var PKGPATH = CXPATH + "pkg/" // TODO @evanlinjin: Not used.
+var PKGPATH = CXPATH + "pkg/" // Module cache in PKGPATH/mod.
 var SRCPATH = CXPATH + "src/"
 
 // var COREPATH = ""
@@ -38,6 +38,7 @@ const OS_ARGS = "Args"
 const NON_ASSIGN_PREFIX = "nonAssign"
 const LOCAL_PREFIX = "*tmp"
 const LABEL_PREFIX = "*lbl"
+const RANGE_PREFIX = "*rng"
 
 // Used in `PrintProgram` to represent literals (`CXArgument`s with no name).
 const LITERAL_PLACEHOLDER = "*lit"
@@ -54,13 +55,24 @@ const OBJECT_GC_HEADER_SIZE = 5
 const FORWARDING_ADDRESS_SIZE = 4
 const OBJECT_SIZE = 4
 
-const CALLSTACK_SIZE = 1000
-
+// The call stack starts with INIT_CALLSTACK_SIZE calls and grows on demand
+// up to MAX_CALLSTACK_SIZE calls.
+//
+// The memory of a program starts with STACK_SIZE bytes that are never used,
+// as the offsets below STACK_SIZE are relative to a frame pointer. They are
+// followed by the data segment, the data stack and the heap. The data stack
+// starts with INIT_STACK_SIZE bytes and it doubles its size when it's full by
+// moving the heap up, until it reaches STACK_SIZE bytes.
+const INIT_CALLSTACK_SIZE = 64
+const INIT_STACK_SIZE = 65536 // 64 Kb
+
+var MAX_CALLSTACK_SIZE = 1048576
 var STACK_SIZE = 1048576     // 1 Mb
 var INIT_HEAP_SIZE = 2097152 // 2 Mb
 var MAX_HEAP_SIZE = 67108864 // 64 Mb
 var MIN_HEAP_FREE_RATIO float32 = 0.4
 var MAX_HEAP_FREE_RATIO float32 = 0.7
+var TAIL_CALLS = true // reuse the caller's stack frame for calls in tail position
 
 const NULL_HEAP_ADDRESS_OFFSET = 4
 const NULL_HEAP_ADDRESS = 0
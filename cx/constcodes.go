@@ -31,11 +31,56 @@ func AddConstCode(code int, name string, typ int, value []byte) {
 	Constants[code] = CXConstant{Type: typ, Value: value}
 }
 
+// ConstI8 ...
+func ConstI8(code int, name string, value int8) {
+	AddConstCode(code, name, TYPE_I8, FromI8(value))
+}
+
+// ConstI16 ...
+func ConstI16(code int, name string, value int16) {
+	AddConstCode(code, name, TYPE_I16, FromI16(value))
+}
+
 // ConstI32 ...
 func ConstI32(code int, name string, value int32) {
 	AddConstCode(code, name, TYPE_I32, FromI32(value))
 }
 
+// ConstI64 ...
+func ConstI64(code int, name string, value int64) {
+	AddConstCode(code, name, TYPE_I64, FromI64(value))
+}
+
+// ConstUI8 ...
+func ConstUI8(code int, name string, value uint8) {
+	AddConstCode(code, name, TYPE_UI8, FromUI8(value))
+}
+
+// ConstUI16 ...
+func ConstUI16(code int, name string, value uint16) {
+	AddConstCode(code, name, TYPE_UI16, FromUI16(value))
+}
+
+// ConstUI32 ...
+func ConstUI32(code int, name string, value uint32) {
+	AddConstCode(code, name, TYPE_UI32, FromUI32(value))
+}
+
+// ConstUI64 ...
+func ConstUI64(code int, name string, value uint64) {
+	AddConstCode(code, name, TYPE_UI64, FromUI64(value))
+}
+
+// ConstF32 ...
+func ConstF32(code int, name string, value float32) {
+	AddConstCode(code, name, TYPE_F32, FromF32(value))
+}
+
+// ConstF64 ...
+func ConstF64(code int, name string, value float64) {
+	AddConstCode(code, name, TYPE_F64, FromF64(value))
+}
+
 // nolint typecheck
 func init() {
 	// cx
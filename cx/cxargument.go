@@ -37,6 +37,10 @@ type CXArgument struct {
 	IsInnerReference      bool // for example: &slice[0] or &struct.field
 	PreviouslyDeclared    bool
 	DoesEscape            bool
+
+	// resolved by Lower
+	isDirect   bool // no dereferences, indexes or fields
+	directSize int  // GetSize of a direct argument
 }
 
 // MakeArgument ...
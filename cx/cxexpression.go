@@ -35,6 +35,14 @@ type CXExpression struct {
 	IsUndType       bool
 	IsBreak         bool
 	IsContinue      bool
+
+	// the `k, v := range x` clause of a `for` loop, and the expressions
+	// of the loop that depend on the type of `x`, until they are resolved
+	IsRange bool
+
+	// resolved by Lower
+	handler    OpcodeHandler
+	isTailCall bool
 }
 
 // MakeExpression ...
@@ -30,6 +30,12 @@ type CXFunction struct {
 
 	// Used by the REPL and parser
 	CurrentExpression *CXExpression
+
+	// Go function generated by `cx build --target=go`, see BindGoFunction
+	goBody GoFunction
+
+	// Expressions lowered by `Lower`, see runInstructions
+	instructions []instruction
 }
 
 // MakeFunction creates an empty function.
@@ -9,7 +9,8 @@ import (
 //
 type CXPackage struct {
 	// Metadata
-	Name string // Name of the package
+	Name     string // Name of the package
+	IsSource bool   // Declared by CX source code, which shadows the core package with the same name
 
 	// Contents
 	Imports   []*CXPackage  // imported packages
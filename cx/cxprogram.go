@@ -29,7 +29,8 @@ type CXProgram struct {
 	Inputs         []*CXArgument // OS input arguments
 	Outputs        []*CXArgument // outputs to the OS
 	Memory         []byte        // Used when running the program
-	StackSize      int           // This field stores the size of a CX program's stack
+	StackSize      int           // This field stores the maximum size of a CX program's stack; offsets below it are relative to a frame pointer
+	StackStartsAt  int           // Offset at which the stack starts in a CX program's memory; the stack ends where the heap starts
 	HeapSize       int           // This field stores the size of a CX program's heap
 	HeapStartsAt   int           // Offset at which the heap starts in a CX program's memory
 	StackPointer   int           // At what byte the current stack frame is
@@ -40,6 +41,9 @@ type CXProgram struct {
 	BCPackageCount int           // In case of a CX chain, how many packages of this program are part of blockchain code.
 	Version        string        // CX version used to build this CX program.
 
+	tailCallBuffer []byte // Inputs of a tail call, copied before its frame is wiped.
+	goTailCall     bool   // Set by GoTailCall when a Go function ends with a tail call.
+
 	// Used by the REPL and parser
 	CurrentPackage *CXPackage // Represents the currently active package in the REPL or when parsing a CX file.
 }
@@ -49,6 +53,7 @@ type CXCall struct {
 	Operator     *CXFunction // What CX function will be called when running this CXCall in the runtime
 	Line         int         // What line in the CX function is currently being executed
 	FramePointer int         // Where in the stack is this function call's local variables stored
+	IsCallback   bool        // Whether the call was made by `Callback`, which reads its outputs itself
 }
 
 // MakeProgram ...
@@ -56,8 +61,8 @@ func MakeProgram() *CXProgram {
 	minHeapSize := minHeapSize()
 	newPrgrm := &CXProgram{
 		Packages:    make([]*CXPackage, 0),
-		CallStack:   make([]CXCall, CALLSTACK_SIZE),
-		Memory:      make([]byte, STACK_SIZE+minHeapSize),
+		CallStack:   make([]CXCall, INIT_CALLSTACK_SIZE),
+		Memory:      makeMemory(STACK_SIZE + minHeapSize),
 		StackSize:   STACK_SIZE,
 		HeapSize:    minHeapSize,
 		HeapPointer: NULL_HEAP_ADDRESS_OFFSET, // We can start adding objects to the heap after the NULL (nil) bytes.
@@ -66,6 +71,59 @@ func MakeProgram() *CXProgram {
 	return newPrgrm
 }
 
+// makeMemory allocates `size` bytes for the memory of a program. The
+// capacity of the slice is big enough to hold a stack of STACK_SIZE bytes
+// and a heap of MAX_HEAP_SIZE bytes, so the memory doesn't need to be copied
+// when they grow.
+func makeMemory(size int) []byte {
+	return make([]byte, size, size+STACK_SIZE+MAX_HEAP_SIZE)
+}
+
+// growStack makes room in the data stack for the frames up to the stack
+// pointer. The stack doubles its size by moving the heap up, and it panics
+// if it would be bigger than STACK_SIZE bytes. Like the garbage collector,
+// it can move the heap while a callback runs, so the native functions read
+// the addresses of heap objects again after calling one.
+func (prgrm *CXProgram) growStack() {
+	used := prgrm.StackPointer - prgrm.StackStartsAt
+	if used > STACK_SIZE {
+		panic(STACK_OVERFLOW_ERROR)
+	}
+
+	size := 2 * (prgrm.HeapStartsAt - prgrm.StackStartsAt)
+	if size < INIT_STACK_SIZE {
+		size = INIT_STACK_SIZE
+	}
+	for size < used {
+		size *= 2
+	}
+	if size > STACK_SIZE {
+		size = STACK_SIZE
+	}
+
+	MoveHeap(prgrm, prgrm.StackStartsAt+size-prgrm.HeapStartsAt)
+}
+
+// pushCall moves the call counter to the next call in the call stack and
+// returns it. The call stack grows if it's full, so the pointers to the
+// calls obtained before calling pushCall shouldn't be used afterwards.
+func (prgrm *CXProgram) pushCall() *CXCall {
+	if prgrm.CallCounter+1 >= len(prgrm.CallStack) {
+		if prgrm.CallCounter+1 >= MAX_CALLSTACK_SIZE {
+			panic(STACK_OVERFLOW_ERROR)
+		}
+		newSize := 2 * len(prgrm.CallStack)
+		if newSize > MAX_CALLSTACK_SIZE {
+			newSize = MAX_CALLSTACK_SIZE
+		}
+		callStack := make([]CXCall, newSize)
+		copy(callStack, prgrm.CallStack)
+		prgrm.CallStack = callStack
+	}
+	prgrm.CallCounter++
+	return &prgrm.CallStack[prgrm.CallCounter]
+}
+
 // ----------------------------------------------------------------
 //                             Getters
 
@@ -415,10 +473,9 @@ func (prgrm *CXProgram) SelectExpression(line int) (*CXExpression, error) {
 // PrintAllObjects prints all objects in a program
 //
 func (prgrm *CXProgram) PrintAllObjects() {
-	fp := 0
-
 	for c := 0; c <= prgrm.CallCounter; c++ {
 		op := prgrm.CallStack[c].Operator
+		fp := prgrm.CallStack[c].FramePointer
 
 		for _, ptr := range op.ListOfPointers {
 			heapOffset := mustDeserializeI32(prgrm.Memory[fp+ptr.Offset : fp+ptr.Offset+TYPE_POINTER_SIZE])
@@ -465,7 +522,5 @@ func (prgrm *CXProgram) PrintAllObjects() {
 
 			fmt.Println("obj", ptr.Name, ptr.CustomType, prgrm.Memory[heapOffset:int(heapOffset)+op.Size], byts)
 		}
-
-		fp += op.Size
 	}
 }
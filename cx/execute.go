@@ -68,8 +68,8 @@ func (prgrm *CXProgram) Run(untilEnd bool, nCalls *int, untilCall int) error {
 		call := &prgrm.CallStack[prgrm.CallCounter]
 
 		// checking if enough memory in stack
-		if prgrm.StackPointer > STACK_SIZE {
-			panic(STACK_OVERFLOW_ERROR)
+		if prgrm.StackPointer > prgrm.HeapStartsAt {
+			prgrm.growStack()
 		}
 
 		if !untilEnd {
@@ -114,6 +114,12 @@ func (prgrm *CXProgram) Run(untilEnd bool, nCalls *int, untilCall int) error {
 
 			fmt.Printf("in:%s, expr#:%d, calling:%s()\n", inName, call.Line+1, toCallName)
 			*nCalls--
+		} else {
+			prgrm.runInstructions(untilCall)
+			if prgrm.CallCounter <= untilCall {
+				break
+			}
+			call = &prgrm.CallStack[prgrm.CallCounter]
 		}
 
 		err = call.ccall(prgrm)
@@ -159,6 +165,7 @@ func (prgrm *CXProgram) RunCompiled(nCalls int, args []string) error {
 		panic(err)
 	}
 	prgrm.EnsureHeap()
+	prgrm.Lower()
 	rand.Seed(time.Now().UTC().UnixNano())
 
 	var untilEnd bool
@@ -175,8 +182,12 @@ func (prgrm *CXProgram) RunCompiled(nCalls int, args []string) error {
 			if fn, err := mod.SelectFunction(SYS_INIT_FUNC); err == nil {
 				// *init function
 				mainCall := MakeCall(fn)
+				mainCall.FramePointer = prgrm.StackStartsAt
 				prgrm.CallStack[0] = mainCall
-				prgrm.StackPointer = fn.Size
+				prgrm.StackPointer = prgrm.StackStartsAt + fn.Size
+				if prgrm.StackPointer > prgrm.HeapStartsAt {
+					prgrm.growStack()
+				}
 
 				var err error
 
@@ -204,12 +215,15 @@ func (prgrm *CXProgram) RunCompiled(nCalls int, args []string) error {
 			if prgrm.CallStack[0].Operator == nil {
 				// main function
 				mainCall := MakeCall(fn)
-				mainCall.FramePointer = prgrm.StackPointer
+				mainCall.FramePointer = prgrm.StackStartsAt
 				// initializing program resources
 				prgrm.CallStack[0] = mainCall
 
 				// prgrm.Stacks = append(prgrm.Stacks, MakeStack(1024))
-				prgrm.StackPointer += fn.Size
+				prgrm.StackPointer = prgrm.StackStartsAt + fn.Size
+				if prgrm.StackPointer > prgrm.HeapStartsAt {
+					prgrm.growStack()
+				}
 
 				// feeding os.Args
 				if osPkg, err := PROGRAM.SelectPackage(OS_PKG); err == nil {
@@ -271,26 +285,32 @@ func (call *CXCall) ccall(prgrm *CXProgram) error {
 			// then the program finished
 			prgrm.Terminated = true
 		} else {
-			// copying the outputs to the previous stack frame
-			returnAddr := &prgrm.CallStack[prgrm.CallCounter]
-			returnOp := returnAddr.Operator
-			returnLine := returnAddr.Line
-			returnFP := returnAddr.FramePointer
-			fp := call.FramePointer
-
-			expr := returnOp.Expressions[returnLine]
-
-			lenOuts := len(expr.Outputs)
-			for i, out := range call.Operator.Outputs {
-				// Continuing if there is no receiving variable available.
-				if i >= lenOuts {
-					continue
+			// copying the outputs to the previous stack frame. The outputs
+			// of a callback are read by `Callback` instead, as the
+			// expression of the previous call is the op that called it.
+			if call.IsCallback {
+				call.IsCallback = false
+			} else {
+				returnAddr := &prgrm.CallStack[prgrm.CallCounter]
+				returnOp := returnAddr.Operator
+				returnLine := returnAddr.Line
+				returnFP := returnAddr.FramePointer
+				fp := call.FramePointer
+
+				expr := returnOp.Expressions[returnLine]
+
+				lenOuts := len(expr.Outputs)
+				for i, out := range call.Operator.Outputs {
+					// Continuing if there is no receiving variable available.
+					if i >= lenOuts {
+						continue
+					}
+					WriteMemory(
+						GetFinalOffset(returnFP, expr.Outputs[i]),
+						ReadMemory(
+							GetFinalOffset(fp, out),
+							out))
 				}
-				WriteMemory(
-					GetFinalOffset(returnFP, expr.Outputs[i]),
-					ReadMemory(
-						GetFinalOffset(fp, out),
-						out))
 			}
 
 			// return the stack pointer to its previous state
@@ -308,97 +328,170 @@ func (call *CXCall) ccall(prgrm *CXProgram) error {
 		expr := fn.Expressions[call.Line]
 		// if it's a native, then we just process the arguments with execNative
 
-		if expr.Operator == nil {
+		if call.Line == 0 && fn.goBody != nil {
+			// the function was compiled to Go
+			prgrm.runGoBody()
+		} else if expr.Operator == nil {
 			// then it's a declaration
 			// wiping this declaration's memory (removing garbage)
-			newCall := &prgrm.CallStack[prgrm.CallCounter]
-			newFP := newCall.FramePointer
+			// Literals used as statements are expressions without operator
+			// too, so the output can be in the data segment.
+			offset := expr.Outputs[0].Offset
+			if offset < prgrm.StackSize {
+				offset += call.FramePointer
+			}
 			size := GetSize(expr.Outputs[0])
 			for c := 0; c < size; c++ {
-				prgrm.Memory[newFP+expr.Outputs[0].Offset+c] = 0
+				prgrm.Memory[offset+c] = 0
 			}
 			call.Line++
 		} else if expr.Operator.IsNative {
-			execNative(prgrm)
-			call.Line++
+			if expr.handler != nil {
+				expr.handler(prgrm)
+			} else {
+				execNative(prgrm)
+			}
+			// `call` is stale if a callback made the call stack grow
+			prgrm.CallStack[prgrm.CallCounter].Line++
 		} else {
 			/*
 			   It was not a native, so we need to create another call
 			   with the current expression's operator
 			*/
-			// we're going to use the next call in the callstack
-			prgrm.CallCounter++
-			if prgrm.CallCounter >= CALLSTACK_SIZE {
-				panic(STACK_OVERFLOW_ERROR)
-			}
-			newCall := &prgrm.CallStack[prgrm.CallCounter]
-			// setting the new call
-			newCall.Operator = expr.Operator
-			newCall.Line = 0
-			newCall.FramePointer = prgrm.StackPointer
-			// the stack pointer is moved to create room for the next call
-			// prgrm.MemoryPointer += fn.Size
-			prgrm.StackPointer += newCall.Operator.Size
-
-			// checking if enough memory in stack
-			if prgrm.StackPointer > STACK_SIZE {
-				panic(STACK_OVERFLOW_ERROR)
+			if expr.isTailCall {
+				// the called function returns to our caller, so it can use our frame
+				call.tailCall(prgrm, expr)
+				return nil
 			}
+			call.enterCall(prgrm, expr)
+		}
+	}
+	return nil
+}
+
+// enterCall pushes a call to the operator of `expr`, which must be a CX
+// function called by `call`, and copies its inputs to the new stack frame.
+func (call *CXCall) enterCall(prgrm *CXProgram, expr *CXExpression) {
+	// we're going to use the next call in the callstack
+	fp := call.FramePointer
+	newCall := prgrm.pushCall()
+	// setting the new call
+	newCall.Operator = expr.Operator
+	newCall.Line = 0
+	newCall.FramePointer = prgrm.StackPointer
+	newCall.IsCallback = false
+	// the stack pointer is moved to create room for the next call
+	// prgrm.MemoryPointer += fn.Size
+	prgrm.StackPointer += newCall.Operator.Size
 
-			fp := call.FramePointer
-			newFP := newCall.FramePointer
+	// checking if enough memory in stack
+	if prgrm.StackPointer > prgrm.HeapStartsAt {
+		prgrm.growStack()
+	}
 
-			// wiping next stack frame (removing garbage)
-			for c := 0; c < expr.Operator.Size; c++ {
-				prgrm.Memory[newFP+c] = 0
+	newFP := newCall.FramePointer
+
+	// wiping next stack frame (removing garbage)
+	frame := prgrm.Memory[newFP : newFP+expr.Operator.Size]
+	for c := range frame {
+		frame[c] = 0
+	}
+
+	for i, inp := range expr.Inputs {
+		var byts []byte
+		// finalOffset := inp.Offset
+		finalOffset := GetFinalOffset(fp, inp)
+		// finalOffset := fp + inp.Offset
+
+		// if inp.Indexes != nil {
+		// 	finalOffset = GetFinalOffset(&prgrm.Stacks[0], fp, inp)
+		// }
+		if inp.PassBy == PASSBY_REFERENCE {
+			// If we're referencing an inner element, like an element of a slice (&slc[0])
+			// or a field of a struct (&struct.fld) we no longer need to add
+			// the OBJECT_HEADER_SIZE to the offset
+			if inp.IsInnerReference {
+				finalOffset -= OBJECT_HEADER_SIZE
 			}
+			var finalOffsetB [4]byte
+			WriteMemI32(finalOffsetB[:], 0, int32(finalOffset))
+			byts = finalOffsetB[:]
+		} else {
+			size := GetSize(inp)
+			byts = prgrm.Memory[finalOffset : finalOffset+size]
+		}
 
-			for i, inp := range expr.Inputs {
-				var byts []byte
-				// finalOffset := inp.Offset
-				finalOffset := GetFinalOffset(fp, inp)
-				// finalOffset := fp + inp.Offset
-
-				// if inp.Indexes != nil {
-				// 	finalOffset = GetFinalOffset(&prgrm.Stacks[0], fp, inp)
-				// }
-				if inp.PassBy == PASSBY_REFERENCE {
-					// If we're referencing an inner element, like an element of a slice (&slc[0])
-					// or a field of a struct (&struct.fld) we no longer need to add
-					// the OBJECT_HEADER_SIZE to the offset
-					if inp.IsInnerReference {
-						finalOffset -= OBJECT_HEADER_SIZE
-					}
-					var finalOffsetB [4]byte
-					WriteMemI32(finalOffsetB[:], 0, int32(finalOffset))
-					byts = finalOffsetB[:]
-				} else {
-					size := GetSize(inp)
-					byts = prgrm.Memory[finalOffset : finalOffset+size]
-				}
+		// writing inputs to new stack frame
+		WriteMemory(
+			GetFinalOffset(newFP, newCall.Operator.Inputs[i]),
+			// newFP + newCall.Operator.Inputs[i].Offset,
+			// GetFinalOffset(prgrm.Memory, newFP, newCall.Operator.Inputs[i], MEM_WRITE),
+			byts)
+	}
+}
+
+// tailCall replaces the function being executed by `call` with the
+// operator of `expr`, which must be a call in tail position. The inputs are
+// read before the frame is wiped, as they can refer to it, and after the
+// stack grows, as they can refer to the heap.
+func (call *CXCall) tailCall(prgrm *CXProgram, expr *CXExpression) {
+	fp := call.FramePointer
+	callee := expr.Operator
+
+	prgrm.StackPointer = fp + callee.Size
+	if prgrm.StackPointer > prgrm.HeapStartsAt {
+		prgrm.growStack()
+	}
 
-				// writing inputs to new stack frame
-				WriteMemory(
-					GetFinalOffset(newFP, newCall.Operator.Inputs[i]),
-					// newFP + newCall.Operator.Inputs[i].Offset,
-					// GetFinalOffset(prgrm.Memory, newFP, newCall.Operator.Inputs[i], MEM_WRITE),
-					byts)
+	prgrm.tailCallBuffer = prgrm.tailCallBuffer[:0]
+	for _, inp := range expr.Inputs {
+		finalOffset := GetFinalOffset(fp, inp)
+		if inp.PassBy == PASSBY_REFERENCE {
+			if inp.IsInnerReference {
+				finalOffset -= OBJECT_HEADER_SIZE
 			}
+			var finalOffsetB [4]byte
+			WriteMemI32(finalOffsetB[:], 0, int32(finalOffset))
+			prgrm.tailCallBuffer = append(prgrm.tailCallBuffer, finalOffsetB[:]...)
+		} else {
+			prgrm.tailCallBuffer = append(prgrm.tailCallBuffer, prgrm.Memory[finalOffset:finalOffset+GetSize(inp)]...)
 		}
 	}
-	return nil
+
+	// wiping the frame (removing garbage)
+	frame := prgrm.Memory[fp : fp+callee.Size]
+	for c := range frame {
+		frame[c] = 0
+	}
+
+	call.Operator = callee
+	call.Line = 0
+
+	byts := prgrm.tailCallBuffer
+	for i, inp := range expr.Inputs {
+		size := 4
+		if inp.PassBy != PASSBY_REFERENCE {
+			size = GetSize(inp)
+		}
+		WriteMemory(GetFinalOffset(fp, callee.Inputs[i]), byts[:size])
+		byts = byts[size:]
+	}
 }
 
 // Callback ...
 func (prgrm *CXProgram) Callback(fn *CXFunction, inputs [][]byte) (outputs [][]byte) {
 	line := prgrm.CallStack[prgrm.CallCounter].Line
 	previousCall := prgrm.CallCounter
-	prgrm.CallCounter++
-	newCall := &prgrm.CallStack[prgrm.CallCounter]
+	heapStartsAt := prgrm.HeapStartsAt
+	newCall := prgrm.pushCall()
 	newCall.Operator = fn
 	newCall.Line = 0
 	newCall.FramePointer = prgrm.StackPointer
+	newCall.IsCallback = true
 	prgrm.StackPointer += newCall.Operator.Size
+	if prgrm.StackPointer > prgrm.HeapStartsAt {
+		prgrm.growStack()
+	}
 	newFP := newCall.FramePointer
 
 	// wiping next mem frame (removing garbage)
@@ -406,9 +499,7 @@ func (prgrm *CXProgram) Callback(fn *CXFunction, inputs [][]byte) (outputs [][]b
 		prgrm.Memory[newFP+c] = 0
 	}
 
-	for i, inp := range inputs {
-		WriteMemory(GetFinalOffset(newFP, newCall.Operator.Inputs[i]), inp)
-	}
+	prgrm.writeCallbackInputs(fn, newFP, inputs, heapStartsAt)
 
 	var nCalls = 0
 	if err := prgrm.Run(true, &nCalls, previousCall); err != nil {
@@ -428,3 +519,38 @@ func (prgrm *CXProgram) Callback(fn *CXFunction, inputs [][]byte) (outputs [][]b
 	}
 	return outputs
 }
+
+// writeCallbackInputs writes the inputs of a callback to its frame at `fp`.
+// The native function made them before the frame was pushed, so if the
+// stack grew, the addresses of the heap objects are moved with the heap,
+// which started at `heapStartsAt`.
+func (prgrm *CXProgram) writeCallbackInputs(fn *CXFunction, fp int, inputs [][]byte, heapStartsAt int) {
+	for i, inp := range inputs {
+		WriteMemory(GetFinalOffset(fp, fn.Inputs[i]), inp)
+	}
+
+	off := prgrm.HeapStartsAt - heapStartsAt
+	if off == 0 {
+		return
+	}
+	for _, inp := range fn.Inputs[:len(inputs)] {
+		offset := GetFinalOffset(fp, inp)
+		if IsPointer(inp) {
+			moveHeapAddress(prgrm, offset, heapStartsAt, off)
+		} else if IsStructWithPointers(inp) {
+			for _, fld := range inp.CustomType.Fields {
+				if IsPointer(fld) {
+					moveHeapAddress(prgrm, offset+fld.Offset, heapStartsAt, off)
+				}
+			}
+		}
+	}
+}
+
+// moveHeapAddress adds `off` to the address at `atOffset` if it's the
+// address of a heap object, in a heap that started at `heapStartsAt`.
+func moveHeapAddress(prgrm *CXProgram, atOffset int, heapStartsAt int, off int) {
+	if addr := int(ReadMemI32(prgrm.Memory, atOffset)); addr >= heapStartsAt {
+		WriteMemI32(prgrm.Memory, atOffset, int32(addr+off))
+	}
+}
@@ -118,3 +118,65 @@ func CXMkdirAll(path string, perm os.FileMode) error {
 
 	return err
 }
+
+// CXMkdir creates the directory `path` of the working directory.
+func CXMkdir(path string, perm os.FileMode) error {
+	if logFile {
+		fmt.Printf("Creating dir : '%s'\n", path)
+	}
+
+	err := os.Mkdir(fmt.Sprintf("%s%s", workingDir, path), perm)
+
+	if logFile && err != nil {
+		fmt.Printf("Failed to create dir : '%s', '%s', err '%v'\n", workingDir, path, err)
+	}
+
+	return err
+}
+
+// CXRemoveAll removes `path` of the working directory and everything it
+// contains.
+func CXRemoveAll(path string) error {
+	if logFile {
+		fmt.Printf("Removing all : '%s', '%s'\n", workingDir, path)
+	}
+
+	err := os.RemoveAll(fmt.Sprintf("%s%s", workingDir, path))
+
+	if logFile && err != nil {
+		fmt.Printf("Failed to remove all : '%s', '%s', err '%v'\n", workingDir, path, err)
+	}
+
+	return err
+}
+
+// CXRenameFile renames `oldPath` to `newPath`, both of the working directory.
+func CXRenameFile(oldPath string, newPath string) error {
+	if logFile {
+		fmt.Printf("Renaming file : '%s', '%s' to '%s'\n", workingDir, oldPath, newPath)
+	}
+
+	err := os.Rename(fmt.Sprintf("%s%s", workingDir, oldPath), fmt.Sprintf("%s%s", workingDir, newPath))
+
+	if logFile && err != nil {
+		fmt.Printf("Failed to rename file : '%s', '%s' to '%s', err '%v'\n", workingDir, oldPath, newPath, err)
+	}
+
+	return err
+}
+
+// CXReadDir reads the entries of the directory `path` of the working
+// directory, sorted by name.
+func CXReadDir(path string) ([]os.FileInfo, error) {
+	if logFile {
+		fmt.Printf("Reading dir : '%s', '%s'\n", workingDir, path)
+	}
+
+	fileInfos, err := ioutil.ReadDir(fmt.Sprintf("%s%s", workingDir, path))
+
+	if logFile && err != nil {
+		fmt.Printf("Failed to read dir : '%s', '%s', err '%v'\n", workingDir, path, err)
+	}
+
+	return fileInfos, err
+}
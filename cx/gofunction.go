@@ -0,0 +1,124 @@
+package cxcore
+
+import (
+	"fmt"
+)
+
+// The Go packages generated by `cx build` translate the functions of a CX
+// program to Go functions that run on the same memory layout as the
+// interpreter. A generated package makes the program as it was compiled,
+// binds the Go functions to their CX functions with BindGoFunction and runs
+// it as usual:
+// `ccall` executes the Go function of a call instead of its expressions.
+//
+// A Go function runs the expressions that can be expressed in Go directly
+// (arithmetic, comparisons, copies, jumps) and delegates the rest to the
+// runtime:
+//
+//   * GoExec runs a native expression or a declaration;
+//   * GoCall runs a call to a CX function and returns when it finishes;
+//   * GoTailCall replaces the current function by the called one, after
+//     which the Go function must return.
+//
+// The functions can be mixed with interpreted ones, so a program keeps
+// working if some of its functions couldn't be translated.
+
+// GoFunction is a CX function translated to Go. It runs the function whose
+// frame starts at `fp`, which is the function of the last call in the call
+// stack.
+type GoFunction func(prgrm *CXProgram, fp int)
+
+// BindGoFunction makes `body` run the function `fnName` of package `pkgName`.
+// `length` and `size` are the number of expressions and the frame size that
+// the function had when it was translated, which are compared with the ones
+// of `prgrm` to detect that its sources were compiled differently.
+func (prgrm *CXProgram) BindGoFunction(pkgName, fnName string, length, size int, body GoFunction) error {
+	pkg, err := prgrm.GetPackage(pkgName)
+	if err != nil {
+		return err
+	}
+	fn, err := pkg.GetFunction(fnName)
+	if err != nil {
+		return err
+	}
+	if fn.Length != length || fn.Size != size {
+		return fmt.Errorf("function '%s.%s' doesn't match its Go translation", pkgName, fnName)
+	}
+	fn.goBody = body
+	return nil
+}
+
+// runGoBody runs the Go function of the last call in the call stack, if it
+// has one, and following the tail calls made by it. The call is left
+// finished, so the next `ccall` pops it.
+func (prgrm *CXProgram) runGoBody() {
+	for {
+		call := &prgrm.CallStack[prgrm.CallCounter]
+		body := call.Operator.goBody
+		if body == nil {
+			// then the interpreter runs it
+			return
+		}
+
+		prgrm.goTailCall = false
+		body(prgrm, call.FramePointer)
+		if !prgrm.goTailCall {
+			call = &prgrm.CallStack[prgrm.CallCounter]
+			call.Line = call.Operator.Length
+			return
+		}
+	}
+}
+
+// GoExec runs the declaration or native expression at `line` of the
+// function being executed.
+func (prgrm *CXProgram) GoExec(line int) {
+	call := &prgrm.CallStack[prgrm.CallCounter]
+	call.Line = line
+	expr := call.Operator.Expressions[line]
+
+	if expr.Operator == nil {
+		// wiping the declaration's memory
+		offset := call.FramePointer + expr.Outputs[0].Offset
+		mem := prgrm.Memory[offset : offset+GetSize(expr.Outputs[0])]
+		for c := range mem {
+			mem[c] = 0
+		}
+	} else if expr.handler != nil {
+		expr.handler(prgrm)
+	} else {
+		execNative(prgrm)
+	}
+}
+
+// GoCall runs the call to a CX function at `line` of the function being
+// executed, and copies its outputs when it returns.
+func (prgrm *CXProgram) GoCall(line int) {
+	depth := prgrm.CallCounter
+	call := &prgrm.CallStack[depth]
+	call.Line = line
+	call.enterCall(prgrm, call.Operator.Expressions[line])
+	prgrm.runGoBody()
+
+	// running the called function if it wasn't translated, and popping it
+	var nCalls int
+	prgrm.Run(true, &nCalls, depth)
+}
+
+// GoTailCall replaces the function being executed by the function called in
+// tail position at `line`. The Go function must return right after, so the
+// called function is run in its place.
+func (prgrm *CXProgram) GoTailCall(line int) {
+	call := &prgrm.CallStack[prgrm.CallCounter]
+	call.Line = line
+	call.tailCall(prgrm, call.Operator.Expressions[line])
+	prgrm.goTailCall = true
+}
+
+// GoCond returns the predicate of the conditional jump at `line` of the
+// function being executed.
+func (prgrm *CXProgram) GoCond(line int) bool {
+	call := &prgrm.CallStack[prgrm.CallCounter]
+	call.Line = line
+	return ReadBool(call.FramePointer, call.Operator.Expressions[line].Inputs[0])
+}
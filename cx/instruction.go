@@ -0,0 +1,437 @@
+package cxcore
+
+// An instruction is an expression lowered to the operation that it performs
+// and the offsets of its operands, so the interpreter can execute it without
+// going through its arguments. `Lower` builds the instructions of every
+// function, and `runInstructions` executes them until it finds one that
+// needs the generic path of `ccall`.
+//
+// The offset of an operand in the stack is relative to the frame pointer,
+// and the offset of an operand in the data segment is absolute. The mask of
+// an operand is -1 in the first case and 0 in the second one, so its final
+// offset is `offset + fp&mask`.
+type instruction struct {
+	code int // instr* constant
+
+	// operands: `a` and `b` are read, `c` is written
+	a, b, c             int
+	aMask, bMask, cMask int
+	size                int // bytes copied by instrIdentity and cleared by instrDecl
+
+	// lines skipped by instrJmp and instrGoto
+	thenLines, elseLines int
+
+	// called function of instrCall and the copies of its inputs and outputs
+	fn      *CXFunction
+	inputs  []instructionCopy
+	outputs []instructionCopy
+}
+
+// instructionCopy copies `size` bytes of an input or an output of a call.
+// The source of an input and the destination of an output are in the frame
+// of the caller, while the other end is in the frame of the called function.
+type instructionCopy struct {
+	offset, mask int // operand in the frame of the caller
+	param        int // offset of the parameter of the called function
+	size         int
+}
+
+const (
+	instrGeneric = iota // executed by `ccall`
+	instrDecl
+	instrIdentity
+	instrGoto
+	instrJmp
+	instrCall
+
+	instrI32Add
+	instrI32Sub
+	instrI32Mul
+	instrI32Div
+	instrI32Mod
+	instrI32Gt
+	instrI32Gteq
+	instrI32Lt
+	instrI32Lteq
+	instrI32Eq
+	instrI32Uneq
+
+	instrI64Add
+	instrI64Sub
+	instrI64Mul
+	instrI64Div
+	instrI64Mod
+	instrI64Gt
+	instrI64Gteq
+	instrI64Lt
+	instrI64Lteq
+	instrI64Eq
+	instrI64Uneq
+
+	instrF64Add
+	instrF64Sub
+	instrF64Mul
+	instrF64Div
+	instrF64Gt
+	instrF64Gteq
+	instrF64Lt
+	instrF64Lteq
+	instrF64Eq
+	instrF64Uneq
+)
+
+// binaryInstructions maps the opcodes of the binary operators executed by
+// `runInstructions` to their instruction and to the size of their inputs.
+var binaryInstructions = map[int]struct{ code, size int }{
+	OP_I32_ADD:  {instrI32Add, 4},
+	OP_I32_SUB:  {instrI32Sub, 4},
+	OP_I32_MUL:  {instrI32Mul, 4},
+	OP_I32_DIV:  {instrI32Div, 4},
+	OP_I32_MOD:  {instrI32Mod, 4},
+	OP_I32_GT:   {instrI32Gt, 4},
+	OP_I32_GTEQ: {instrI32Gteq, 4},
+	OP_I32_LT:   {instrI32Lt, 4},
+	OP_I32_LTEQ: {instrI32Lteq, 4},
+	OP_I32_EQ:   {instrI32Eq, 4},
+	OP_I32_UNEQ: {instrI32Uneq, 4},
+
+	OP_I64_ADD:  {instrI64Add, 8},
+	OP_I64_SUB:  {instrI64Sub, 8},
+	OP_I64_MUL:  {instrI64Mul, 8},
+	OP_I64_DIV:  {instrI64Div, 8},
+	OP_I64_MOD:  {instrI64Mod, 8},
+	OP_I64_GT:   {instrI64Gt, 8},
+	OP_I64_GTEQ: {instrI64Gteq, 8},
+	OP_I64_LT:   {instrI64Lt, 8},
+	OP_I64_LTEQ: {instrI64Lteq, 8},
+	OP_I64_EQ:   {instrI64Eq, 8},
+	OP_I64_UNEQ: {instrI64Uneq, 8},
+
+	OP_F64_ADD:  {instrF64Add, 8},
+	OP_F64_SUB:  {instrF64Sub, 8},
+	OP_F64_MUL:  {instrF64Mul, 8},
+	OP_F64_DIV:  {instrF64Div, 8},
+	OP_F64_GT:   {instrF64Gt, 8},
+	OP_F64_GTEQ: {instrF64Gteq, 8},
+	OP_F64_LT:   {instrF64Lt, 8},
+	OP_F64_LTEQ: {instrF64Lteq, 8},
+	OP_F64_EQ:   {instrF64Eq, 8},
+	OP_F64_UNEQ: {instrF64Uneq, 8},
+}
+
+// lowerInstructions returns the instructions of the expressions of `fn`.
+func lowerInstructions(fn *CXFunction) []instruction {
+	if fn.Length != len(fn.Expressions) {
+		return nil
+	}
+	instrs := make([]instruction, len(fn.Expressions))
+	for i, expr := range fn.Expressions {
+		lowerInstruction(expr, &instrs[i])
+	}
+	return instrs
+}
+
+// lowerInstruction lowers `expr` to `instr`, which is left as an
+// instrGeneric if `runInstructions` can't execute the expression.
+func lowerInstruction(expr *CXExpression, instr *instruction) {
+	switch {
+	case expr.Operator == nil:
+		// declaration
+		if len(expr.Outputs) == 1 {
+			instr.code = instrDecl
+			setOperand(expr.Outputs[0], &instr.c, &instr.cMask)
+			instr.size = GetSize(expr.Outputs[0])
+		}
+	case !expr.Operator.IsNative:
+		if !expr.isTailCall {
+			lowerCall(expr, instr)
+		}
+	case expr.Operator.OpCode == OP_JMP:
+		if expr.Label != "" {
+			instr.code = instrGoto
+			instr.thenLines = expr.ThenLines
+		} else if len(expr.Inputs) == 1 && isDirectOperand(expr.Inputs[0], 1) {
+			instr.code = instrJmp
+			setOperand(expr.Inputs[0], &instr.a, &instr.aMask)
+			instr.thenLines = expr.ThenLines
+			instr.elseLines = expr.ElseLines
+		}
+	case expr.Operator.OpCode == OP_IDENTITY:
+		if len(expr.Inputs) != 1 || len(expr.Outputs) != 1 {
+			return
+		}
+		inp, out := expr.Inputs[0], expr.Outputs[0]
+		if isDirectOperand(inp, GetSize(inp)) && isDirectOperand(out, -1) &&
+			!out.DoesEscape && out.PassBy == PASSBY_VALUE {
+			instr.code = instrIdentity
+			setOperand(inp, &instr.a, &instr.aMask)
+			setOperand(out, &instr.c, &instr.cMask)
+			instr.size = GetSize(inp)
+		}
+	default:
+		bin, ok := binaryInstructions[resolveOpCode(expr)]
+		if !ok || len(expr.Inputs) != 2 || len(expr.Outputs) != 1 {
+			return
+		}
+		if isDirectOperand(expr.Inputs[0], bin.size) && isDirectOperand(expr.Inputs[1], bin.size) &&
+			isDirectOperand(expr.Outputs[0], -1) {
+			instr.code = bin.code
+			setOperand(expr.Inputs[0], &instr.a, &instr.aMask)
+			setOperand(expr.Inputs[1], &instr.b, &instr.bMask)
+			setOperand(expr.Outputs[0], &instr.c, &instr.cMask)
+		}
+	}
+}
+
+// lowerCall lowers the call to a CX function `expr` to an instrCall, if its
+// inputs and outputs are copied by value from and to direct operands.
+func lowerCall(expr *CXExpression, instr *instruction) {
+	fn := expr.Operator
+	if len(expr.Inputs) != len(fn.Inputs) {
+		return
+	}
+
+	inputs := make([]instructionCopy, len(expr.Inputs))
+	for i, inp := range expr.Inputs {
+		param := fn.Inputs[i]
+		size := GetSize(inp)
+		if inp.PassBy != PASSBY_VALUE || !isDirectOperand(inp, size) || !isDirectOperand(param, -1) {
+			return
+		}
+		inputs[i] = instructionCopy{param: param.Offset, size: size}
+		setOperand(inp, &inputs[i].offset, &inputs[i].mask)
+	}
+
+	var outputs []instructionCopy
+	for i, param := range fn.Outputs {
+		if i >= len(expr.Outputs) {
+			break
+		}
+		out := expr.Outputs[i]
+		if !isDirectOperand(out, -1) || !isDirectOperand(param, -1) {
+			return
+		}
+		cop := instructionCopy{param: param.Offset, size: GetSize(param)}
+		setOperand(out, &cop.offset, &cop.mask)
+		outputs = append(outputs, cop)
+	}
+
+	instr.code = instrCall
+	instr.fn = fn
+	instr.inputs = inputs
+	instr.outputs = outputs
+}
+
+// isDirectOperand checks if the final offset of `arg` only depends on the
+// frame pointer and, if `size` is not -1, if `arg` is `size` bytes long.
+func isDirectOperand(arg *CXArgument, size int) bool {
+	if len(arg.DereferenceOperations) != 0 || len(arg.Fields) != 0 {
+		return false
+	}
+	return size == -1 || GetSize(arg) == size
+}
+
+// setOperand sets the offset and the mask of the operand `arg`.
+func setOperand(arg *CXArgument, offset *int, mask *int) {
+	*offset = arg.Offset
+	*mask = 0
+	if arg.Offset < PROGRAM.StackSize {
+		*mask = -1
+	}
+}
+
+// runInstructions executes the instructions of the current call, and of the
+// calls that it makes, until it finds an instruction that `ccall` has to
+// execute or until the call counter would go down to `untilCall`. The line
+// of the current call is saved before returning, so `ccall` resumes from
+// the instruction that stopped it.
+func (prgrm *CXProgram) runInstructions(untilCall int) {
+	call := &prgrm.CallStack[prgrm.CallCounter]
+	fn := call.Operator
+	instrs := fn.instructions
+	if instrs == nil || fn.goBody != nil && call.Line == 0 {
+		return
+	}
+
+	mem := prgrm.Memory
+	fp := call.FramePointer
+	line := call.Line
+
+loop:
+	for {
+		if line >= len(instrs) {
+			// returning to the caller, if it made the call with an instrCall
+			if call.IsCallback || prgrm.CallCounter-1 <= untilCall {
+				break loop
+			}
+			caller := &prgrm.CallStack[prgrm.CallCounter-1]
+			callerInstrs := caller.Operator.instructions
+			if caller.Line >= len(callerInstrs) {
+				break loop
+			}
+			instr := &callerInstrs[caller.Line]
+			if instr.code != instrCall || instr.fn != fn {
+				break loop
+			}
+
+			callerFP := caller.FramePointer
+			for _, out := range instr.outputs {
+				copy(mem[out.offset+callerFP&out.mask:][:out.size], mem[fp+out.param:][:out.size])
+			}
+			prgrm.StackPointer = fp
+			prgrm.CallCounter--
+
+			call = caller
+			fn = call.Operator
+			instrs = callerInstrs
+			fp = callerFP
+			line = call.Line + 1
+			continue
+		}
+
+		instr := &instrs[line]
+		switch instr.code {
+		case instrDecl:
+			frame := mem[instr.c+fp&instr.cMask:][:instr.size]
+			for c := range frame {
+				frame[c] = 0
+			}
+		case instrIdentity:
+			copy(mem[instr.c+fp&instr.cMask:][:instr.size], mem[instr.a+fp&instr.aMask:][:instr.size])
+		case instrGoto:
+			line += instr.thenLines
+		case instrJmp:
+			switch mem[instr.a+fp&instr.aMask] {
+			case 0:
+				line += instr.elseLines
+			case 1:
+				line += instr.thenLines
+			default:
+				// invalid bool, reported by opJmp
+				break loop
+			}
+		case instrCall:
+			callee := instr.fn
+			newFP := prgrm.StackPointer
+			if newFP+callee.Size > prgrm.HeapStartsAt {
+				// the stack needs to grow, which moves the heap
+				break loop
+			}
+
+			call.Line = line
+			call = prgrm.pushCall()
+			call.Operator = callee
+			call.Line = 0
+			call.FramePointer = newFP
+			call.IsCallback = false
+			prgrm.StackPointer = newFP + callee.Size
+
+			// wiping the new frame (removing garbage)
+			frame := mem[newFP : newFP+callee.Size]
+			for c := range frame {
+				frame[c] = 0
+			}
+			for _, inp := range instr.inputs {
+				copy(mem[newFP+inp.param:][:inp.size], mem[inp.offset+fp&inp.mask:][:inp.size])
+			}
+
+			fn = callee
+			instrs = fn.instructions
+			fp = newFP
+			line = 0
+			if instrs == nil || fn.goBody != nil {
+				break loop
+			}
+			continue
+
+		case instrI32Add:
+			WriteMemI32(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask)+ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Sub:
+			WriteMemI32(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask)-ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Mul:
+			WriteMemI32(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask)*ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Div, instrI32Mod:
+			b := ReadMemI32(mem, instr.b+fp&instr.bMask)
+			if b == 0 {
+				// division by zero, reported by the handler
+				break loop
+			}
+			a := ReadMemI32(mem, instr.a+fp&instr.aMask)
+			if instr.code == instrI32Div {
+				WriteMemI32(mem, instr.c+fp&instr.cMask, a/b)
+			} else {
+				WriteMemI32(mem, instr.c+fp&instr.cMask, a%b)
+			}
+		case instrI32Gt:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask) > ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Gteq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask) >= ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Lt:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask) < ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Lteq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask) <= ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Eq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask) == ReadMemI32(mem, instr.b+fp&instr.bMask))
+		case instrI32Uneq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI32(mem, instr.a+fp&instr.aMask) != ReadMemI32(mem, instr.b+fp&instr.bMask))
+
+		case instrI64Add:
+			WriteMemI64(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask)+ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Sub:
+			WriteMemI64(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask)-ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Mul:
+			WriteMemI64(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask)*ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Div, instrI64Mod:
+			b := ReadMemI64(mem, instr.b+fp&instr.bMask)
+			if b == 0 {
+				// division by zero, reported by the handler
+				break loop
+			}
+			a := ReadMemI64(mem, instr.a+fp&instr.aMask)
+			if instr.code == instrI64Div {
+				WriteMemI64(mem, instr.c+fp&instr.cMask, a/b)
+			} else {
+				WriteMemI64(mem, instr.c+fp&instr.cMask, a%b)
+			}
+		case instrI64Gt:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask) > ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Gteq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask) >= ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Lt:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask) < ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Lteq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask) <= ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Eq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask) == ReadMemI64(mem, instr.b+fp&instr.bMask))
+		case instrI64Uneq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemI64(mem, instr.a+fp&instr.aMask) != ReadMemI64(mem, instr.b+fp&instr.bMask))
+
+		case instrF64Add:
+			WriteMemF64(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask)+ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Sub:
+			WriteMemF64(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask)-ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Mul:
+			WriteMemF64(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask)*ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Div:
+			WriteMemF64(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask)/ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Gt:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask) > ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Gteq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask) >= ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Lt:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask) < ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Lteq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask) <= ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Eq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask) == ReadMemF64(mem, instr.b+fp&instr.bMask))
+		case instrF64Uneq:
+			WriteMemBool(mem, instr.c+fp&instr.cMask, ReadMemF64(mem, instr.a+fp&instr.aMask) != ReadMemF64(mem, instr.b+fp&instr.bMask))
+
+		default:
+			break loop
+		}
+		line++
+	}
+
+	call.Line = line
+}
@@ -0,0 +1,192 @@
+package cxcore
+
+// Lowering is a post-compilation step that resolves, once per expression,
+// information that the interpreter loop would otherwise recompute every time
+// an expression is executed:
+//
+//   * the expressions of every function are lowered to an array of
+//     instructions with the offsets of their operands, which
+//     `runInstructions` executes without going through `ccall` (see
+//     instruction.go). Arithmetic, comparisons, assignments, declarations,
+//     jumps and calls are lowered when their operands are direct;
+//   * the opcode handler of native expressions is cached in the expression,
+//     so `ccall` does not need to go through `execNative` and the handlers
+//     table;
+//   * undefined-type operators (`add`, `lt`, `eq`, ...) are resolved to their
+//     typed counterpart (`i32.add`, `i32.lt`, ...), as the type of their
+//     first input is known at compile time;
+//   * arguments that are not dereferenced, indexed or accessed through
+//     fields are flagged as direct, so `GetFinalOffset` only needs to add
+//     the frame pointer to their offset and `GetSize` returns a cached size;
+//   * calls in tail position are flagged, so `ccall` can reuse the frame of
+//     the caller instead of pushing a new call (see `isTailCall`).
+//
+// Expressions created after lowering take the generic path of `ccall`, but
+// the flags of an argument are copied with it, so a program must be lowered
+// again after it is modified. `RunCompiled` lowers the program every time it
+// runs it.
+
+// Lower resolves the operands and handlers of every expression in `prgrm`.
+// It is safe to call it several times, e.g. after the REPL modifies a program.
+func (prgrm *CXProgram) Lower() {
+	for _, pkg := range prgrm.Packages {
+		for _, fn := range pkg.Functions {
+			LowerFunction(fn)
+		}
+	}
+}
+
+// LowerFunction resolves the operands and handlers of the expressions of `fn`.
+func LowerFunction(fn *CXFunction) {
+	canReuseFrame := TAIL_CALLS && !hasStackReferences(fn)
+	for i, expr := range fn.Expressions {
+		lowerExpression(expr)
+		expr.isTailCall = canReuseFrame && isTailCall(fn, i)
+	}
+	fn.instructions = lowerInstructions(fn)
+}
+
+// isTailCall checks if the `i`th expression of `fn` is a call to a CX
+// function whose outputs are the outputs of `fn` and after which `fn`
+// returns. The frame of `fn` can then be reused by the called function, as
+// the outputs that it returns are the ones that `fn` would have returned.
+// The outputs of both functions must also be at the same offsets, as the
+// caller of `fn` may read them with the offsets of `fn`, e.g. `Callback`.
+func isTailCall(fn *CXFunction, i int) bool {
+	expr := fn.Expressions[i]
+	if expr.Operator == nil || expr.Operator.IsNative {
+		return false
+	}
+
+	// the call must be followed by the end of the function or by a return
+	if i+1 < len(fn.Expressions) {
+		next := fn.Expressions[i+1]
+		if next.Operator != Natives[OP_JMP] || next.Label == "" || next.ThenLines != MAX_INT32 {
+			return false
+		}
+	}
+
+	callee := expr.Operator
+	if len(expr.Outputs) != len(fn.Outputs) || len(callee.Outputs) != len(fn.Outputs) {
+		return false
+	}
+	for j, out := range expr.Outputs {
+		param := fn.Outputs[j]
+		if !out.isDirect || out.PassBy != PASSBY_VALUE || out.Offset != param.Offset ||
+			out.Type != param.Type || GetSize(out) != GetSize(param) ||
+			callee.Outputs[j].Offset != param.Offset || GetSize(callee.Outputs[j]) != GetSize(param) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasStackReferences checks if `fn` takes the address of a variable in its
+// stack frame, in which case the frame can't be reused by a tail call.
+func hasStackReferences(fn *CXFunction) bool {
+	for _, expr := range fn.Expressions {
+		for _, inp := range expr.Inputs {
+			if inp.PassBy == PASSBY_REFERENCE && inp.Offset < PROGRAM.StackSize {
+				return true
+			}
+		}
+		for _, out := range expr.Outputs {
+			if out.PassBy == PASSBY_REFERENCE && out.Offset < PROGRAM.StackSize {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lowerExpression caches the opcode handler of `expr` and flags its direct arguments.
+func lowerExpression(expr *CXExpression) {
+	expr.handler = nil
+	if expr.Operator != nil && expr.Operator.IsNative {
+		expr.handler = resolveHandler(expr)
+	}
+
+	for _, inp := range expr.Inputs {
+		lowerArgument(inp)
+	}
+	for _, out := range expr.Outputs {
+		lowerArgument(out)
+	}
+}
+
+// lowerArgument flags `arg` as direct if its final offset only depends on
+// the frame pointer. The arguments used as indexes are lowered too, as they
+// are read every time `arg` is accessed.
+func lowerArgument(arg *CXArgument) {
+	arg.isDirect = false
+	if len(arg.DereferenceOperations) == 0 && len(arg.Fields) == 0 {
+		arg.directSize = GetSize(arg)
+		arg.isDirect = true
+	}
+
+	for _, idx := range arg.Indexes {
+		lowerArgument(idx)
+	}
+	for _, fld := range arg.Fields {
+		for _, idx := range fld.Indexes {
+			lowerArgument(idx)
+		}
+	}
+}
+
+// undTypedOps lists the undefined-type operators that only dispatch on the
+// type of their first input, and which can therefore be resolved to the
+// typed operator named "<type>.<name>".
+var undTypedOps = map[int]bool{
+	OP_UND_EQUAL:    true,
+	OP_UND_UNEQUAL:  true,
+	OP_UND_BITAND:   true,
+	OP_UND_BITXOR:   true,
+	OP_UND_BITOR:    true,
+	OP_UND_BITCLEAR: true,
+	OP_UND_MUL:      true,
+	OP_UND_DIV:      true,
+	OP_UND_MOD:      true,
+	OP_UND_ADD:      true,
+	OP_UND_SUB:      true,
+	OP_UND_NEG:      true,
+	OP_UND_BITSHL:   true,
+	OP_UND_BITSHR:   true,
+	OP_UND_LT:       true,
+	OP_UND_GT:       true,
+	OP_UND_LTEQ:     true,
+	OP_UND_GTEQ:     true,
+}
+
+// resolveHandler returns the handler that executes the native expression `expr`.
+func resolveHandler(expr *CXExpression) OpcodeHandler {
+	code := resolveOpCode(expr)
+	if code < 0 || code >= len(opcodeHandlers) {
+		return nil
+	}
+	return opcodeHandlers[code]
+}
+
+// resolveOpCode returns the opcode of the native expression `expr`, which
+// is the opcode of the typed operator if `expr` calls an undefined-type one.
+func resolveOpCode(expr *CXExpression) int {
+	code := expr.Operator.OpCode
+	if undTypedOps[code] && len(expr.Inputs) > 0 {
+		typName := TypeNames[expr.Inputs[0].Type]
+		if typedCode, ok := OpCodes[typName+"."+OpNames[code]]; ok && typedCode < len(opcodeHandlers) && opcodeHandlers[typedCode] != nil {
+			return typedCode
+		}
+	}
+	return code
+}
+
+// IsTailCall checks if `expr` was flagged by Lower as a call in tail position.
+func (expr *CXExpression) IsTailCall() bool {
+	return expr.isTailCall
+}
+
+// IsDirect checks if `arg` was flagged by Lower as a direct argument, whose
+// final offset only depends on the frame pointer.
+func (arg *CXArgument) IsDirect() bool {
+	return arg.isDirect
+}
@@ -9,6 +9,10 @@ import (
 
 // GetSize ...
 func GetSize(arg *CXArgument) int {
+	if arg.isDirect {
+		return arg.directSize
+	}
+
 	if len(arg.Fields) > 0 {
 		return GetSize(arg.Fields[len(arg.Fields)-1])
 	}
@@ -140,6 +144,14 @@ func GetFinalOffset(fp int, arg *CXArgument) int {
 	// defer RuntimeError(PROGRAM)
 	// var elt *CXArgument
 	finalOffset := arg.Offset
+
+	if arg.isDirect {
+		// Fast path for arguments resolved by `Lower`.
+		if finalOffset < PROGRAM.StackSize {
+			finalOffset += fp
+		}
+		return finalOffset
+	}
 	// var fldIdx int
 
 	// elt = arg
@@ -451,10 +463,9 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 		}
 	}
 
-	var fp int
-
 	for c := 0; c <= prgrm.CallCounter; c++ {
 		op := prgrm.CallStack[c].Operator
+		fp := prgrm.CallStack[c].FramePointer
 
 		// TODO: Some standard library functions "manually" add a function
 		// call (callbacks) to `PRGRM.CallStack`. These functions do not have an
@@ -512,15 +523,22 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 				}
 			}
 
+			// Checking the pointer fields of a structure instance stored in the stack.
+			if IsStructWithPointers(ptr) {
+				for _, fld := range ptr.CustomType.Fields {
+					if IsPointer(fld) {
+						updatePointerTree(prgrm, offset+fld.Offset, oldAddr, newAddr, fld.Type, fld.DeclarationSpecifiers[1:])
+					}
+				}
+			}
+
 		}
 
-		fp += op.Size
 	}
 }
 
 // MarkAndCompact ...
 func MarkAndCompact(prgrm *CXProgram) {
-	var fp int
 	var faddr = int32(NULL_HEAP_ADDRESS_OFFSET)
 
 	// marking, setting forward addresses and updating references
@@ -568,6 +586,7 @@ func MarkAndCompact(prgrm *CXProgram) {
 	// local variables
 	for c := 0; c <= prgrm.CallCounter; c++ {
 		op := prgrm.CallStack[c].Operator
+		fp := prgrm.CallStack[c].FramePointer
 
 		// TODO: Some standard library functions "manually" add a function
 		// call (callbacks) to `PRGRM.CallStack`. These functions do not have an
@@ -612,9 +631,17 @@ func MarkAndCompact(prgrm *CXProgram) {
 				fld := ptr.Fields[len(ptr.Fields)-1]
 				MarkObjectsTree(prgrm, offset+fld.Offset, fld.Type, fld.DeclarationSpecifiers[1:])
 			}
+
+			// Checking the pointer fields of a structure instance stored in the stack.
+			if IsStructWithPointers(ptr) {
+				for _, fld := range ptr.CustomType.Fields {
+					if IsPointer(fld) {
+						MarkObjectsTree(prgrm, offset+fld.Offset, fld.Type, fld.DeclarationSpecifiers[1:])
+					}
+				}
+			}
 		}
 
-		fp += op.Size
 	}
 
 	// Relocation of live objects.
@@ -661,12 +688,38 @@ func ResizeMemory(prgrm *CXProgram, newMemSize int, isExpand bool) {
 		prgrm.Memory = append(prgrm.Memory, make([]byte, newMemSize-prgrm.HeapSize)...)
 		prgrm.HeapSize = newMemSize
 	} else {
-		// Removing bytes to reach a heap equal to `newMemSize`.
-		prgrm.Memory = append([]byte(nil), prgrm.Memory[:prgrm.HeapStartsAt+newMemSize]...)
+		// Removing bytes to reach a heap equal to `newMemSize`. The bytes are
+		// kept in the slice's capacity, as copying the memory would also use the
+		// pages of the stack that are not used.
+		prgrm.Memory = prgrm.Memory[:prgrm.HeapStartsAt+newMemSize]
 		prgrm.HeapSize = newMemSize
 	}
 }
 
+// MoveHeap moves the heap `off` bytes up and updates the references to its
+// objects, so the bytes before it can be used by the stack. The objects are
+// moved starting from the last one, so an updated reference never points to
+// an object that wasn't moved yet.
+func MoveHeap(prgrm *CXProgram, off int) {
+	prgrm.Memory = append(prgrm.Memory, make([]byte, off)...)
+
+	var objects []int
+	for c := prgrm.HeapStartsAt + NULL_HEAP_ADDRESS_OFFSET; c < prgrm.HeapStartsAt+prgrm.HeapPointer; {
+		objects = append(objects, c)
+		c += int(mustDeserializeI32(prgrm.Memory[c+MARK_SIZE+FORWARDING_ADDRESS_SIZE : c+MARK_SIZE+FORWARDING_ADDRESS_SIZE+OBJECT_SIZE]))
+	}
+
+	for i := len(objects) - 1; i >= 0; i-- {
+		c := objects[i]
+		objSize := int(mustDeserializeI32(prgrm.Memory[c+MARK_SIZE+FORWARDING_ADDRESS_SIZE : c+MARK_SIZE+FORWARDING_ADDRESS_SIZE+OBJECT_SIZE]))
+
+		updatePointers(prgrm, int32(c), int32(c+off))
+		copy(prgrm.Memory[c+off:c+off+objSize], prgrm.Memory[c:c+objSize])
+	}
+
+	prgrm.HeapStartsAt += off
+}
+
 // AllocateSeq allocates memory in the heap
 func AllocateSeq(size int) (offset int) {
 	// Current object trying to be allocated would use this address.
@@ -911,6 +964,70 @@ func WriteMemF64(mem []byte, offset int, f float64) {
 	mem[offset+7] = byte(v >> 56)
 }
 
+// WriteMemBool ...
+func WriteMemBool(mem []byte, offset int, b bool) {
+	v := byte(0)
+	if b {
+		v = 1
+	}
+	mem[offset] = v
+}
+
+// ReadMemBool ...
+func ReadMemBool(mem []byte, offset int) bool {
+	return mustDeserializeBool(mem[offset:])
+}
+
+// ReadMemI8 ...
+func ReadMemI8(mem []byte, offset int) int8 {
+	return mustDeserializeI8(mem[offset:])
+}
+
+// ReadMemI16 ...
+func ReadMemI16(mem []byte, offset int) int16 {
+	return mustDeserializeI16(mem[offset:])
+}
+
+// ReadMemI32 ...
+func ReadMemI32(mem []byte, offset int) int32 {
+	return mustDeserializeI32(mem[offset:])
+}
+
+// ReadMemI64 ...
+func ReadMemI64(mem []byte, offset int) int64 {
+	return mustDeserializeI64(mem[offset:])
+}
+
+// ReadMemUI8 ...
+func ReadMemUI8(mem []byte, offset int) uint8 {
+	return mustDeserializeUI8(mem[offset:])
+}
+
+// ReadMemUI16 ...
+func ReadMemUI16(mem []byte, offset int) uint16 {
+	return mustDeserializeUI16(mem[offset:])
+}
+
+// ReadMemUI32 ...
+func ReadMemUI32(mem []byte, offset int) uint32 {
+	return mustDeserializeUI32(mem[offset:])
+}
+
+// ReadMemUI64 ...
+func ReadMemUI64(mem []byte, offset int) uint64 {
+	return mustDeserializeUI64(mem[offset:])
+}
+
+// ReadMemF32 ...
+func ReadMemF32(mem []byte, offset int) float32 {
+	return mustDeserializeF32(mem[offset:])
+}
+
+// ReadMemF64 ...
+func ReadMemF64(mem []byte, offset int) float64 {
+	return mustDeserializeF64(mem[offset:])
+}
+
 // FromStr ...
 func FromStr(in string) []byte {
 	return encoder.Serialize(in)
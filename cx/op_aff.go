@@ -63,14 +63,18 @@ func opAffPrint(prgrm *CXProgram) {
 
 // CallAffPredicate ...
 func CallAffPredicate(fn *CXFunction, predValue []byte) byte {
-	prevCall := &PROGRAM.CallStack[PROGRAM.CallCounter]
+	prevCC := PROGRAM.CallCounter
+	heapStartsAt := PROGRAM.HeapStartsAt
 
-	PROGRAM.CallCounter++
-	newCall := &PROGRAM.CallStack[PROGRAM.CallCounter]
+	newCall := PROGRAM.pushCall()
 	newCall.Operator = fn
 	newCall.Line = 0
 	newCall.FramePointer = PROGRAM.StackPointer
+	newCall.IsCallback = false
 	PROGRAM.StackPointer += newCall.Operator.Size
+	if PROGRAM.StackPointer > PROGRAM.HeapStartsAt {
+		PROGRAM.growStack()
+	}
 
 	newFP := newCall.FramePointer
 
@@ -80,28 +84,22 @@ func CallAffPredicate(fn *CXFunction, predValue []byte) byte {
 	}
 
 	// sending value to predicate function
-	WriteMemory(
-		GetFinalOffset(newFP, newCall.Operator.Inputs[0]),
-		predValue)
+	PROGRAM.writeCallbackInputs(fn, newFP, [][]byte{predValue}, heapStartsAt)
 
-	prevCC := PROGRAM.CallCounter
 	for {
 		call := &PROGRAM.CallStack[PROGRAM.CallCounter]
 		err := call.ccall(PROGRAM)
 		if err != nil {
 			panic(err)
 		}
-		if PROGRAM.CallCounter < prevCC {
+		if PROGRAM.CallCounter <= prevCC {
 			break
 		}
 	}
 
-	prevCall.Line--
+	PROGRAM.CallStack[prevCC].Line--
 
-	return ReadMemory(GetFinalOffset(
-		newCall.FramePointer,
-		newCall.Operator.Outputs[0]),
-		newCall.Operator.Outputs[0])[0]
+	return ReadMemory(GetFinalOffset(newFP, fn.Outputs[0]), fn.Outputs[0])[0]
 }
 
 // This might not make sense, as we can use normal programming to create conditions on values
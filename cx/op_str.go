@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/skycoin/skycoin/src/cipher/encoder"
 )
@@ -216,6 +217,24 @@ func opStrIndex(prgrm *CXProgram) {
 	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), int32(strings.Index(str, substr)))
 }
 
+// opStrDecodeRune decodes the UTF-8 encoded rune that starts at the byte
+// offset given by the second input, and returns it and its size in bytes. The
+// size is 0 at the end of the string, and an invalid encoding is decoded as
+// the rune U+FFFD of size 1.
+func opStrDecodeRune(prgrm *CXProgram) {
+	expr := prgrm.GetExpr()
+	fp := prgrm.GetFramePointer()
+
+	str := ReadStr(fp, expr.Inputs[0])
+	offset := ReadI32(fp, expr.Inputs[1])
+	if offset < 0 || int(offset) > len(str) {
+		panic(CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE)
+	}
+	r, size := utf8.DecodeRuneInString(str[offset:])
+	WriteI32(GetFinalOffset(fp, expr.Outputs[0]), r)
+	WriteI32(GetFinalOffset(fp, expr.Outputs[1]), int32(size))
+}
+
 func opStrLastIndex(prgrm *CXProgram) {
 	expr := prgrm.GetExpr()
 	fp := prgrm.GetFramePointer()
@@ -675,6 +675,8 @@ func buildString(expr *CXExpression, fp int) []byte {
 			switch nextCh {
 			case 's':
 				res = append(res, []byte(checkForEscapedChars(ReadStr(fp, inp)))...)
+			case 'c':
+				res = append(res, []byte(string(ReadI32(fp, inp)))...)
 			case 'd':
 				switch inp.Type {
 				case TYPE_I8:
@@ -422,6 +422,7 @@ const (
 	OP_STR_LAST_INDEX
 	OP_STR_TRIM_SPACE
 	OP_STR_EQ
+	OP_STR_DECODE_RUNE
 
 	OP_APPEND
 	OP_RESIZE
@@ -477,6 +478,12 @@ func execNative(prgrm *CXProgram) {
 	opcodeHandlers[prgrm.GetOpCode()](prgrm)
 }
 
+// ExecNative executes the native expression pointed by the current call of `prgrm`.
+// It is used by the compiler to evaluate constant expressions.
+func ExecNative(prgrm *CXProgram) {
+	execNative(prgrm)
+}
+
 // RegisterPackage registers a package on the CX standard library. This does not create a `CXPackage` structure,
 // it only tells the CX runtime that `pkgName` will exist by the time a CX program is run.
 func RegisterPackage(pkgName string) {
@@ -563,6 +570,9 @@ func Slice(typCode int) *CXArgument {
 	arg := Param(typCode)
 	arg.IsSlice = true
 	arg.DeclarationSpecifiers = append(arg.DeclarationSpecifiers, DECL_SLICE)
+	// A slice is stored as a pointer to its object on the heap, whatever
+	// the size of its elements.
+	arg.TotalSize = TYPE_POINTER_SIZE
 	return arg
 }
 
@@ -658,11 +668,6 @@ func opDebug(prgrm *CXProgram) {
 }
 
 func init() {
-	httpPkg, err := PROGRAM.GetPackage("http")
-	if err != nil {
-		panic(err)
-	}
-
 	Op(OP_IDENTITY, "identity", opIdentity, In(AUND), Out(AUND))
 	Op(OP_JMP, "jmp", opJmp, In(ABOOL), nil) // AUND to allow 0 inputs (goto)
 	Op(OP_DEBUG, "debug", opDebug, nil, nil)
@@ -1017,7 +1022,7 @@ func init() {
 	Op(OP_F64_NEG, "f64.neg", opF64Neg, In(AF64), Out(AF64))
 	Op(OP_F64_MUL, "f64.mul", opF64Mul, In(AF64, AF64), Out(AF64))
 	Op(OP_F64_DIV, "f64.div", opF64Div, In(AF64, AF64), Out(AF64))
-	Op(OP_F64_MOD, "f32.mod", opF64Mod, In(AF64, AF64), Out(AF64))
+	Op(OP_F64_MOD, "f64.mod", opF64Mod, In(AF64, AF64), Out(AF64))
 	Op(OP_F64_ABS, "f64.abs", opF64Abs, In(AF64), Out(AF64))
 	Op(OP_F64_POW, "f64.pow", opF64Pow, In(AF64, AF64), Out(AF64))
 	Op(OP_F64_GT, "f64.gt", opF64Gt, In(AF64, AF64), Out(ABOOL))
@@ -1055,6 +1060,7 @@ func init() {
 	Op(OP_STR_INDEX, "str.index", opStrIndex, In(ASTR, ASTR), Out(AI32))
 	Op(OP_STR_LAST_INDEX, "str.lastindex", opStrLastIndex, In(ASTR, ASTR), Out(AI32))
 	Op(OP_STR_TRIM_SPACE, "str.trimspace", opStrTrimSpace, In(ASTR), Out(ASTR))
+	Op(OP_STR_DECODE_RUNE, "str.decoderune", opStrDecodeRune, In(ASTR, AI32), Out(AI32, AI32))
 
 	Op(OP_APPEND, "append", opAppend, In(Slice(TYPE_UNDEFINED), Slice(TYPE_UNDEFINED)), Out(Slice(TYPE_UNDEFINED)))
 	Op(OP_RESIZE, "resize", opResize, In(Slice(TYPE_UNDEFINED), AI32), Out(Slice(TYPE_UNDEFINED)))
@@ -1076,20 +1082,6 @@ func init() {
 	Op(OP_AFF_INFORM, "aff.inform", opAffInform, In(Slice(TYPE_AFF), AI32, Slice(TYPE_AFF)), nil)
 	Op(OP_AFF_REQUEST, "aff.request", opAffRequest, In(Slice(TYPE_AFF), AI32, Slice(TYPE_AFF)), nil)
 
-	Op(OP_HTTP_SERVE, "http.Serve", opHTTPServe, In(ASTR), Out(ASTR))
-	Op(OP_HTTP_LISTEN_AND_SERVE, "http.ListenAndServe", opHTTPListenAndServe, In(ASTR), Out(ASTR))
-	Op(OP_HTTP_NEW_REQUEST, "http.NewRequest", opHTTPNewRequest, In(ASTR, ASTR, ASTR), Out(ASTR))
-	Op(OP_HTTP_DO, "http.Do", opHTTPDo, In(AUND), Out(AUND, ASTR))
-	Op(OP_DMSG_DO, "http.DmsgDo", opDMSGDo, In(AUND), Out(ASTR))
-
 	// Op(OP_EVOLVE_EVOLVE, "evolve.evolve", opEvolve, In(Slice(TYPE_AFF), Slice(TYPE_AFF), Slice(TYPE_F64), Slice(TYPE_F64), AI32, AI32, AI32, AF64), nil)
 	// Op(OP_EVOLVE_EVOLVE, "evolve.evolve", opEvolve, In(Slice(TYPE_AFF), Slice(TYPE_AFF), Slice(TYPE_AFF), Slice(TYPE_AFF), Slice(TYPE_AFF), AI32, AI32, AI32, AF64), nil)
-
-	Op(OP_HTTP_HANDLE, "http.Handle", opHTTPHandle,
-		In(
-			ASTR,
-			ParamEx(ParamData{typCode: TYPE_FUNC, pkg: httpPkg, inputs: In(MakeArgument("ResponseWriter", "", -1).AddType(TypeNames[TYPE_STR]), Pointer(Struct("http", "Request", "r")))})),
-		Out())
-
-	Op(OP_HTTP_CLOSE, "http.Close", opHTTPClose, nil, nil)
 }
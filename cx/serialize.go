@@ -1173,8 +1173,9 @@ func dsIntegers(off int32, size int32, s *sAll) []int {
 func initDeserialization(prgrm *CXProgram, s *sAll) {
 	prgrm.Memory = s.Memory
 	prgrm.Packages = make([]*CXPackage, len(s.Packages))
-	prgrm.CallStack = make([]CXCall, CALLSTACK_SIZE)
+	prgrm.CallStack = make([]CXCall, INIT_CALLSTACK_SIZE)
 	prgrm.HeapStartsAt = int(s.Program.HeapStartsAt)
+	prgrm.StackStartsAt = prgrm.HeapStartsAt
 	prgrm.HeapPointer = int(s.Program.HeapPointer)
 	prgrm.StackSize = int(s.Program.StackSize)
 	prgrm.HeapSize = int(s.Program.HeapSize)
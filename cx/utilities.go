@@ -43,11 +43,9 @@ func (prgrm *CXProgram) PrintStack() {
 	fmt.Println("===Callstack===")
 
 	// we're going backwards in the stack
-	fp := prgrm.StackPointer
-
 	for c := prgrm.CallCounter; c >= 0; c-- {
 		op := prgrm.CallStack[c].Operator
-		fp -= op.Size
+		fp := prgrm.CallStack[c].FramePointer
 
 		var dupNames []string
 
@@ -872,7 +870,9 @@ func NewWriteObj(obj []byte) int {
 	heapOffset := AllocateSeq(size + OBJECT_HEADER_SIZE)
 	var finalObj = make([]byte, OBJECT_HEADER_SIZE+size)
 
-	WriteMemI32(finalObj, OBJECT_GC_HEADER_SIZE, int32(size))
+	// The size of the object includes its header, as the garbage collector
+	// uses it to find the next object.
+	WriteMemI32(finalObj, OBJECT_GC_HEADER_SIZE, int32(OBJECT_HEADER_SIZE+size))
 	for c := OBJECT_HEADER_SIZE; c < size+OBJECT_HEADER_SIZE; c++ {
 		finalObj[c] = obj[c-OBJECT_HEADER_SIZE]
 	}
@@ -988,6 +988,9 @@ func getNonCollectionValue(fp int, arg, elt *CXArgument, typ string) string {
 		return fmt.Sprintf("%v", ReadF32(fp, elt))
 	case "f64":
 		return fmt.Sprintf("%v", ReadF64(fp, elt))
+	case "func":
+		// the functions given to the natives aren't stored in the frame
+		return elt.Name
 	default:
 		// then it's a struct
 		var val string
@@ -1171,9 +1174,9 @@ func DebugHeap() {
 
 	// Processing local variables in every active function call in the `CallStack`.
 	// Adding the address they are pointing to.
-	var fp int
 	for c := 0; c <= PROGRAM.CallCounter; c++ {
 		op := PROGRAM.CallStack[c].Operator
+		fp := PROGRAM.CallStack[c].FramePointer
 
 		// TODO: Some standard library functions "manually" add a function
 		// call (callbacks) to `PRGRM.CallStack`. These functions do not have an
@@ -1203,7 +1206,6 @@ func DebugHeap() {
 			symsToAddrs[heapOffset] = append(symsToAddrs[heapOffset], symName)
 		}
 
-		fp += op.Size
 	}
 
 	// Printing all the details.
@@ -1389,6 +1391,22 @@ func IsPointer(sym *CXArgument) bool {
 	return false
 }
 
+// IsStructWithPointers checks if `sym` is a structure instance stored in the stack
+// with fields that behave like pointers (slices, pointers, strings). The garbage
+// collector needs to check these fields even if the CX program doesn't access them.
+func IsStructWithPointers(sym *CXArgument) bool {
+	if sym.CustomType == nil || sym.Name == "" || len(sym.Fields) > 0 ||
+		sym.IsPointer || sym.IsSlice || sym.IsArray || sym.Offset >= PROGRAM.StackSize {
+		return false
+	}
+	for _, fld := range sym.CustomType.Fields {
+		if IsPointer(fld) {
+			return true
+		}
+	}
+	return false
+}
+
 // WriteStringObj writes `str` to the heap as an object and returns its absolute offset.
 func WriteStringObj(str string) int {
 	strB := encoder.Serialize(str)
@@ -1412,3 +1430,189 @@ func ReadStringFromObject(off int32) string {
 	}
 	return str
 }
+
+// WriteStringSlice writes `strs` to the heap as a `[]str` slice and writes its
+// offset to `out`. The slice and its strings are allocated at once, as the
+// garbage collector would free the strings allocated before the slice that
+// references them.
+func WriteStringSlice(fp int, strs []string, out *CXArgument) {
+	if len(strs) == 0 {
+		WriteI32(GetFinalOffset(fp, out), 0)
+		return
+	}
+
+	sliceSize := OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE + len(strs)*TYPE_POINTER_SIZE
+	size := sliceSize
+	strsB := make([][]byte, len(strs))
+	for i, str := range strs {
+		strsB[i] = encoder.Serialize(str)
+		size += OBJECT_HEADER_SIZE + len(strsB[i])
+	}
+	heapOffset := AllocateSeq(size)
+
+	// The slice object is followed by the string objects.
+	obj := make([]byte, size)
+	WriteMemI32(obj, OBJECT_GC_HEADER_SIZE, int32(sliceSize))
+	WriteMemI32(obj, OBJECT_HEADER_SIZE, int32(len(strs)))
+	WriteMemI32(obj, OBJECT_HEADER_SIZE+4, int32(len(strs)))
+	off := sliceSize
+	for i, strB := range strsB {
+		WriteMemI32(obj, OBJECT_HEADER_SIZE+SLICE_HEADER_SIZE+i*TYPE_POINTER_SIZE, int32(heapOffset+off))
+		WriteMemI32(obj, off+OBJECT_GC_HEADER_SIZE, int32(OBJECT_HEADER_SIZE+len(strB)))
+		copy(obj[off+OBJECT_HEADER_SIZE:], strB)
+		off += OBJECT_HEADER_SIZE + len(strB)
+	}
+
+	WriteMemory(heapOffset, obj)
+	WriteI32(GetFinalOffset(fp, out), int32(heapOffset))
+}
+
+// WriteStringObjs writes `strs` to the heap at once and returns their
+// offsets, for the objects that reference more than one string, as the
+// garbage collector would free the strings allocated before the others.
+func WriteStringObjs(strs []string) []int32 {
+	objs := make([][]byte, len(strs))
+	for i, str := range strs {
+		objs[i] = encoder.Serialize(str)
+	}
+	return WriteObjs(objs)
+}
+
+// WriteObjs is like `WriteStringObjs`, for objects of any type.
+func WriteObjs(objs [][]byte) []int32 {
+	size := 0
+	for _, obj := range objs {
+		size += OBJECT_HEADER_SIZE + len(obj)
+	}
+	heapOffset := AllocateSeq(size)
+
+	mem := make([]byte, size)
+	offsets := make([]int32, len(objs))
+	off := 0
+	for i, obj := range objs {
+		offsets[i] = int32(heapOffset + off)
+		WriteMemI32(mem, off+OBJECT_GC_HEADER_SIZE, int32(OBJECT_HEADER_SIZE+len(obj)))
+		copy(mem[off+OBJECT_HEADER_SIZE:], obj)
+		off += OBJECT_HEADER_SIZE + len(obj)
+	}
+
+	WriteMemory(heapOffset, mem)
+	return offsets
+}
+
+// ReadStringSlice reads the `[]str` slice `inp`.
+func ReadStringSlice(fp int, inp *CXArgument) []string {
+	sliceOffset := GetSliceOffset(fp, inp)
+	if sliceOffset < 0 || inp.Type != TYPE_STR {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+
+	data := GetSliceData(sliceOffset, TYPE_POINTER_SIZE)
+	strs := make([]string, len(data)/TYPE_POINTER_SIZE)
+	for i := range strs {
+		if off := mustDeserializeI32(data[i*TYPE_POINTER_SIZE : (i+1)*TYPE_POINTER_SIZE]); off != 0 {
+			strs[i] = ReadStringFromObject(off)
+		}
+	}
+	return strs
+}
+
+// WriteSliceData writes `data`, the serialized elements of `sizeofElement`
+// bytes each, to the heap as a new slice and writes its offset to `out`. The
+// elements must not hold pointers.
+func WriteSliceData(fp int, data []byte, sizeofElement int, out *CXArgument) {
+	if len(data) == 0 {
+		WriteI32(GetFinalOffset(fp, out), 0)
+		return
+	}
+
+	count := len(data) / sizeofElement
+	size := OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE + len(data)
+	heapOffset := AllocateSeq(size)
+
+	obj := make([]byte, size)
+	WriteMemI32(obj, OBJECT_GC_HEADER_SIZE, int32(size))
+	WriteMemI32(obj, OBJECT_HEADER_SIZE, int32(count))
+	WriteMemI32(obj, OBJECT_HEADER_SIZE+4, int32(count))
+	copy(obj[OBJECT_HEADER_SIZE+SLICE_HEADER_SIZE:], data)
+
+	WriteMemory(heapOffset, obj)
+	WriteI32(GetFinalOffset(fp, out), int32(heapOffset))
+}
+
+// WriteStringTable writes `rows` to the heap as a `[][]str` slice and writes
+// its offset to `out`. As in `WriteStringSlice`, the slices and their
+// strings are allocated at once.
+func WriteStringTable(fp int, rows [][]string, out *CXArgument) {
+	if len(rows) == 0 {
+		WriteI32(GetFinalOffset(fp, out), 0)
+		return
+	}
+
+	size := OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE + len(rows)*TYPE_POINTER_SIZE
+	rowsB := make([][][]byte, len(rows))
+	for i, row := range rows {
+		if len(row) > 0 {
+			size += OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE + len(row)*TYPE_POINTER_SIZE
+		}
+		rowsB[i] = make([][]byte, len(row))
+		for j, str := range row {
+			rowsB[i][j] = encoder.Serialize(str)
+			size += OBJECT_HEADER_SIZE + len(rowsB[i][j])
+		}
+	}
+	heapOffset := AllocateSeq(size)
+
+	// writeSlice writes the headers of a slice of `count` pointers at `off`
+	// and returns the offset of the next object.
+	obj := make([]byte, size)
+	writeSlice := func(off, count int) int {
+		sliceSize := OBJECT_HEADER_SIZE + SLICE_HEADER_SIZE + count*TYPE_POINTER_SIZE
+		WriteMemI32(obj, off+OBJECT_GC_HEADER_SIZE, int32(sliceSize))
+		WriteMemI32(obj, off+OBJECT_HEADER_SIZE, int32(count))
+		WriteMemI32(obj, off+OBJECT_HEADER_SIZE+4, int32(count))
+		return off + sliceSize
+	}
+
+	// Each row is followed by its strings. Empty rows are nil.
+	off := writeSlice(0, len(rows))
+	for i, row := range rowsB {
+		if len(row) == 0 {
+			continue
+		}
+		rowOff := off
+		WriteMemI32(obj, OBJECT_HEADER_SIZE+SLICE_HEADER_SIZE+i*TYPE_POINTER_SIZE, int32(heapOffset+rowOff))
+		off = writeSlice(rowOff, len(row))
+		for j, strB := range row {
+			WriteMemI32(obj, rowOff+OBJECT_HEADER_SIZE+SLICE_HEADER_SIZE+j*TYPE_POINTER_SIZE, int32(heapOffset+off))
+			WriteMemI32(obj, off+OBJECT_GC_HEADER_SIZE, int32(OBJECT_HEADER_SIZE+len(strB)))
+			copy(obj[off+OBJECT_HEADER_SIZE:], strB)
+			off += OBJECT_HEADER_SIZE + len(strB)
+		}
+	}
+
+	WriteMemory(heapOffset, obj)
+	WriteI32(GetFinalOffset(fp, out), int32(heapOffset))
+}
+
+// ReadStringTable reads the `[][]str` slice `inp`.
+func ReadStringTable(fp int, inp *CXArgument) [][]string {
+	sliceOffset := GetSliceOffset(fp, inp)
+	if sliceOffset < 0 || inp.Type != TYPE_STR {
+		panic(CX_RUNTIME_INVALID_ARGUMENT)
+	}
+
+	data := GetSliceData(sliceOffset, TYPE_POINTER_SIZE)
+	rows := make([][]string, len(data)/TYPE_POINTER_SIZE)
+	for i := range rows {
+		rowOffset := mustDeserializeI32(data[i*TYPE_POINTER_SIZE : (i+1)*TYPE_POINTER_SIZE])
+		rowData := GetSliceData(rowOffset, TYPE_POINTER_SIZE)
+		rows[i] = make([]string, len(rowData)/TYPE_POINTER_SIZE)
+		for j := range rows[i] {
+			if off := mustDeserializeI32(rowData[j*TYPE_POINTER_SIZE : (j+1)*TYPE_POINTER_SIZE]); off != 0 {
+				rows[i][j] = ReadStringFromObject(off)
+			}
+		}
+	}
+	return rows
+}
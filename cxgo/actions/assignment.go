@@ -209,6 +209,11 @@ func Assignment(to []*CXExpression, assignOp string, from []*CXExpression) []*CX
 		to[0].Outputs[0].DoesEscape = from[idx].Outputs[0].DoesEscape
 		// to[0].Outputs[0].Program = PRGRM
 
+		// In a short declaration `to[0]` only declares the variable, so the
+		// identity's output needs to know too that a string literal is passed
+		// by reference.
+		to[len(to)-1].Outputs[0].PassBy = from[idx].Outputs[0].PassBy
+
 		if from[idx].IsMethodCall {
 			from[idx].Inputs = append(from[idx].Outputs, from[idx].Inputs...)
 		} else {
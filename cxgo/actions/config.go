@@ -9,6 +9,10 @@ import (
 var PRGRM *CXProgram
 var DataOffset int = STACK_SIZE
 
+// OptimizationLevel determines which passes are run by `OptimizeFunction`:
+// 0 disables the optimizer and 1 enables all of its passes.
+var OptimizationLevel int = 1
+
 var CurrentFile string
 var LineNo int
 var ReplTargetFn string = ""
@@ -204,10 +204,12 @@ func DeclareStruct(ident string, strctFlds []*CXArgument) {
 //
 func DeclarePackage(ident string) {
 	// Add a new package to the program if it's not previously defined.
-	if pkg, err := PRGRM.GetPackage(ident); err != nil {
+	pkg, err := PRGRM.GetPackage(ident)
+	if err != nil {
 		pkg = MakePackage(ident)
 		PRGRM.AddPackage(pkg)
 	}
+	pkg.IsSource = true
 
 	PRGRM.SelectPackage(ident)
 }
@@ -55,17 +55,16 @@ func IterationExpressions(init []*CXExpression, cond []*CXExpression, incr []*CX
 	thenLines := 0
 	elseLines := len(incr) + len(statements) + 1
 
-	// processing possible breaks
+	// processing possible breaks and continues. They are unmarked once
+	// resolved, so the loops enclosing this one don't retarget them.
 	for i, stat := range statements {
 		if stat.IsBreak {
 			stat.ThenLines = elseLines - i - 1
+			stat.IsBreak = false
 		}
-	}
-
-	// processing possible continues
-	for i, stat := range statements {
 		if stat.IsContinue {
 			stat.ThenLines = len(statements) - i - 1
+			stat.IsContinue = false
 		}
 	}
 
@@ -84,6 +83,137 @@ func IterationExpressions(init []*CXExpression, cond []*CXExpression, incr []*CX
 	return exprs
 }
 
+// RangeClause returns the expressions of the collection in `k, v := range coll`
+// followed by a marker expression holding the iteration variables. The marker
+// is expanded into a full loop by `RangeExpressions`.
+func RangeClause(to []*CXExpression, coll []*CXExpression) []*CXExpression {
+	pkg, err := PRGRM.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	marker := MakeExpression(nil, CurrentFile, LineNo)
+	marker.Package = pkg
+	marker.IsRange = true
+	marker.Outputs = to[len(to)-1].Outputs
+
+	return append(coll, marker)
+}
+
+// RangeExpressions expands `for k, v := range coll { ... }` into a classic
+// `for` loop over the byte offsets of a string, the indexes of an array or
+// slice, or the values from 0 to an i32 counter.
+//
+// The type of `coll` is not known yet, so the loop is built as if it ranged
+// over a string, decoding a rune on every iteration. `ProcessRangeExpression`
+// adapts it once `coll` is resolved. Like in Go, `coll` and its length are
+// evaluated once, before the first iteration.
+func RangeExpressions(clause []*CXExpression, statements []*CXExpression) []*CXExpression {
+	marker := clause[len(clause)-1]
+	coll := clause[:len(clause)-1]
+
+	if len(marker.Outputs) > 2 {
+		println(CompilationError(marker.FileName, marker.FileLine), "range clause permits at most two iteration variables")
+		return nil
+	}
+
+	// The expressions built here report errors at the line of the clause.
+	lineNo := LineNo
+	LineNo = marker.FileLine
+	defer func() { LineNo = lineNo }()
+
+	key := marker.Outputs[0].Name
+	val := MakeGenSym(RANGE_PREFIX)
+	if len(marker.Outputs) == 2 && marker.Outputs[1].Name != "_" {
+		val = marker.Outputs[1].Name
+	}
+
+	idxSym := MakeGenSym(RANGE_PREFIX)
+	widthSym := MakeGenSym(RANGE_PREFIX)
+
+	// The collection is copied before the loop, so assigning it in the body
+	// doesn't change the iterations. `len` and the copy both read `coll`, so
+	// a call is assigned to a variable first to evaluate it only once.
+	var init []*CXExpression
+	if len(coll) > 1 || coll[0].Operator != nil || len(coll[0].Outputs) != 1 {
+		callSym := MakeGenSym(RANGE_PREFIX)
+		init = Assignment(PrimaryIdentifier(callSym), ":=", coll)
+		coll = PrimaryIdentifier(callSym)
+	}
+	operand := func() []*CXExpression {
+		expr := MakeExpression(nil, coll[0].FileName, coll[0].FileLine)
+		expr.Package = coll[0].Package
+		expr.AddOutput(cloneArgument(coll[0].Outputs[0]))
+		return []*CXExpression{expr}
+	}
+
+	collSym := MakeGenSym(RANGE_PREFIX)
+	lenSym := MakeGenSym(RANGE_PREFIX)
+
+	// n = len(coll)
+	init = append(init, DeclareLocal(MakeArgument(lenSym, CurrentFile, LineNo), DeclarationSpecifiersBasic(TYPE_I32), nil, false)...)
+	length := PostfixExpressionFunCall(PrimaryIdentifier("len"), operand())
+	length[len(length)-1].IsRange = true
+	init = append(init, Assignment(PrimaryIdentifier(lenSym), "=", length)...)
+
+	// c = coll
+	collDecl := DeclareLocal(MakeArgument(collSym, CurrentFile, LineNo), DeclarationSpecifiersBasic(TYPE_I32), nil, false)
+	collDecl[0].IsRange = true
+	init = append(init, collDecl...)
+	init = append(init, Assignment(PrimaryIdentifier(collSym), "=", operand())...)
+
+	init = append(init, Assignment(PrimaryIdentifier(idxSym), ":=", WritePrimary(TYPE_I32, encoder.Serialize(int32(0)), false))...)
+	init = append(init, Assignment(PrimaryIdentifier(widthSym), ":=", WritePrimary(TYPE_I32, encoder.Serialize(int32(1)), false))...)
+
+	cond := ShorthandExpression(PrimaryIdentifier(idxSym), PrimaryIdentifier(lenSym), OP_LT)
+
+	var body []*CXExpression
+	if key != "_" {
+		body = append(body, Assignment(PrimaryIdentifier(key), ":=", PrimaryIdentifier(idxSym))...)
+	}
+
+	decl := DeclareLocal(MakeArgument(val, CurrentFile, LineNo), DeclarationSpecifiersBasic(TYPE_I32), nil, false)
+	decl[0].IsRange = true
+	body = append(body, decl...)
+
+	// v, w = str.decoderune(coll, i)
+	args := append(PrimaryIdentifier(collSym), PrimaryIdentifier(idxSym)...)
+	step := PostfixExpressionFunCall(PostfixExpressionNative(TYPE_STR, "decoderune"), args)
+	step = Assignment(PrimaryIdentifier(widthSym), "=", step)
+	last := step[len(step)-1]
+	last.Outputs = append(PrimaryIdentifier(val)[0].Outputs, last.Outputs...)
+	body = append(body, step...)
+
+	incr := Assignment(PrimaryIdentifier(idxSym), "+=", PrimaryIdentifier(widthSym))
+
+	return IterationExpressions(init, cond, incr, append(body, statements...))
+}
+
+// cloneArgument copies an argument that hasn't been processed yet, so it can
+// be used by more than one expression.
+func cloneArgument(arg *CXArgument) *CXArgument {
+	copyInts := func(ints []int) []int {
+		if ints == nil {
+			return nil
+		}
+		return append([]int{}, ints...)
+	}
+
+	clone := *arg
+	clone.DeclarationSpecifiers = copyInts(arg.DeclarationSpecifiers)
+	clone.DereferenceOperations = copyInts(arg.DereferenceOperations)
+	clone.Lengths = copyInts(arg.Lengths)
+	clone.Indexes = nil
+	for _, idx := range arg.Indexes {
+		clone.Indexes = append(clone.Indexes, cloneArgument(idx))
+	}
+	clone.Fields = nil
+	for _, fld := range arg.Fields {
+		clone.Fields = append(clone.Fields, cloneArgument(fld))
+	}
+	return &clone
+}
+
 func trueJmpExpressions() []*CXExpression {
 	pkg, err := PRGRM.GetCurrentPackage()
 	if err != nil {
@@ -386,10 +516,12 @@ func AssociateReturnExpressions(idx int, retExprs []*CXExpression) []*CXExpressi
 
 	outParam := fn.Outputs[idx]
 
+	// The output is not flagged as previously declared, as it would then be
+	// declared again in the scope of the return statement, e.g. in an `if`,
+	// and the returned value would be written to that new variable.
 	out := MakeArgument(outParam.Name, CurrentFile, LineNo)
 	out.AddType(TypeNames[outParam.Type])
 	out.CustomType = outParam.CustomType
-	out.PreviouslyDeclared = true
 
 	if lastExpr.Operator == nil {
 		lastExpr.Operator = Natives[OP_IDENTITY]
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	. "github.com/skycoin/cx/cx"
 
@@ -116,6 +117,7 @@ func FunctionDeclaration(fn *CXFunction, inputs, outputs []*CXArgument, exprs []
 
 	// getting offset to use by statements (excluding inputs, outputs and receiver)
 	var offset int
+	PRGRM.StackStartsAt = DataOffset
 	PRGRM.HeapStartsAt = DataOffset
 
 	ProcessGoTos(fn, exprs)
@@ -142,6 +144,7 @@ func FunctionDeclaration(fn *CXFunction, inputs, outputs []*CXArgument, exprs []
 
 		ProcessMethodCall(expr, symbols, &offset, true)
 		ProcessExpressionArguments(symbols, &symbolsScope, &offset, fn, expr.Inputs, expr, true)
+		ProcessRangeExpression(fn, i)
 		ProcessExpressionArguments(symbols, &symbolsScope, &offset, fn, expr.Outputs, expr, false)
 
 		ProcessPointerStructs(expr)
@@ -175,6 +178,8 @@ func FunctionDeclaration(fn *CXFunction, inputs, outputs []*CXArgument, exprs []
 	}
 
 	fn.Size = offset
+
+	OptimizeFunction(fn)
 }
 
 func FunctionCall(exprs []*CXExpression, args []*CXExpression) []*CXExpression {
@@ -222,6 +227,7 @@ func FunctionCall(exprs []*CXExpression, args []*CXExpression) []*CXExpression {
 				} else {
 					out = MakeArgument(MakeGenSym(LOCAL_PREFIX), CurrentFile, inpExpr.FileLine).AddType(TypeNames[inpExpr.Operator.Outputs[0].Type])
 					out.DeclarationSpecifiers = inpExpr.Operator.Outputs[0].DeclarationSpecifiers
+					out.IsSlice = inpExpr.Operator.Outputs[0].IsSlice
 
 					out.CustomType = inpExpr.Operator.Outputs[0].CustomType
 
@@ -451,7 +457,7 @@ func AddPointer(fn *CXFunction, sym *CXArgument) {
 	// added to the list.
 	if len(sym.Fields) > 0 {
 		fld := sym.Fields[len(sym.Fields)-1]
-		if IsPointer(fld) && !isPointerAdded(fn, sym) {
+		if IsPointer(fld) && !isPointerAdded(fn, sym) && !isStructAdded(fn, sym) {
 			fn.ListOfPointers = append(fn.ListOfPointers, sym)
 		}
 	}
@@ -468,6 +474,33 @@ func AddPointer(fn *CXFunction, sym *CXArgument) {
 			fn.ListOfPointers = append(fn.ListOfPointers, sym)
 		}
 	}
+	// Structure instance:
+	// Its pointer fields need to be checked even if they're never accessed.
+	// The fields already added are removed, as the garbage collector can't
+	// update the same pointer twice.
+	if IsStructWithPointers(sym) && !isPointerAdded(fn, sym) {
+		ptrs := fn.ListOfPointers[:0]
+		for _, ptr := range fn.ListOfPointers {
+			if ptr.Name != sym.Name || len(ptr.Fields) != 1 {
+				ptrs = append(ptrs, ptr)
+			}
+		}
+		fn.ListOfPointers = append(ptrs, sym)
+	}
+}
+
+// isStructAdded checks if `sym` is the field of a structure instance whose
+// pointer fields were already added to `fn.ListOfPointers`.
+func isStructAdded(fn *CXFunction, sym *CXArgument) bool {
+	if len(sym.Fields) != 1 {
+		return false
+	}
+	for _, ptr := range fn.ListOfPointers {
+		if ptr.Name == sym.Name && len(ptr.Fields) == 0 && IsStructWithPointers(ptr) {
+			return true
+		}
+	}
+	return false
 }
 
 // CheckRedeclared checks if `expr` represents a variable declaration and then checks if an
@@ -670,6 +703,118 @@ func ProcessStringAssignment(expr *CXExpression) {
 	}
 }
 
+// ProcessRangeExpression adapts a loop built by `RangeExpressions` to the type of
+// the collection it ranges over, now that the `len` call at `fn.Expressions[i]`
+// has resolved it. Loops over strings are left as they are.
+func ProcessRangeExpression(fn *CXFunction, i int) {
+	expr := fn.Expressions[i]
+	if !expr.IsRange {
+		return
+	}
+	expr.IsRange = false
+
+	// The copy of the collection and the iteration value are declared by the
+	// next two range expressions, and the value is assigned by the expression
+	// that follows its declaration.
+	var decls []*CXExpression
+	var step *CXExpression
+	for j := i + 1; j < len(fn.Expressions)-1 && len(decls) < 2; j++ {
+		if fn.Expressions[j].IsRange {
+			fn.Expressions[j].IsRange = false
+			decls = append(decls, fn.Expressions[j])
+			step = fn.Expressions[j+1]
+		}
+	}
+
+	coll := GetAssignmentElement(expr.Inputs[0])
+	val := decls[1].Outputs[0]
+	specs := coll.DeclarationSpecifiers
+
+	setRangeCollection(decls[0].Outputs[0], coll)
+
+	switch {
+	case len(specs) > 0 && (specs[len(specs)-1] == DECL_SLICE || specs[len(specs)-1] == DECL_ARRAY):
+		setRangeElement(val, coll)
+
+		// v = coll[i]
+		elt := GetAssignmentElement(step.Inputs[0])
+		elt.IsArray = false
+		elt.DereferenceOperations = append(elt.DereferenceOperations, DEREF_ARRAY)
+		elt.DeclarationSpecifiers = append(elt.DeclarationSpecifiers, DECL_INDEXING)
+		if !elt.IsDereferenceFirst {
+			elt.IsArrayFirst = true
+		}
+		elt.Indexes = append(elt.Indexes, step.Inputs[1])
+
+		step.Operator = Natives[OP_IDENTITY]
+		step.Inputs = step.Inputs[:1]
+		step.Outputs = step.Outputs[:1]
+	case coll.Type == TYPE_STR:
+	case coll.Type == TYPE_I32 && (len(specs) == 0 || specs[len(specs)-1] != DECL_POINTER):
+		if !strings.HasPrefix(val.Name, RANGE_PREFIX) {
+			println(CompilationError(expr.FileName, expr.FileLine), "range over an i32 permits only one iteration variable")
+		}
+
+		// The loop counts up to the value itself, and v = i
+		expr.Operator = Natives[OP_IDENTITY]
+		step.Operator = Natives[OP_IDENTITY]
+		step.Inputs = step.Inputs[1:]
+		step.Outputs = step.Outputs[:1]
+	default:
+		println(CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("cannot range over a value of type '%s'", GetFormattedType(coll)))
+	}
+}
+
+// setRangeElement gives `val` the type of the elements of the array or slice `coll`.
+func setRangeElement(val *CXArgument, coll *CXArgument) {
+	var lengths []int
+	if n := len(coll.Indexes) + 1; n < len(coll.Lengths) {
+		lengths = append([]int{}, coll.Lengths[n:]...)
+	}
+	setRangeType(val, coll, coll.DeclarationSpecifiers[:len(coll.DeclarationSpecifiers)-1], lengths)
+}
+
+// setRangeCollection gives `sym`, the copy of the collection of a range loop,
+// the type of the collection `coll`.
+func setRangeCollection(sym *CXArgument, coll *CXArgument) {
+	var lengths []int
+	if n := len(coll.Indexes); n < len(coll.Lengths) {
+		lengths = append([]int{}, coll.Lengths[n:]...)
+	}
+	setRangeType(sym, coll, coll.DeclarationSpecifiers, lengths)
+}
+
+// setRangeType gives `arg` the type of `coll` with the declaration specifiers
+// `specs` and the array lengths `lengths`.
+func setRangeType(arg *CXArgument, coll *CXArgument, specs []int, lengths []int) {
+	arg.Type = coll.Type
+	arg.CustomType = coll.CustomType
+	arg.IsStruct = coll.IsStruct
+	arg.Size = coll.Size
+	arg.TotalSize = coll.Size
+	arg.DeclarationSpecifiers = append([]int{}, specs...)
+	arg.Lengths = lengths
+
+	if len(specs) == 0 {
+		return
+	}
+
+	switch specs[len(specs)-1] {
+	case DECL_POINTER:
+		arg.IsPointer = true
+		arg.IndirectionLevels = coll.IndirectionLevels
+	case DECL_SLICE:
+		arg.IsSlice = true
+		arg.IsArray = true
+		arg.IsReference = true
+		arg.PassBy = PASSBY_REFERENCE
+		arg.TotalSize = TYPE_POINTER_SIZE
+	case DECL_ARRAY:
+		arg.IsArray = true
+		arg.TotalSize = arg.Size * TotalLength(lengths)
+	}
+}
+
 // ProcessReferenceAssignment checks if the reference of a symbol can be assigned to the expression's output.
 // For example: `var foo i32; var bar i32; bar = &foo` is not valid.
 func ProcessReferenceAssignment(expr *CXExpression) {
@@ -950,7 +1095,9 @@ func CopyArgFields(sym *CXArgument, arg *CXArgument) {
 	sym.IsPointer = arg.IsPointer
 	sym.IndirectionLevels = arg.IndirectionLevels
 
-	if sym.FileLine != arg.FileLine {
+	// Unless `sym` is the declaration itself, it's a use of the symbol, which
+	// can be on the same line as the declaration, as in loops built by the parser.
+	if sym != arg {
 		// FIXME Maybe we can unify this later.
 		if len(sym.Fields) > 0 {
 			elt := GetAssignmentElement(sym)
@@ -1000,7 +1147,7 @@ func CopyArgFields(sym *CXArgument, arg *CXArgument) {
 						println(CompilationError(sym.FileName, sym.FileLine), fmt.Sprintf("invalid indirection"))
 					}
 				case DECL_POINTER:
-					if sym.FileLine != arg.FileLine {
+					if sym != arg {
 						// This function is also called so it assigns offset and other fields to signature parameters
 						//
 						declSpec = append(declSpec, DECL_POINTER)
@@ -0,0 +1,247 @@
+package actions
+
+import (
+	. "github.com/skycoin/cx/cx"
+)
+
+// The inliner replaces the calls to small leaf functions by a copy of their
+// body. It runs once every function of the program has been declared, as a
+// function can be called before being declared:
+//
+//   r = sq(x)   =>   *tmp_1 = identity(x)      // copy of the parameter
+//                    *tmp_2 = mul(*tmp_1, *tmp_1)
+//                    r = identity(*tmp_2)      // copy of the output
+//
+// The variables of the inlined function are renamed to temporaries, stored
+// in a new region at the end of the caller's frame, so the optimization
+// passes can then forward the copies and release their stack slots.
+
+// maxInlineExpressions is the maximum number of expressions of a function
+// that can be inlined.
+const maxInlineExpressions = 8
+
+// InlineFunctions inlines the calls to small leaf functions in every function of `PRGRM`.
+func InlineFunctions() {
+	if OptimizationLevel < 1 || FoundCompileErrors {
+		return
+	}
+
+	for _, pkg := range PRGRM.Packages {
+		for _, fn := range pkg.Functions {
+			if inlineCalls(fn) {
+				OptimizeFunction(fn)
+			}
+		}
+	}
+}
+
+// canInline checks if `fn` is a leaf function whose body can be copied in
+// its callers, i.e. a small function that only runs pure operators on
+// values of basic types and that doesn't have pointers to check by the
+// garbage collector.
+func canInline(fn *CXFunction) bool {
+	if fn.IsNative || len(fn.Expressions) == 0 || len(fn.Expressions) > maxInlineExpressions ||
+		len(fn.ListOfPointers) > 0 {
+		return false
+	}
+
+	for _, params := range [][]*CXArgument{fn.Inputs, fn.Outputs} {
+		for _, param := range params {
+			if !isDirectArg(param) || !isBasicType(param) {
+				return false
+			}
+		}
+	}
+
+	for _, expr := range fn.Expressions {
+		if expr.Operator != nil && (!expr.Operator.IsNative ||
+			expr.Operator != Natives[OP_IDENTITY] && !foldableOps[expr.Operator.OpCode]) {
+			return false
+		}
+
+		inlinable := true
+		forEachArgument(expr, func(arg *CXArgument) {
+			if !isDirectArg(arg) || !isBasicType(arg) {
+				inlinable = false
+			}
+		})
+		if !inlinable {
+			return false
+		}
+	}
+
+	return true
+}
+
+// inlineCalls replaces the calls of `fn` to functions that can be inlined
+// by a copy of their body. It returns true if any call was inlined.
+func inlineCalls(fn *CXFunction) bool {
+	inlined := make(map[int][]*CXExpression)
+	for i, expr := range fn.Expressions {
+		callee := expr.Operator
+		if callee == nil || callee == fn || len(expr.Inputs) != len(callee.Inputs) ||
+			len(expr.Outputs) > len(callee.Outputs) || !canInline(callee) {
+			continue
+		}
+
+		passByValue := true
+		for _, inp := range expr.Inputs {
+			if inp.PassBy != PASSBY_VALUE {
+				passByValue = false
+			}
+		}
+		if passByValue {
+			inlined[i] = inlineCall(fn, expr)
+		}
+	}
+
+	if len(inlined) == 0 {
+		return false
+	}
+
+	insertExpressions(fn, inlined)
+	return true
+}
+
+// inlineCall returns the expressions that replace the call `expr` in `fn`,
+// and allocates the variables of the called function in the frame of `fn`.
+func inlineCall(fn *CXFunction, expr *CXExpression) []*CXExpression {
+	callee := expr.Operator
+	base := fn.Size
+	fn.Size += callee.Size
+
+	names := make(map[string]string)
+	clone := func(arg *CXArgument) *CXArgument {
+		if arg.Name == "" || arg.Offset >= PRGRM.StackSize {
+			// literals are shared with the called function
+			return arg
+		}
+		if _, ok := names[arg.Name]; !ok {
+			names[arg.Name] = MakeGenSym(LOCAL_PREFIX)
+		}
+		cpy := *arg
+		cpy.Name = names[arg.Name]
+		cpy.Offset += base
+		return &cpy
+	}
+
+	makeExpr := func(op *CXFunction, from *CXExpression) *CXExpression {
+		e := MakeExpression(op, from.FileName, from.FileLine)
+		e.Package = from.Package
+		e.Function = fn
+		return e
+	}
+
+	var exprs []*CXExpression
+
+	// copying the inputs to the parameters
+	for i, param := range callee.Inputs {
+		e := makeExpr(Natives[OP_IDENTITY], expr)
+		e.Inputs = []*CXArgument{expr.Inputs[i]}
+		e.Outputs = []*CXArgument{clone(param)}
+		exprs = append(exprs, e)
+	}
+
+	// the outputs start as zero values unless the body writes them first
+	for _, param := range callee.Outputs {
+		if !isWrittenFirst(callee, param.Name) {
+			e := makeExpr(nil, expr)
+			e.Outputs = []*CXArgument{clone(param)}
+			exprs = append(exprs, e)
+		}
+	}
+
+	for _, bodyExpr := range callee.Expressions {
+		e := makeExpr(bodyExpr.Operator, bodyExpr)
+		for _, inp := range bodyExpr.Inputs {
+			e.Inputs = append(e.Inputs, clone(inp))
+		}
+		for _, out := range bodyExpr.Outputs {
+			e.Outputs = append(e.Outputs, clone(out))
+		}
+		exprs = append(exprs, e)
+	}
+
+	// copying the outputs to the receiving variables
+	for i, out := range expr.Outputs {
+		e := makeExpr(Natives[OP_IDENTITY], expr)
+		e.Inputs = []*CXArgument{clone(callee.Outputs[i])}
+		e.Outputs = []*CXArgument{out}
+		exprs = append(exprs, e)
+	}
+
+	return exprs
+}
+
+// isWrittenFirst checks if the variable `name` of `fn` is written by an
+// expression before being read. `fn` mustn't have jumps.
+func isWrittenFirst(fn *CXFunction, name string) bool {
+	for _, expr := range fn.Expressions {
+		if expr.Operator == nil {
+			continue
+		}
+		for _, inp := range expr.Inputs {
+			if inp.Name == name {
+				return false
+			}
+		}
+		for _, out := range expr.Outputs {
+			if out.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// insertExpressions replaces the expressions of `fn` whose indexes are in
+// `inserted` by their corresponding expressions. Jumps are updated so they
+// keep pointing to the same expressions; a jump to a replaced expression
+// now points to the first expression that replaces it.
+func insertExpressions(fn *CXFunction, inserted map[int][]*CXExpression) {
+	n := len(fn.Expressions)
+
+	// newIdx[i] is the index that the ith expression (or the first expression
+	// replacing it) will have after the insertion.
+	newIdx := make([]int, n+1)
+	count := 0
+	for i := 0; i < n; i++ {
+		newIdx[i] = count
+		if exprs, ok := inserted[i]; ok {
+			count += len(exprs)
+		} else {
+			count++
+		}
+	}
+	newIdx[n] = count
+
+	relocate := func(i, lines int) int {
+		if lines == MAX_INT32 {
+			return lines
+		}
+		t := i + lines + 1
+		if t > n {
+			t = n
+		}
+		if t < 0 {
+			t = 0
+		}
+		return newIdx[t] - newIdx[i] - 1
+	}
+
+	exprs := make([]*CXExpression, 0, count)
+	for i, expr := range fn.Expressions {
+		if repl, ok := inserted[i]; ok {
+			exprs = append(exprs, repl...)
+			continue
+		}
+		if isJmp(expr) {
+			expr.ThenLines = relocate(i, expr.ThenLines)
+			expr.ElseLines = relocate(i, expr.ElseLines)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	fn.Expressions = exprs
+	fn.Length = len(exprs)
+}
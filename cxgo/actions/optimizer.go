@@ -0,0 +1,590 @@
+package actions
+
+import (
+	"sort"
+
+	. "github.com/skycoin/cx/cx"
+)
+
+// The optimizer runs a pipeline of passes over a function once
+// `FunctionDeclaration` has processed its expressions, i.e. once every
+// argument has its final type, size and offset:
+//
+//   1. constant folding: pure operations whose inputs are all literals are
+//      evaluated at compile time and replaced by an identity of the result;
+//   2. copy forwarding: temporaries (`*tmp_N`) that only carry a value from
+//      one expression to the next one are removed, and the producer or the
+//      consumer of the value uses the final argument directly;
+//   3. dead code elimination: expressions that can't be reached, jumps to the
+//      next expression and pure expressions whose temporaries are never read
+//      are removed. Jumps are fixed up to keep pointing to the same code;
+//   4. frame compaction: the stack slots of the removed temporaries are
+//      released and `CXFunction.Size` shrinks accordingly.
+//
+// Passes 1 to 3 are run until none of them modifies the function.
+
+// foldableOps are the operators that don't have side effects and that only
+// read their inputs, so they can be evaluated at compile time.
+var foldableOps = map[int]bool{}
+
+func init() {
+	for _, code := range []int{
+		OP_UND_EQUAL, OP_UND_UNEQUAL, OP_UND_BITAND, OP_UND_BITXOR, OP_UND_BITOR,
+		OP_UND_BITCLEAR, OP_UND_MUL, OP_UND_DIV, OP_UND_MOD, OP_UND_ADD, OP_UND_SUB,
+		OP_UND_NEG, OP_UND_BITSHL, OP_UND_BITSHR, OP_UND_LT, OP_UND_GT, OP_UND_LTEQ,
+		OP_UND_GTEQ, OP_BOOL_EQUAL, OP_BOOL_UNEQUAL, OP_BOOL_NOT, OP_BOOL_OR, OP_BOOL_AND,
+	} {
+		foldableOps[code] = true
+	}
+
+	numTypes := []string{"i8", "i16", "i32", "i64", "ui8", "ui16", "ui32", "ui64", "f32", "f64"}
+	numOps := []string{
+		"add", "sub", "neg", "mul", "div", "mod", "abs", "max", "min",
+		"gt", "gteq", "lt", "lteq", "eq", "uneq",
+		"bitand", "bitor", "bitxor", "bitclear", "bitshl", "bitshr",
+	}
+	for _, typ := range numTypes {
+		// arithmetic and comparison
+		for _, op := range numOps {
+			if code, ok := OpCodes[typ+"."+op]; ok {
+				foldableOps[code] = true
+			}
+		}
+		// casts
+		for _, to := range numTypes {
+			if code, ok := OpCodes[typ+"."+to]; ok {
+				foldableOps[code] = true
+			}
+		}
+	}
+}
+
+// OptimizeFunction runs the optimization passes enabled by `OptimizationLevel` on `fn`.
+func OptimizeFunction(fn *CXFunction) {
+	if OptimizationLevel < 1 || FoundCompileErrors {
+		return
+	}
+
+	slots := getFrameSlots(fn)
+
+	for changed := true; changed; {
+		changed = foldConstants(fn)
+		changed = forwardTemporaries(fn) || changed
+		changed = removeDeadCode(fn) || changed
+	}
+
+	compactFrame(fn, slots)
+
+	// constant folding could have added literals to the data segment
+	PRGRM.StackStartsAt = DataOffset
+	PRGRM.HeapStartsAt = DataOffset
+}
+
+// isJmp checks if `expr` is a jump, i.e. a control flow expression.
+func isJmp(expr *CXExpression) bool {
+	return expr.Operator == Natives[OP_JMP]
+}
+
+// isBasicType checks if `arg` holds a value of a basic type that is not a
+// string, i.e. a value that is fully contained in its memory location.
+func isBasicType(arg *CXArgument) bool {
+	switch arg.Type {
+	case TYPE_BOOL, TYPE_I8, TYPE_I16, TYPE_I32, TYPE_I64,
+		TYPE_UI8, TYPE_UI16, TYPE_UI32, TYPE_UI64, TYPE_F32, TYPE_F64:
+	default:
+		return false
+	}
+	return arg.CustomType == nil && !arg.IsPointer && !arg.IsSlice && !arg.IsArray && len(arg.Lengths) == 0
+}
+
+// isDirectArg checks if the final offset of `arg` doesn't depend on
+// dereferences, indexes or fields, and if it's passed by value.
+func isDirectArg(arg *CXArgument) bool {
+	return len(arg.DereferenceOperations) == 0 && len(arg.Indexes) == 0 && len(arg.Fields) == 0 &&
+		arg.PassBy == PASSBY_VALUE && !arg.DoesEscape && !arg.IsInnerReference
+}
+
+// isLiteralArg checks if `arg` is a literal of a basic type stored in the data segment.
+func isLiteralArg(arg *CXArgument) bool {
+	return arg.Name == "" && arg.Offset >= PRGRM.StackSize && isDirectArg(arg) && isBasicType(arg)
+}
+
+// getWrittenLiterals returns the offsets of the literals that are used as
+// outputs in `fn`, e.g. by a declaration, so their value can change at run time.
+func getWrittenLiterals(fn *CXFunction) map[int]bool {
+	written := make(map[int]bool)
+	for _, expr := range fn.Expressions {
+		for _, out := range expr.Outputs {
+			if out.Name == "" {
+				written[out.Offset] = true
+			}
+		}
+	}
+	return written
+}
+
+// isConstantArg checks if `arg` is a literal whose value doesn't change at run time.
+func isConstantArg(arg *CXArgument, written map[int]bool) bool {
+	return isLiteralArg(arg) && !written[arg.Offset]
+}
+
+// isSameValueType checks if `a` and `b` can be used interchangeably to hold the same value.
+func isSameValueType(a, b *CXArgument) bool {
+	return a.Type == b.Type && GetSize(a) == GetSize(b) && isBasicType(a) && isBasicType(b)
+}
+
+// forEachArgument calls `f` with every argument used by `expr`, including
+// the arguments used as indexes.
+func forEachArgument(expr *CXExpression, f func(arg *CXArgument)) {
+	var visit func(arg *CXArgument)
+	visit = func(arg *CXArgument) {
+		f(arg)
+		for _, idx := range arg.Indexes {
+			visit(idx)
+		}
+		for _, fld := range arg.Fields {
+			for _, idx := range fld.Indexes {
+				visit(idx)
+			}
+		}
+	}
+
+	for _, inp := range expr.Inputs {
+		visit(inp)
+	}
+	for _, out := range expr.Outputs {
+		visit(out)
+	}
+}
+
+// countTempReferences returns how many times each temporary variable is used in `fn`.
+func countTempReferences(fn *CXFunction) map[string]int {
+	refs := make(map[string]int)
+	for _, expr := range fn.Expressions {
+		forEachArgument(expr, func(arg *CXArgument) {
+			if IsTempVar(arg.Name) {
+				refs[arg.Name]++
+			}
+		})
+	}
+	return refs
+}
+
+// getJmpTargets returns the indexes of the expressions that are the target of a jump in `fn`.
+func getJmpTargets(fn *CXFunction) map[int]bool {
+	written := getWrittenLiterals(fn)
+	targets := make(map[int]bool)
+	for i, expr := range fn.Expressions {
+		if !isJmp(expr) {
+			continue
+		}
+		for _, t := range getSuccessors(fn, i, written) {
+			if t != i+1 {
+				targets[t] = true
+			}
+		}
+	}
+	return targets
+}
+
+// getSuccessors returns the indexes of the expressions that can be executed
+// after the `i`th expression of `fn`. An index equal to the number of
+// expressions represents the end of the function. The predicate of a jump is
+// only evaluated if it's a literal that isn't in `written`.
+func getSuccessors(fn *CXFunction, i int, written map[int]bool) []int {
+	expr := fn.Expressions[i]
+	n := len(fn.Expressions)
+
+	clamp := func(t int) int {
+		if t > n {
+			return n
+		}
+		if t < 0 {
+			return 0
+		}
+		return t
+	}
+
+	if !isJmp(expr) {
+		return []int{i + 1}
+	}
+
+	thenTarget := clamp(i + expr.ThenLines + 1)
+	elseTarget := clamp(i + expr.ElseLines + 1)
+
+	if expr.Label != "" {
+		// then it's a goto or a return
+		return []int{thenTarget}
+	}
+
+	if pred := expr.Inputs[0]; isConstantArg(pred, written) && pred.Type == TYPE_BOOL {
+		if PRGRM.Memory[pred.Offset] != 0 {
+			return []int{thenTarget}
+		}
+		return []int{elseTarget}
+	}
+
+	return []int{thenTarget, elseTarget}
+}
+
+// removeExpressions removes from `fn` the expressions flagged in `removed`.
+// Jumps are updated so they keep pointing to the same expressions; a jump to
+// a removed expression now points to the first expression that follows it.
+func removeExpressions(fn *CXFunction, removed []bool) {
+	n := len(fn.Expressions)
+
+	// newIdx[i] is the index that the ith expression (or the one following it, if
+	// it's removed) will have after the removal.
+	newIdx := make([]int, n+1)
+	count := 0
+	for i := 0; i < n; i++ {
+		newIdx[i] = count
+		if !removed[i] {
+			count++
+		}
+	}
+	newIdx[n] = count
+
+	relocate := func(i, lines int) int {
+		if lines == MAX_INT32 {
+			return lines
+		}
+		t := i + lines + 1
+		if t > n {
+			t = n
+		}
+		if t < 0 {
+			t = 0
+		}
+		return newIdx[t] - newIdx[i] - 1
+	}
+
+	exprs := make([]*CXExpression, 0, count)
+	for i, expr := range fn.Expressions {
+		if removed[i] {
+			continue
+		}
+		if isJmp(expr) {
+			expr.ThenLines = relocate(i, expr.ThenLines)
+			expr.ElseLines = relocate(i, expr.ElseLines)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	fn.Expressions = exprs
+	fn.Length = len(exprs)
+}
+
+// foldConstants evaluates the pure expressions of `fn` that only have
+// literal inputs, and replaces them by an identity of the result.
+func foldConstants(fn *CXFunction) (changed bool) {
+	written := getWrittenLiterals(fn)
+	for _, expr := range fn.Expressions {
+		if expr.Operator == nil || !expr.Operator.IsNative || !foldableOps[expr.Operator.OpCode] {
+			continue
+		}
+		if len(expr.Inputs) == 0 || len(expr.Outputs) != 1 || !isBasicType(expr.Outputs[0]) {
+			continue
+		}
+
+		allLiterals := true
+		for _, inp := range expr.Inputs {
+			if !isConstantArg(inp, written) {
+				allLiterals = false
+				break
+			}
+		}
+		if !allLiterals {
+			continue
+		}
+
+		if lit := evalConstExpression(expr); lit != nil {
+			expr.Operator = Natives[OP_IDENTITY]
+			expr.Inputs = []*CXArgument{lit}
+			changed = true
+		}
+	}
+	return changed
+}
+
+// evalConstExpression runs the handler of `expr` on its literal inputs and
+// returns a new literal holding the result. It returns nil if the operation
+// fails, e.g. in a division by zero, so the error is raised at run time.
+//
+// The handler writes the result at the bottom of the stack, whose bytes are
+// restored afterwards, so the data segment only grows if the folding works.
+func evalConstExpression(expr *CXExpression) (lit *CXArgument) {
+	out := expr.Outputs[0]
+	size := GetArgSize(out.Type)
+
+	res := MakeArgument("", expr.FileName, expr.FileLine)
+	res.AddType(TypeNames[out.Type])
+	res.Size = size
+	res.TotalSize = size
+	res.Offset = 0
+
+	evalExpr := MakeExpression(expr.Operator, expr.FileName, expr.FileLine)
+	evalExpr.Package = expr.Package
+	evalExpr.Inputs = expr.Inputs
+	evalExpr.Outputs = []*CXArgument{res}
+
+	evalFn := MakeFunction("", expr.FileName, expr.FileLine)
+	evalFn.Expressions = []*CXExpression{evalExpr}
+	evalFn.Length = 1
+
+	stackBytes := make([]byte, size)
+	copy(stackBytes, PRGRM.Memory[:size])
+
+	// the handlers read the expression being executed from the call stack
+	prevPrgrm, _ := GetProgram()
+	prevCall := PRGRM.CallStack[PRGRM.CallCounter]
+	PRGRM.SelectProgram()
+	PRGRM.CallStack[PRGRM.CallCounter] = MakeCall(evalFn)
+	if !execConstExpression() {
+		PRGRM.CallStack[PRGRM.CallCounter] = prevCall
+		prevPrgrm.SelectProgram()
+		copy(PRGRM.Memory[:size], stackBytes)
+		return nil
+	}
+	PRGRM.CallStack[PRGRM.CallCounter] = prevCall
+	prevPrgrm.SelectProgram()
+
+	result := make([]byte, size)
+	copy(result, PRGRM.Memory[:size])
+	copy(PRGRM.Memory[:size], stackBytes)
+
+	return WritePrimary(out.Type, result, false)[0].Outputs[0]
+}
+
+// execConstExpression runs the expression of the current call, and returns
+// false if its handler panicked.
+func execConstExpression() (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	ExecNative(PRGRM)
+	return true
+}
+
+// forwardTemporaries removes the temporaries that carry a value between
+// two consecutive expressions:
+//
+//   *tmp = op(...)  ;  y = identity(*tmp)   =>   y = op(...)
+//   *tmp = identity(x)  ;  op(..., *tmp, ...)   =>   op(..., x, ...)
+func forwardTemporaries(fn *CXFunction) (changed bool) {
+	refs := countTempReferences(fn)
+	targets := getJmpTargets(fn)
+	removed := make([]bool, len(fn.Expressions))
+
+	for i := 0; i+1 < len(fn.Expressions); i++ {
+		expr, next := fn.Expressions[i], fn.Expressions[i+1]
+		if removed[i] || expr.Operator == nil || isJmp(expr) || len(expr.Outputs) != 1 {
+			continue
+		}
+		tmp := expr.Outputs[0]
+		if !IsTempVar(tmp.Name) || refs[tmp.Name] != 2 || !isDirectArg(tmp) {
+			continue
+		}
+
+		// the producer writes directly to the identity's output
+		if next.Operator == Natives[OP_IDENTITY] && !targets[i+1] &&
+			len(next.Inputs) == 1 && len(next.Outputs) == 1 &&
+			next.Inputs[0].Name == tmp.Name && isDirectArg(next.Inputs[0]) &&
+			isSameValueType(tmp, next.Outputs[0]) && canForwardOutput(expr, next.Outputs[0]) {
+			expr.Outputs[0] = next.Outputs[0]
+			removed[i+1] = true
+			changed = true
+			i++
+			continue
+		}
+
+		// the consumer reads directly the identity's input
+		if expr.Operator == Natives[OP_IDENTITY] && len(expr.Inputs) == 1 &&
+			isDirectArg(expr.Inputs[0]) && isSameValueType(tmp, expr.Inputs[0]) && next.Operator != nil {
+			for j, inp := range next.Inputs {
+				if inp.Name == tmp.Name && isDirectArg(inp) && isSameValueType(tmp, inp) {
+					next.Inputs[j] = expr.Inputs[0]
+					removed[i] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	if changed {
+		removeExpressions(fn, removed)
+	}
+	return changed
+}
+
+// canForwardOutput checks if `expr` can write its result directly to `out`.
+// Only calls and pure operators are considered, as they read all of their
+// inputs before writing their outputs.
+func canForwardOutput(expr *CXExpression, out *CXArgument) bool {
+	if out.Name == "" || out.DoesEscape || out.PassBy != PASSBY_VALUE {
+		return false
+	}
+	if len(out.Fields) > 0 {
+		elt := out.Fields[len(out.Fields)-1]
+		if elt.DoesEscape || elt.PassBy != PASSBY_VALUE {
+			return false
+		}
+	}
+	if expr.Operator.IsNative && !foldableOps[expr.Operator.OpCode] {
+		return false
+	}
+	return true
+}
+
+// removeDeadCode removes the unreachable expressions of `fn`, the jumps to
+// the next expression and the pure expressions whose outputs are temporaries
+// that are never read.
+func removeDeadCode(fn *CXFunction) (changed bool) {
+	n := len(fn.Expressions)
+	if n == 0 {
+		return false
+	}
+
+	written := getWrittenLiterals(fn)
+	reachable := make([]bool, n)
+	stack := []int{0}
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if i >= n || reachable[i] {
+			continue
+		}
+		reachable[i] = true
+		stack = append(stack, getSuccessors(fn, i, written)...)
+	}
+
+	refs := countTempReferences(fn)
+	removed := make([]bool, n)
+	for i, expr := range fn.Expressions {
+		switch {
+		case !reachable[i]:
+			removed[i] = true
+		case isJmp(expr):
+			succs := getSuccessors(fn, i, written)
+			removed[i] = len(succs) == 1 && succs[0] == i+1 ||
+				len(succs) == 2 && succs[0] == i+1 && succs[1] == i+1
+		case expr.Operator != nil && expr.Operator.IsNative &&
+			(expr.Operator == Natives[OP_IDENTITY] || foldableOps[expr.Operator.OpCode]) && len(expr.Outputs) > 0:
+			unused := true
+			for _, out := range expr.Outputs {
+				if !IsTempVar(out.Name) || refs[out.Name] != 1 || !isDirectArg(out) {
+					unused = false
+				}
+			}
+			removed[i] = unused
+		}
+		changed = changed || removed[i]
+	}
+
+	if changed {
+		removeExpressions(fn, removed)
+	}
+	return changed
+}
+
+// frameSlot is a region of a function's stack frame that was given to a
+// symbol by `UpdateSymbolsTable`.
+type frameSlot struct {
+	offset int
+	size   int
+	isTemp bool // only temporaries refer to this slot
+}
+
+// forEachFrameArgument calls `f` with every argument of `fn` that refers to
+// its stack frame, including the inputs, outputs and the pointers checked by
+// the garbage collector.
+func forEachFrameArgument(fn *CXFunction, f func(arg *CXArgument)) {
+	visit := func(arg *CXArgument) {
+		if arg.Name != "" && arg.Offset >= 0 && arg.Offset < PRGRM.StackSize {
+			f(arg)
+		}
+	}
+	for _, param := range fn.Inputs {
+		visit(param)
+	}
+	for _, param := range fn.Outputs {
+		visit(param)
+	}
+	for _, ptr := range fn.ListOfPointers {
+		visit(ptr)
+	}
+	for _, expr := range fn.Expressions {
+		forEachArgument(expr, visit)
+	}
+}
+
+// getFrameSlots returns the slots of the stack frame of `fn`, sorted by offset.
+func getFrameSlots(fn *CXFunction) []frameSlot {
+	isTemp := make(map[int]bool)
+	forEachFrameArgument(fn, func(arg *CXArgument) {
+		if prev, found := isTemp[arg.Offset]; found {
+			isTemp[arg.Offset] = prev && IsTempVar(arg.Name)
+		} else {
+			isTemp[arg.Offset] = IsTempVar(arg.Name)
+		}
+	})
+
+	slots := make([]frameSlot, 0, len(isTemp))
+	for off, tmp := range isTemp {
+		slots = append(slots, frameSlot{offset: off, isTemp: tmp})
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i].offset < slots[j].offset })
+
+	for i := range slots {
+		if i+1 < len(slots) {
+			slots[i].size = slots[i+1].offset - slots[i].offset
+		} else {
+			slots[i].size = fn.Size - slots[i].offset
+		}
+	}
+
+	return slots
+}
+
+// compactFrame releases the slots of the temporaries that were removed from
+// `fn` and updates the offsets of the remaining arguments and `fn.Size`.
+func compactFrame(fn *CXFunction, slots []frameSlot) {
+	used := make(map[int]bool)
+	forEachFrameArgument(fn, func(arg *CXArgument) {
+		used[arg.Offset] = true
+	})
+
+	var freed []frameSlot
+	for _, slot := range slots {
+		if slot.isTemp && !used[slot.offset] && slot.size > 0 {
+			freed = append(freed, slot)
+		}
+	}
+	if len(freed) == 0 {
+		return
+	}
+
+	// shift returns how many bytes were released before `offset`
+	shift := func(offset int) (total int) {
+		for _, slot := range freed {
+			if slot.offset < offset {
+				total += slot.size
+			}
+		}
+		return total
+	}
+
+	moved := make(map[*CXArgument]bool)
+	forEachFrameArgument(fn, func(arg *CXArgument) {
+		if moved[arg] {
+			return
+		}
+		moved[arg] = true
+		arg.Offset -= shift(arg.Offset)
+	})
+
+	fn.Size -= shift(fn.Size)
+}
@@ -273,7 +273,9 @@ func PostfixExpressionField(prevExprs []*CXExpression, ident string) []*CXExpres
 		// the external property will be propagated to the following arguments
 		// this way we avoid considering these arguments as module names
 
-		if IsCorePackage(left.Name) {
+		// A package of the program with the same name as a core package
+		// shadows the core constants and natives it declares again.
+		if IsCorePackage(left.Name) && !isDeclaredBySource(imp, ident) {
 			if code, ok := ConstCodes[left.Name+"."+ident]; ok {
 				constant := Constants[code]
 				val := WritePrimary(constant.Type, constant.Value, false)
@@ -331,3 +333,16 @@ func PostfixExpressionField(prevExprs []*CXExpression, ident string) []*CXExpres
 
 	return prevExprs
 }
+
+// isDeclaredBySource checks if `ident` is a global or a function declared by
+// the CX source code of `imp`.
+func isDeclaredBySource(imp *CXPackage, ident string) bool {
+	if !imp.IsSource {
+		return false
+	}
+	if _, err := imp.GetGlobal(ident); err == nil {
+		return true
+	}
+	_, err := imp.GetFunction(ident)
+	return err == nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+
+	cxcore "github.com/skycoin/cx/cx"
+	"github.com/skycoin/cx/cxgo/actions"
+	"github.com/skycoin/cx/cxgo/gotarget"
+)
+
+// buildProgram writes the program in `actions.PRGRM`, compiled from the
+// files `fileNames`, as a package of the kind chosen with --target.
+func buildProgram(options cxCmdFlags, fileNames []string) {
+	// "bundle" is the former name of the "go" target
+	if options.buildTarget != "go" && options.buildTarget != "bundle" {
+		fmt.Fprintf(os.Stderr, "cx build: unknown target '%s'\n", options.buildTarget)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+	if len(fileNames) == 0 {
+		fmt.Fprintln(os.Stderr, "cx build: no source files")
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+
+	outDir := options.buildOutput
+	if outDir == "" {
+		outDir = strings.TrimSuffix(filepath.Base(fileNames[0]), ".cx") + "_go"
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cx build: %v\n", err)
+		os.Exit(cxcore.CX_INTERNAL_ERROR)
+	}
+
+	cfg := gotarget.Config{
+		OptimizationLevel: options.optimizationLevel,
+		StackSize:         cxcore.STACK_SIZE,
+		InitHeapSize:      cxcore.INIT_HEAP_SIZE,
+		MaxHeapSize:       cxcore.MAX_HEAP_SIZE,
+		MaxCallStackSize:  cxcore.MAX_CALLSTACK_SIZE,
+		MinHeapFreeRatio:  cxcore.MIN_HEAP_FREE_RATIO,
+		MaxHeapFreeRatio:  cxcore.MAX_HEAP_FREE_RATIO,
+		Dir:               wd,
+		Module:            filepath.Base(outDir),
+		CXVersion:         cxVersion(),
+	}
+
+	files, err := gotarget.Generate(actions.PRGRM, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cx build: %v\n", err)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "cx build: %v\n", err)
+		os.Exit(cxcore.CX_INTERNAL_ERROR)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(outDir, name), content, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "cx build: %v\n", err)
+			os.Exit(cxcore.CX_INTERNAL_ERROR)
+		}
+	}
+}
+
+// cxVersion returns the version of github.com/skycoin/cx required by the
+// generated packages, which is the module version of this binary, or the
+// release it was built from when it wasn't installed as a module or was
+// built from modified sources.
+func cxVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == "" || info.Main.Version == "(devel)" || strings.Contains(info.Main.Version, "+") {
+		return "v" + VERSION
+	}
+	return info.Main.Version
+}
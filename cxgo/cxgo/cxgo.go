@@ -3,6 +3,7 @@ package cxgo
 import (
 	"bufio"
 	"bytes"
+	"fmt"
 	"io"
 	"os"
 	"regexp"
@@ -19,8 +20,6 @@ import (
 // ParseSourceCode takes a group of files representing CX `sourceCode` and
 // parses it into CX program structures for `PRGRM`.
 func ParseSourceCode(sourceCode []*os.File, fileNames []string) {
-	cxgo0.PRGRM0 = actions.PRGRM
-
 	// Copy the contents of the file pointers containing the CX source
 	// code into sourceCodeCopy
 	sourceCodeCopy := make([]string, len(sourceCode))
@@ -30,13 +29,21 @@ func ParseSourceCode(sourceCode []*os.File, fileNames []string) {
 		sourceCodeCopy[i] = string(tmp.Bytes())
 	}
 
+	ParseSources(sourceCodeCopy, fileNames)
+}
+
+// ParseSources parses the CX source code `sources`, read from the files
+// `fileNames`, into CX program structures for `PRGRM`.
+func ParseSources(sources []string, fileNames []string) {
+	cxgo0.PRGRM0 = actions.PRGRM
+
 	// We need to traverse the elements by hierarchy first add all the
 	// packages and structs at the same time then add globals, as these
 	// can be of a custom type (and it could be imported) the signatures
 	// of functions and methods are added in the cxgo0.y pass
 	parseErrors := 0
-	if len(sourceCode) > 0 {
-		parseErrors = lexerStep0(sourceCodeCopy, fileNames)
+	if len(sources) > 0 {
+		parseErrors = lexerStep0(sources, fileNames)
 	}
 
 	actions.PRGRM.SelectProgram()
@@ -48,8 +55,9 @@ func ParseSourceCode(sourceCode []*os.File, fileNames []string) {
 	}
 
 	// Adding global variables `OS_ARGS` to the `os` (operating system)
-	// package.
-	if osPkg, err := actions.PRGRM.GetPackage(cxcore.OS_PKG); err == nil {
+	// package. Without sources, as when the REPL starts empty, there's no
+	// current package to declare it from, and no program to read it.
+	if osPkg, err := actions.PRGRM.GetPackage(cxcore.OS_PKG); err == nil && len(sources) > 0 {
 		if _, err := osPkg.GetGlobal(cxcore.OS_ARGS); err != nil {
 			arg0 := cxcore.MakeArgument(cxcore.OS_ARGS, "", -1).AddType(cxcore.TypeNames[cxcore.TYPE_UNDEFINED])
 			arg0.Package = osPkg
@@ -64,7 +72,7 @@ func ParseSourceCode(sourceCode []*os.File, fileNames []string) {
 
 	profiling.StartProfile("4. parse")
 	// The last pass of parsing that generates the actual output.
-	for i, source := range sourceCodeCopy {
+	for i, source := range sources {
 		// Because of an unkown reason, sometimes some CX programs
 		// throw an error related to a premature EOF (particularly in Windows).
 		// Adding a newline character solves this.
@@ -85,6 +93,50 @@ func ParseSourceCode(sourceCode []*os.File, fileNames []string) {
 	}
 }
 
+// FindPackage returns the directory of the package imported as `path`. The
+// packages are looked for in SRCPATH, unless `cx` replaces it to resolve the
+// imports of a module (see package cxmod).
+var FindPackage = func(path string) (string, error) {
+	return filepath.Join(cxcore.SRCPATH, path), nil
+}
+
+// CorePackageEnabled checks if the programs can import the core package
+// `name`. `cx` replaces it with the packages enabled by the configuration of
+// the project (see package cxproject).
+var CorePackageEnabled = func(name string) bool {
+	return true
+}
+
+// isWorkspacePackage checks if the package imported as `path` is a
+// directory of the workspace.
+func isWorkspacePackage(path string) bool {
+	dir, err := FindPackage(path)
+	if err != nil {
+		return false
+	}
+	fi, err := os.Stat(dir)
+	return err == nil && fi.IsDir()
+}
+
+// importPackage reads and parses the package imported as `path`.
+func importPackage(path string) {
+	dir, err := FindPackage(path)
+	if err != nil {
+		println(err.Error())
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+
+	_, sourceCode, fileNames := cxcore.ParseArgsForCX([]string{dir}, false)
+	var sources []string
+	for _, source := range sourceCode {
+		tmp := bytes.NewBuffer(nil)
+		io.Copy(tmp, source)
+		sources = append(sources, string(tmp.Bytes()))
+	}
+
+	ParseSources(sources, fileNames)
+}
+
 // lexerStep0 performs a first pass for the CX parser. Globals, packages and
 // custom types are added to `cxgo0.PRGRM0`.
 func lexerStep0(srcStrs, srcNames []string) int {
@@ -107,7 +159,7 @@ func lexerStep0(srcStrs, srcNames []string) int {
 	reBodyClose := regexp.MustCompile("}")
 
 	reImp := regexp.MustCompile("import")
-	reImpName := regexp.MustCompile("(^|[\\s])import\\s+\"([_a-zA-Z][_a-zA-Z0-9/-]*)\"")
+	reImpName := regexp.MustCompile("(^|[\\s])import\\s+\"([_a-zA-Z][_a-zA-Z0-9/.-]*)\"")
 
 	profiling.StartProfile("1. packages/structs")
 	// 1. Identify all the packages and structs
@@ -195,10 +247,12 @@ func lexerStep0(srcStrs, srcNames []string) int {
 		// inBlock needs to be 0 to guarantee that we're in the global scope
 		var inBlock int
 		var commentedCode bool
+		var lineno = 0
 
 		scanner := bufio.NewScanner(strings.NewReader(source))
 		for scanner.Scan() {
 			line := scanner.Bytes()
+			lineno++
 
 			// we need to ignore function bodies
 			// it'll also ignore struct declaration's bodies, but this doesn't matter
@@ -230,12 +284,16 @@ func lexerStep0(srcStrs, srcNames []string) int {
 				}
 
 				if match := reImpName.FindStringSubmatch(string(line)); match != nil {
-					pkgName := match[len(match)-1]
+					pkgPath := match[len(match)-1]
+					pkgName := pkgPath[strings.LastIndex(pkgPath, "/")+1:]
+					// A package of the workspace shadows the standard library package with the same name.
+					isCore := cxcore.IsCorePackage(pkgName) && !isWorkspacePackage(pkgPath)
 					// Checking if `pkgName` already exists and if it's not a standard library package.
-					if _, err := cxgo0.PRGRM0.GetPackage(pkgName); err != nil && !cxcore.IsCorePackage(pkgName) {
-						// _, sourceCode, srcNames := ParseArgsForCX([]string{fmt.Sprintf("%s%s", SRCPATH, pkgName)}, false)
-						_, sourceCode, fileNames := cxcore.ParseArgsForCX([]string{filepath.Join(cxcore.SRCPATH, pkgName)}, false)
-						ParseSourceCode(sourceCode, fileNames)
+					if _, err := cxgo0.PRGRM0.GetPackage(pkgName); err != nil && !isCore {
+						importPackage(pkgPath)
+					} else if isCore && cxcore.IsCorePackage(pkgPath) && !CorePackageEnabled(pkgPath) {
+						println(cxcore.CompilationError(srcNames[i], lineno),
+							fmt.Sprintf("core package '%s' is not enabled in the project configuration", pkgPath))
 					}
 				}
 			}
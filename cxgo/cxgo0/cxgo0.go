@@ -72,18 +72,18 @@ type yyXError struct {
 }
 
 const (
-	yyDefault              = 57493
+	yyDefault              = 57494
 	yyEofCode              = 57344
-	ADDR                   = 57492
+	ADDR                   = 57493
 	ADD_ASSIGN             = 57439
 	ADD_OP                 = 57399
-	AFF                    = 57487
+	AFF                    = 57488
 	AFFVAR                 = 57406
 	AND                    = 57397
 	AND_ASSIGN             = 57440
 	AND_OP                 = 57437
 	ASSIGN                 = 57379
-	BASICTYPE              = 57470
+	BASICTYPE              = 57471
 	BITANDEQ               = 57425
 	BITCLEAR_OP            = 57416
 	BITOREQ                = 57427
@@ -94,25 +94,25 @@ const (
 	BOOLEAN_LITERAL        = 57346
 	BREAK                  = 57467
 	BYTE_LITERAL           = 57347
-	CAFF                   = 57488
+	CAFF                   = 57489
 	CASE                   = 57464
 	CASSIGN                = 57380
-	CLAUSES                = 57478
+	CLAUSES                = 57479
 	COLON                  = 57389
 	COMMA                  = 57367
 	COMMENT                = 57369
 	CONST                  = 57463
 	CONTINUE               = 57468
 	DEC_OP                 = 57428
-	DEF                    = 57475
+	DEF                    = 57476
 	DEFAULT                = 57465
 	DIVEQ                  = 57420
 	DIV_ASSIGN             = 57444
 	DIV_OP                 = 57402
 	DOUBLE_LITERAL         = 57356
-	DPROGRAM               = 57485
-	DSTACK                 = 57484
-	DSTATE                 = 57486
+	DPROGRAM               = 57486
+	DSTACK                 = 57485
+	DSTATE                 = 57487
 	ELSE                   = 57373
 	ENUM                   = 57462
 	EQUAL                  = 57388
@@ -120,10 +120,10 @@ const (
 	EQ_OP                  = 57435
 	EXP                    = 57412
 	EXPEQ                  = 57422
-	EXPR                   = 57476
+	EXPR                   = 57477
 	F32                    = 57450
 	F64                    = 57451
-	FIELD                  = 57477
+	FIELD                  = 57478
 	FLOAT_LITERAL          = 57355
 	FOR                    = 57374
 	FUNC                   = 57357
@@ -141,7 +141,7 @@ const (
 	IF                     = 57372
 	IMPORT                 = 57381
 	INC_OP                 = 57429
-	INFER                  = 57490
+	INFER                  = 57491
 	INT_LITERAL            = 57349
 	LBRACE                 = 57361
 	LBRACK                 = 57363
@@ -168,8 +168,8 @@ const (
 	NEWLINE                = 57378
 	NE_OP                  = 57436
 	NOT                    = 57413
-	OBJECT                 = 57479
-	OBJECTS                = 57480
+	OBJECT                 = 57480
+	OBJECTS                = 57481
 	OP                     = 57358
 	OR                     = 57398
 	OR_ASSIGN              = 57445
@@ -178,12 +178,13 @@ const (
 	PERIOD                 = 57368
 	PLUSEQ                 = 57417
 	PLUSPLUS               = 57407
-	PSTEP                  = 57482
+	PSTEP                  = 57483
 	PTR_OP                 = 57430
+	RANGE                  = 57469
 	RBRACE                 = 57362
 	RBRACK                 = 57364
 	REF_OP                 = 57404
-	REM                    = 57474
+	REM                    = 57475
 	REMAINDER              = 57409
 	REMAINDEREQ            = 57421
 	RETURN                 = 57382
@@ -193,20 +194,20 @@ const (
 	RIGHT_OP               = 57432
 	RPAREN                 = 57360
 	SEMICOLON              = 57377
-	SFUNC                  = 57473
+	SFUNC                  = 57474
 	SHORT_LITERAL          = 57348
-	SPACKAGE               = 57471
-	SSTRUCT                = 57472
-	STEP                   = 57481
+	SPACKAGE               = 57472
+	SSTRUCT                = 57473
+	STEP                   = 57482
 	STR                    = 57456
 	STRING_LITERAL         = 57370
 	STRUCT                 = 57376
 	SUB_ASSIGN             = 57447
 	SUB_OP                 = 57400
 	SWITCH                 = 57466
-	TAG                    = 57489
-	TSTEP                  = 57483
-	TYPE                   = 57469
+	TAG                    = 57490
+	TSTEP                  = 57484
+	TYPE                   = 57470
 	TYPSTRUCT              = 57375
 	UI16                   = 57458
 	UI32                   = 57459
@@ -218,13 +219,13 @@ const (
 	UNSIGNED_INT_LITERAL   = 57353
 	UNSIGNED_LONG_LITERAL  = 57354
 	UNSIGNED_SHORT_LITERAL = 57352
-	VALUE                  = 57491
+	VALUE                  = 57492
 	VAR                    = 57366
 	XOR_ASSIGN             = 57448
 	yyErrCode              = 57345
 
 	yyMaxDepth = 200
-	yyTabOfs   = -228
+	yyTabOfs   = -229
 )
 
 var (
@@ -234,49 +235,49 @@ var (
 	}
 
 	yyXLAT = map[int]int{
-		57377: 0,   // SEMICOLON (201x)
-		57404: 1,   // REF_OP (198x)
-		57359: 2,   // LPAREN (196x)
-		57400: 3,   // SUB_OP (192x)
-		57401: 4,   // MUL_OP (191x)
-		57399: 5,   // ADD_OP (187x)
-		57363: 6,   // LBRACK (185x)
-		57428: 7,   // DEC_OP (172x)
-		57429: 8,   // INC_OP (172x)
-		57362: 9,   // RBRACE (171x)
-		57361: 10,  // LBRACE (167x)
-		57365: 11,  // IDENTIFIER (164x)
-		57367: 12,  // COMMA (154x)
-		57360: 13,  // RPAREN (141x)
-		57487: 14,  // AFF (134x)
-		57449: 15,  // BOOL (134x)
-		57450: 16,  // F32 (134x)
-		57451: 17,  // F64 (134x)
-		57453: 18,  // I16 (134x)
-		57454: 19,  // I32 (134x)
-		57455: 20,  // I64 (134x)
-		57452: 21,  // I8 (134x)
-		57456: 22,  // STR (134x)
-		57458: 23,  // UI16 (134x)
-		57459: 24,  // UI32 (134x)
-		57460: 25,  // UI64 (134x)
-		57457: 26,  // UI8 (134x)
-		57349: 27,  // INT_LITERAL (127x)
-		57370: 28,  // STRING_LITERAL (118x)
-		57346: 29,  // BOOLEAN_LITERAL (117x)
-		57347: 30,  // BYTE_LITERAL (117x)
-		57356: 31,  // DOUBLE_LITERAL (117x)
-		57355: 32,  // FLOAT_LITERAL (117x)
-		57490: 33,  // INFER (117x)
-		57350: 34,  // LONG_LITERAL (117x)
-		57405: 35,  // NEG_OP (117x)
-		57348: 36,  // SHORT_LITERAL (117x)
-		57351: 37,  // UNSIGNED_BYTE_LITERAL (117x)
-		57353: 38,  // UNSIGNED_INT_LITERAL (117x)
-		57354: 39,  // UNSIGNED_LONG_LITERAL (117x)
-		57352: 40,  // UNSIGNED_SHORT_LITERAL (117x)
-		57389: 41,  // COLON (103x)
-		57364: 42,  // RBRACK (102x)
+		57377: 0,   // SEMICOLON (202x)
+		57404: 1,   // REF_OP (199x)
+		57359: 2,   // LPAREN (197x)
+		57400: 3,   // SUB_OP (193x)
+		57401: 4,   // MUL_OP (192x)
+		57399: 5,   // ADD_OP (188x)
+		57363: 6,   // LBRACK (186x)
+		57428: 7,   // DEC_OP (173x)
+		57429: 8,   // INC_OP (173x)
+		57362: 9,   // RBRACE (172x)
+		57361: 10,  // LBRACE (168x)
+		57365: 11,  // IDENTIFIER (165x)
+		57367: 12,  // COMMA (155x)
+		57360: 13,  // RPAREN (142x)
+		57488: 14,  // AFF (135x)
+		57449: 15,  // BOOL (135x)
+		57450: 16,  // F32 (135x)
+		57451: 17,  // F64 (135x)
+		57453: 18,  // I16 (135x)
+		57454: 19,  // I32 (135x)
+		57455: 20,  // I64 (135x)
+		57452: 21,  // I8 (135x)
+		57456: 22,  // STR (135x)
+		57458: 23,  // UI16 (135x)
+		57459: 24,  // UI32 (135x)
+		57460: 25,  // UI64 (135x)
+		57457: 26,  // UI8 (135x)
+		57349: 27,  // INT_LITERAL (128x)
+		57370: 28,  // STRING_LITERAL (119x)
+		57346: 29,  // BOOLEAN_LITERAL (118x)
+		57347: 30,  // BYTE_LITERAL (118x)
+		57356: 31,  // DOUBLE_LITERAL (118x)
+		57355: 32,  // FLOAT_LITERAL (118x)
+		57491: 33,  // INFER (118x)
+		57350: 34,  // LONG_LITERAL (118x)
+		57405: 35,  // NEG_OP (118x)
+		57348: 36,  // SHORT_LITERAL (118x)
+		57351: 37,  // UNSIGNED_BYTE_LITERAL (118x)
+		57353: 38,  // UNSIGNED_INT_LITERAL (118x)
+		57354: 39,  // UNSIGNED_LONG_LITERAL (118x)
+		57352: 40,  // UNSIGNED_SHORT_LITERAL (118x)
+		57389: 41,  // COLON (104x)
+		57364: 42,  // RBRACK (103x)
 		63:    43,  // '?' (88x)
 		57438: 44,  // OR_OP (88x)
 		57437: 45,  // AND_OP (87x)
@@ -291,18 +292,18 @@ var (
 		57416: 54,  // BITCLEAR_OP (77x)
 		57431: 55,  // LEFT_OP (77x)
 		57432: 56,  // RIGHT_OP (77x)
-		57558: 57,  // type_specifier (75x)
+		57559: 57,  // type_specifier (76x)
 		57379: 58,  // ASSIGN (72x)
 		57366: 59,  // VAR (70x)
-		57527: 60,  // indexing_literal (68x)
+		57528: 60,  // indexing_literal (69x)
 		57402: 61,  // DIV_OP (66x)
 		57403: 62,  // MOD_OP (66x)
-		57550: 63,  // slice_literal_expression (64x)
-		57498: 64,  // array_literal_expression (63x)
-		57545: 65,  // postfix_expression (63x)
-		57546: 66,  // primary_expression (63x)
-		57560: 67,  // unary_expression (63x)
-		57561: 68,  // unary_operator (63x)
+		57551: 63,  // slice_literal_expression (65x)
+		57499: 64,  // array_literal_expression (64x)
+		57546: 65,  // postfix_expression (64x)
+		57547: 66,  // primary_expression (64x)
+		57561: 67,  // unary_expression (64x)
+		57562: 68,  // unary_operator (64x)
 		57439: 69,  // ADD_ASSIGN (59x)
 		57440: 70,  // AND_ASSIGN (59x)
 		57380: 71,  // CASSIGN (59x)
@@ -315,9 +316,9 @@ var (
 		57446: 78,  // RIGHT_ASSIGN (59x)
 		57447: 79,  // SUB_ASSIGN (59x)
 		57448: 80,  // XOR_ASSIGN (59x)
-		57540: 81,  // multiplicative_expression (56x)
-		57494: 82,  // additive_expression (54x)
-		57549: 83,  // shift_expression (51x)
+		57541: 81,  // multiplicative_expression (57x)
+		57495: 82,  // additive_expression (55x)
+		57550: 83,  // shift_expression (52x)
 		57372: 84,  // IF (50x)
 		57467: 85,  // BREAK (49x)
 		57464: 86,  // CASE (49x)
@@ -327,132 +328,133 @@ var (
 		57383: 90,  // GOTO (49x)
 		57382: 91,  // RETURN (49x)
 		57466: 92,  // SWITCH (49x)
-		57547: 93,  // relational_expression (45x)
-		57496: 94,  // and_expression (44x)
-		57514: 95,  // exclusive_or_expression (43x)
-		57526: 96,  // inclusive_or_expression (42x)
-		57538: 97,  // logical_and_expression (41x)
-		57505: 98,  // conditional_expression (40x)
-		57539: 99,  // logical_or_expression (40x)
-		57500: 100, // assignment_expression (33x)
+		57548: 93,  // relational_expression (46x)
+		57497: 94,  // and_expression (45x)
+		57515: 95,  // exclusive_or_expression (44x)
+		57527: 96,  // inclusive_or_expression (43x)
+		57539: 97,  // logical_and_expression (42x)
+		57506: 98,  // conditional_expression (41x)
+		57540: 99,  // logical_or_expression (41x)
+		57501: 100, // assignment_expression (33x)
 		57357: 101, // FUNC (33x)
-		57555: 102, // struct_literal_expression (33x)
+		57556: 102, // struct_literal_expression (33x)
 		57381: 103, // IMPORT (25x)
 		57371: 104, // PACKAGE (25x)
-		57481: 105, // STEP (25x)
-		57483: 106, // TSTEP (25x)
-		57469: 107, // TYPE (25x)
+		57482: 105, // STEP (25x)
+		57484: 106, // TSTEP (25x)
+		57470: 107, // TYPE (25x)
 		57344: 108, // $end (24x)
-		57515: 109, // expression (21x)
-		57504: 110, // compound_statement (20x)
-		57516: 111, // expression_statement (15x)
-		57535: 112, // iteration_statement (12x)
-		57536: 113, // jump_statement (12x)
-		57537: 114, // labeled_statement (12x)
-		57548: 115, // selection_statement (12x)
-		57551: 116, // statement (12x)
-		57507: 117, // declaration (10x)
-		57502: 118, // block_item (9x)
-		57509: 119, // declarator (8x)
-		57510: 120, // direct_declarator (8x)
+		57516: 109, // expression (21x)
+		57505: 110, // compound_statement (20x)
+		57517: 111, // expression_statement (15x)
+		57536: 112, // iteration_statement (12x)
+		57537: 113, // jump_statement (12x)
+		57538: 114, // labeled_statement (12x)
+		57549: 115, // selection_statement (12x)
+		57552: 116, // statement (12x)
+		57508: 117, // declaration (10x)
+		57503: 118, // block_item (9x)
+		57510: 119, // declarator (8x)
+		57511: 120, // direct_declarator (8x)
 		57373: 121, // ELSE (8x)
-		57508: 122, // declaration_specifiers (5x)
-		57542: 123, // parameter_declaration (5x)
-		57503: 124, // block_item_list (4x)
-		57511: 125, // else_statement (4x)
-		57512: 126, // elseif (4x)
-		57529: 127, // infer_action (4x)
-		57497: 128, // argument_expression_list (3x)
-		57499: 129, // array_literal_expression_list (3x)
-		57506: 130, // constant_expression (3x)
-		57534: 131, // int_value (3x)
-		57556: 132, // struct_literal_fields (3x)
-		57513: 133, // elseif_list (2x)
-		57517: 134, // external_declaration (2x)
-		57519: 135, // function_declaration (2x)
-		57520: 136, // function_header (2x)
-		57521: 137, // function_parameters (2x)
-		57522: 138, // global_declaration (2x)
-		57525: 139, // import_declaration (2x)
-		57533: 140, // initializer (2x)
-		57541: 141, // package_declaration (2x)
-		57543: 142, // parameter_list (2x)
-		57544: 143, // parameter_type_list (2x)
-		57552: 144, // stepping (2x)
-		57553: 145, // struct_declaration (2x)
-		57559: 146, // types_list (2x)
-		57495: 147, // after_period (1x)
-		57501: 148, // assignment_operator (1x)
-		57518: 149, // fields (1x)
-		57523: 150, // id_list (1x)
-		57530: 151, // infer_action_arg (1x)
-		57531: 152, // infer_actions (1x)
-		57532: 153, // infer_clauses (1x)
-		57376: 154, // STRUCT (1x)
-		57554: 155, // struct_fields (1x)
-		57557: 156, // translation_unit (1x)
-		57493: 157, // $default (0x)
-		57492: 158, // ADDR (0x)
-		57406: 159, // AFFVAR (0x)
-		57397: 160, // AND (0x)
-		57470: 161, // BASICTYPE (0x)
-		57425: 162, // BITANDEQ (0x)
-		57427: 163, // BITOREQ (0x)
-		57426: 164, // BITXOREQ (0x)
-		57488: 165, // CAFF (0x)
-		57478: 166, // CLAUSES (0x)
-		57369: 167, // COMMENT (0x)
-		57463: 168, // CONST (0x)
-		57475: 169, // DEF (0x)
-		57420: 170, // DIVEQ (0x)
-		57485: 171, // DPROGRAM (0x)
-		57484: 172, // DSTACK (0x)
-		57486: 173, // DSTATE (0x)
-		57462: 174, // ENUM (0x)
-		57388: 175, // EQUAL (0x)
-		57391: 176, // EQUALWORD (0x)
-		57345: 177, // error (0x)
-		57412: 178, // EXP (0x)
-		57422: 179, // EXPEQ (0x)
-		57476: 180, // EXPR (0x)
-		57477: 181, // FIELD (0x)
-		57433: 182, // GE_OP (0x)
-		57394: 183, // GTHANEQ (0x)
-		57392: 184, // GTHANWORD (0x)
-		57524: 185, // identifier_list (0x)
-		57528: 186, // indexing_slice_literal (0x)
-		57434: 187, // LE_OP (0x)
-		57410: 188, // LEFTSHIFT (0x)
-		57423: 189, // LEFTSHIFTEQ (0x)
-		57395: 190, // LTHANEQ (0x)
-		57393: 191, // LTHANWORD (0x)
-		57418: 192, // MINUSEQ (0x)
-		57408: 193, // MINUSMINUS (0x)
-		57419: 194, // MULTEQ (0x)
-		57390: 195, // NEW (0x)
-		57378: 196, // NEWLINE (0x)
-		57413: 197, // NOT (0x)
-		57479: 198, // OBJECT (0x)
-		57480: 199, // OBJECTS (0x)
-		57358: 200, // OP (0x)
-		57398: 201, // OR (0x)
-		57417: 202, // PLUSEQ (0x)
-		57407: 203, // PLUSPLUS (0x)
-		57482: 204, // PSTEP (0x)
-		57430: 205, // PTR_OP (0x)
-		57474: 206, // REM (0x)
-		57409: 207, // REMAINDER (0x)
-		57421: 208, // REMAINDEREQ (0x)
-		57411: 209, // RIGHTSHIFT (0x)
-		57424: 210, // RIGHTSHIFTEQ (0x)
-		57473: 211, // SFUNC (0x)
-		57471: 212, // SPACKAGE (0x)
-		57472: 213, // SSTRUCT (0x)
-		57489: 214, // TAG (0x)
-		57375: 215, // TYPSTRUCT (0x)
-		57396: 216, // UNEQUAL (0x)
-		57461: 217, // UNION (0x)
-		57491: 218, // VALUE (0x)
+		57509: 122, // declaration_specifiers (5x)
+		57543: 123, // parameter_declaration (5x)
+		57504: 124, // block_item_list (4x)
+		57512: 125, // else_statement (4x)
+		57513: 126, // elseif (4x)
+		57530: 127, // infer_action (4x)
+		57498: 128, // argument_expression_list (3x)
+		57500: 129, // array_literal_expression_list (3x)
+		57507: 130, // constant_expression (3x)
+		57535: 131, // int_value (3x)
+		57557: 132, // struct_literal_fields (3x)
+		57514: 133, // elseif_list (2x)
+		57518: 134, // external_declaration (2x)
+		57520: 135, // function_declaration (2x)
+		57521: 136, // function_header (2x)
+		57522: 137, // function_parameters (2x)
+		57523: 138, // global_declaration (2x)
+		57526: 139, // import_declaration (2x)
+		57534: 140, // initializer (2x)
+		57542: 141, // package_declaration (2x)
+		57544: 142, // parameter_list (2x)
+		57545: 143, // parameter_type_list (2x)
+		57553: 144, // stepping (2x)
+		57554: 145, // struct_declaration (2x)
+		57560: 146, // types_list (2x)
+		57496: 147, // after_period (1x)
+		57502: 148, // assignment_operator (1x)
+		57519: 149, // fields (1x)
+		57524: 150, // id_list (1x)
+		57531: 151, // infer_action_arg (1x)
+		57532: 152, // infer_actions (1x)
+		57533: 153, // infer_clauses (1x)
+		57469: 154, // RANGE (1x)
+		57376: 155, // STRUCT (1x)
+		57555: 156, // struct_fields (1x)
+		57558: 157, // translation_unit (1x)
+		57494: 158, // $default (0x)
+		57493: 159, // ADDR (0x)
+		57406: 160, // AFFVAR (0x)
+		57397: 161, // AND (0x)
+		57471: 162, // BASICTYPE (0x)
+		57425: 163, // BITANDEQ (0x)
+		57427: 164, // BITOREQ (0x)
+		57426: 165, // BITXOREQ (0x)
+		57489: 166, // CAFF (0x)
+		57479: 167, // CLAUSES (0x)
+		57369: 168, // COMMENT (0x)
+		57463: 169, // CONST (0x)
+		57476: 170, // DEF (0x)
+		57420: 171, // DIVEQ (0x)
+		57486: 172, // DPROGRAM (0x)
+		57485: 173, // DSTACK (0x)
+		57487: 174, // DSTATE (0x)
+		57462: 175, // ENUM (0x)
+		57388: 176, // EQUAL (0x)
+		57391: 177, // EQUALWORD (0x)
+		57345: 178, // error (0x)
+		57412: 179, // EXP (0x)
+		57422: 180, // EXPEQ (0x)
+		57477: 181, // EXPR (0x)
+		57478: 182, // FIELD (0x)
+		57433: 183, // GE_OP (0x)
+		57394: 184, // GTHANEQ (0x)
+		57392: 185, // GTHANWORD (0x)
+		57525: 186, // identifier_list (0x)
+		57529: 187, // indexing_slice_literal (0x)
+		57434: 188, // LE_OP (0x)
+		57410: 189, // LEFTSHIFT (0x)
+		57423: 190, // LEFTSHIFTEQ (0x)
+		57395: 191, // LTHANEQ (0x)
+		57393: 192, // LTHANWORD (0x)
+		57418: 193, // MINUSEQ (0x)
+		57408: 194, // MINUSMINUS (0x)
+		57419: 195, // MULTEQ (0x)
+		57390: 196, // NEW (0x)
+		57378: 197, // NEWLINE (0x)
+		57413: 198, // NOT (0x)
+		57480: 199, // OBJECT (0x)
+		57481: 200, // OBJECTS (0x)
+		57358: 201, // OP (0x)
+		57398: 202, // OR (0x)
+		57417: 203, // PLUSEQ (0x)
+		57407: 204, // PLUSPLUS (0x)
+		57483: 205, // PSTEP (0x)
+		57430: 206, // PTR_OP (0x)
+		57475: 207, // REM (0x)
+		57409: 208, // REMAINDER (0x)
+		57421: 209, // REMAINDEREQ (0x)
+		57411: 210, // RIGHTSHIFT (0x)
+		57424: 211, // RIGHTSHIFTEQ (0x)
+		57474: 212, // SFUNC (0x)
+		57472: 213, // SPACKAGE (0x)
+		57473: 214, // SSTRUCT (0x)
+		57490: 215, // TAG (0x)
+		57375: 216, // TYPSTRUCT (0x)
+		57396: 217, // UNEQUAL (0x)
+		57461: 218, // UNION (0x)
+		57492: 219, // VALUE (0x)
 	}
 
 	yySymNames = []string{
@@ -610,6 +612,7 @@ var (
 		"infer_action_arg",
 		"infer_actions",
 		"infer_clauses",
+		"RANGE",
 		"STRUCT",
 		"struct_fields",
 		"translation_unit",
@@ -681,8 +684,8 @@ var (
 
 	yyReductions = map[int]struct{ xsym, components int }{
 		0:   {0, 1},
-		1:   {156, 1},
-		2:   {156, 2},
+		1:   {157, 1},
+		2:   {157, 2},
 		3:   {134, 1},
 		4:   {134, 1},
 		5:   {134, 1},
@@ -694,8 +697,8 @@ var (
 		11:  {138, 4},
 		12:  {138, 6},
 		13:  {145, 4},
-		14:  {155, 3},
-		15:  {155, 4},
+		14:  {156, 3},
+		15:  {156, 4},
 		16:  {149, 2},
 		17:  {149, 3},
 		18:  {141, 3},
@@ -710,8 +713,8 @@ var (
 		27:  {142, 1},
 		28:  {142, 3},
 		29:  {123, 2},
-		30:  {185, 1},
-		31:  {185, 3},
+		30:  {186, 1},
+		31:  {186, 3},
 		32:  {119, 1},
 		33:  {120, 1},
 		34:  {120, 3},
@@ -751,8 +754,8 @@ var (
 		68:  {129, 3},
 		69:  {60, 3},
 		70:  {60, 4},
-		71:  {186, 2},
-		72:  {186, 3},
+		71:  {187, 2},
+		72:  {187, 3},
 		73:  {64, 5},
 		74:  {64, 4},
 		75:  {64, 5},
@@ -849,7 +852,7 @@ var (
 		166: {102, 6},
 		167: {100, 1},
 		168: {100, 3},
-		169: {148, 1},
+		169: {100, 4},
 		170: {148, 1},
 		171: {148, 1},
 		172: {148, 1},
@@ -861,154 +864,155 @@ var (
 		178: {148, 1},
 		179: {148, 1},
 		180: {148, 1},
-		181: {109, 1},
-		182: {109, 3},
-		183: {130, 1},
-		184: {117, 4},
-		185: {117, 6},
-		186: {140, 1},
-		187: {116, 1},
+		181: {148, 1},
+		182: {109, 1},
+		183: {109, 3},
+		184: {130, 1},
+		185: {117, 4},
+		186: {117, 6},
+		187: {140, 1},
 		188: {116, 1},
 		189: {116, 1},
 		190: {116, 1},
 		191: {116, 1},
 		192: {116, 1},
-		193: {114, 3},
-		194: {114, 4},
-		195: {114, 3},
-		196: {110, 3},
-		197: {110, 4},
-		198: {124, 1},
-		199: {124, 2},
-		200: {118, 1},
+		193: {116, 1},
+		194: {114, 3},
+		195: {114, 4},
+		196: {114, 3},
+		197: {110, 3},
+		198: {110, 4},
+		199: {124, 1},
+		200: {124, 2},
 		201: {118, 1},
-		202: {111, 1},
-		203: {111, 2},
-		204: {115, 8},
-		205: {115, 7},
-		206: {115, 6},
-		207: {115, 7},
-		208: {115, 6},
-		209: {115, 7},
-		210: {115, 3},
-		211: {115, 5},
-		212: {126, 6},
-		213: {126, 5},
-		214: {133, 1},
-		215: {133, 2},
-		216: {125, 4},
-		217: {125, 3},
-		218: {112, 3},
-		219: {112, 4},
-		220: {112, 5},
-		221: {112, 4},
-		222: {112, 5},
-		223: {113, 3},
-		224: {113, 2},
+		202: {118, 1},
+		203: {111, 1},
+		204: {111, 2},
+		205: {115, 8},
+		206: {115, 7},
+		207: {115, 6},
+		208: {115, 7},
+		209: {115, 6},
+		210: {115, 7},
+		211: {115, 3},
+		212: {115, 5},
+		213: {126, 6},
+		214: {126, 5},
+		215: {133, 1},
+		216: {133, 2},
+		217: {125, 4},
+		218: {125, 3},
+		219: {112, 3},
+		220: {112, 4},
+		221: {112, 5},
+		222: {112, 4},
+		223: {112, 5},
+		224: {113, 3},
 		225: {113, 2},
 		226: {113, 2},
-		227: {113, 3},
+		227: {113, 2},
+		228: {113, 3},
 	}
 
 	yyXErrors = map[yyXError]string{}
 
-	yyParseTab = [415][]uint16{
+	yyParseTab = [417][]uint16{
 		// 0
-		{59: 239, 101: 243, 103: 242, 241, 238, 237, 240, 134: 230, 233, 244, 138: 232, 234, 141: 231, 144: 236, 235, 156: 229},
-		{59: 239, 101: 243, 103: 242, 241, 238, 237, 240, 228, 134: 642, 233, 244, 138: 232, 234, 141: 231, 144: 236, 235},
-		{59: 227, 101: 227, 103: 227, 227, 227, 227, 227, 227},
+		{59: 240, 101: 244, 103: 243, 242, 239, 238, 241, 134: 231, 234, 245, 138: 233, 235, 141: 232, 144: 237, 236, 157: 230},
+		{59: 240, 101: 244, 103: 243, 242, 239, 238, 241, 229, 134: 645, 234, 245, 138: 233, 235, 141: 232, 144: 237, 236},
+		{59: 228, 101: 228, 103: 228, 228, 228, 228, 228, 228},
+		{59: 226, 101: 226, 103: 226, 226, 226, 226, 226, 226},
 		{59: 225, 101: 225, 103: 225, 225, 225, 225, 225, 225},
-		{59: 224, 101: 224, 103: 224, 224, 224, 224, 224, 224},
 		// 5
+		{59: 224, 101: 224, 103: 224, 224, 224, 224, 224, 224},
 		{59: 223, 101: 223, 103: 223, 223, 223, 223, 223, 223},
 		{59: 222, 101: 222, 103: 222, 222, 222, 222, 222, 222},
 		{59: 221, 101: 221, 103: 221, 221, 221, 221, 221, 221},
-		{59: 220, 101: 220, 103: 220, 220, 220, 220, 220, 220},
-		{3: 638, 27: 637, 131: 640},
+		{3: 641, 27: 640, 131: 643},
 		// 10
-		{3: 638, 27: 637, 131: 636},
-		{2: 428, 11: 427, 119: 630, 426},
-		{11: 617},
-		{11: 615},
-		{28: 613},
+		{3: 641, 27: 640, 131: 639},
+		{2: 429, 11: 428, 119: 633, 427},
+		{11: 620},
+		{11: 618},
+		{28: 616},
 		// 15
-		{2: 609, 11: 608},
-		{2: 245, 137: 246},
-		{2: 428, 11: 427, 13: 599, 119: 603, 426, 123: 602, 142: 601, 600},
-		{2: 245, 10: 249, 110: 247, 137: 248},
-		{59: 204, 101: 204, 103: 204, 204, 204, 204, 204, 204},
+		{2: 612, 11: 611},
+		{2: 246, 137: 247},
+		{2: 429, 11: 428, 13: 602, 119: 606, 427, 123: 605, 142: 604, 603},
+		{2: 246, 10: 250, 110: 248, 137: 249},
+		{59: 205, 101: 205, 103: 205, 205, 205, 205, 205, 205},
 		// 20
-		{10: 249, 110: 598},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 316, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 318, 124: 317},
+		{10: 250, 110: 601},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 317, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 319, 124: 318},
+		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 12: 179, 179, 41: 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 58: 179, 61: 179, 179, 69: 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
 		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 12: 178, 178, 41: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 58: 178, 61: 178, 178, 69: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
 		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 12: 177, 177, 41: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 58: 177, 61: 177, 177, 69: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177},
-		{176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 12: 176, 176, 41: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 58: 176, 61: 176, 176, 69: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176},
 		// 25
+		{176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 12: 176, 176, 41: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 58: 176, 61: 176, 176, 69: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176},
 		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 12: 175, 175, 41: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 58: 175, 61: 175, 175, 69: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175},
 		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 12: 174, 174, 41: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 58: 174, 61: 174, 174, 69: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174},
 		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 12: 173, 173, 41: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 58: 173, 61: 173, 173, 69: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
 		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 12: 172, 172, 41: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 58: 172, 61: 172, 172, 69: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
-		{171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 12: 171, 171, 41: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 58: 171, 61: 171, 171, 69: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171},
 		// 30
+		{171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 12: 171, 171, 41: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 58: 171, 61: 171, 171, 69: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171},
 		{170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 12: 170, 170, 41: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 58: 170, 61: 170, 170, 69: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170},
 		{169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 12: 169, 169, 41: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 58: 169, 61: 169, 169, 69: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169},
 		{168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 12: 168, 168, 41: 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 58: 168, 61: 168, 168, 69: 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168},
 		{167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 12: 167, 167, 41: 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 58: 167, 61: 167, 167, 69: 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167},
-		{166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 12: 166, 166, 41: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 58: 166, 61: 166, 166, 69: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166},
 		// 35
-		{27: 452, 42: 585},
-		{6: 444, 11: 569, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 570},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 10: 335, 12: 133, 41: 567, 43: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 61: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{10: 542},
-		{131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 12: 131, 131, 41: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 58: 131, 61: 131, 131, 69: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131},
+		{27: 453, 42: 588},
+		{6: 445, 11: 572, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 573},
+		{134, 134, 134, 134, 134, 134, 134, 134, 134, 10: 336, 12: 134, 41: 570, 43: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 58: 134, 61: 134, 134, 69: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134},
+		{10: 545},
+		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 12: 132, 132, 41: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 58: 132, 61: 132, 132, 69: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
 		// 40
+		{131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 12: 131, 131, 41: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 58: 131, 61: 131, 131, 69: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131},
 		{130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 12: 130, 130, 41: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 58: 130, 61: 130, 130, 69: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130},
 		{129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 12: 129, 129, 41: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 58: 129, 61: 129, 129, 69: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129},
 		{128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 12: 128, 128, 41: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 58: 128, 61: 128, 128, 69: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128},
 		{127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 12: 127, 127, 41: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 58: 127, 61: 127, 127, 69: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127},
-		{126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 12: 126, 126, 41: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 58: 126, 61: 126, 126, 69: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126},
 		// 45
+		{126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 12: 126, 126, 41: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 58: 126, 61: 126, 126, 69: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126},
 		{125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 12: 125, 125, 41: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 58: 125, 61: 125, 125, 69: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125},
 		{124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 12: 124, 124, 41: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 58: 124, 61: 124, 124, 69: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124},
 		{123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 12: 123, 123, 41: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 58: 123, 61: 123, 123, 69: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123},
 		{122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 12: 122, 122, 41: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 58: 122, 61: 122, 122, 69: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122},
-		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 12: 121, 121, 41: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 58: 121, 61: 121, 121, 69: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121},
 		// 50
-		{120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 12: 120, 120, 41: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 58: 120, 61: 120, 120, 69: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 540},
+		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 12: 121, 121, 41: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 58: 121, 61: 121, 121, 69: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 543},
+		{119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 12: 119, 119, 41: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 58: 119, 61: 119, 119, 69: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119},
 		{118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 12: 118, 118, 41: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 58: 118, 61: 118, 118, 69: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118},
-		{117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 12: 117, 117, 41: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 58: 117, 61: 117, 117, 69: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
-		{114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 12: 114, 114, 41: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 58: 114, 61: 114, 114, 69: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114},
+		{115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 12: 115, 115, 41: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 58: 115, 61: 115, 115, 69: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115},
 		// 55
-		{104, 104, 350, 104, 104, 104, 349, 352, 351, 104, 104, 12: 104, 104, 41: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 58: 104, 61: 104, 104, 69: 104, 104, 104, 104, 104, 104, 104, 104, 535, 104, 104, 104},
-		{77: 531},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 530, 345},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 529, 345},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 525, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 348, 345},
+		{105, 105, 351, 105, 105, 105, 350, 353, 352, 105, 105, 12: 105, 105, 41: 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 58: 105, 61: 105, 105, 69: 105, 105, 105, 105, 105, 105, 105, 105, 538, 105, 105, 105},
+		{77: 534},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 533, 346},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 532, 346},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 528, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 349, 346},
 		// 60
+		{1: 101, 101, 101, 101, 101, 101, 101, 101, 11: 101, 14: 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101},
 		{1: 100, 100, 100, 100, 100, 100, 100, 100, 11: 100, 14: 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100},
 		{1: 99, 99, 99, 99, 99, 99, 99, 99, 11: 99, 14: 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99},
 		{1: 98, 98, 98, 98, 98, 98, 98, 98, 11: 98, 14: 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98},
 		{1: 97, 97, 97, 97, 97, 97, 97, 97, 11: 97, 14: 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97},
-		{1: 96, 96, 96, 96, 96, 96, 96, 96, 11: 96, 14: 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96},
 		// 65
-		{95, 95, 3: 95, 95, 95, 9: 95, 95, 12: 95, 95, 41: 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 58: 512, 61: 95, 95, 69: 517, 521, 513, 515, 519, 516, 514, 523, 78: 520, 518, 522, 148: 511},
-		{91, 91, 3: 91, 497, 91, 9: 91, 91, 12: 91, 91, 41: 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 61: 498, 499},
-		{88, 88, 3: 495, 5: 494, 9: 88, 88, 12: 88, 88, 41: 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88},
-		{84, 84, 9: 84, 84, 12: 84, 84, 41: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 492, 490, 491},
-		{77, 77, 9: 77, 77, 12: 77, 77, 41: 77, 77, 77, 77, 77, 77, 77, 483, 486, 488, 485, 487, 484},
+		{96, 96, 3: 96, 96, 96, 9: 96, 96, 12: 96, 96, 41: 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 58: 514, 61: 96, 96, 69: 518, 522, 513, 516, 520, 517, 515, 524, 78: 521, 519, 523, 148: 512},
+		{92, 92, 3: 92, 498, 92, 9: 92, 92, 12: 92, 92, 41: 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 61: 499, 500},
+		{89, 89, 3: 496, 5: 495, 9: 89, 89, 12: 89, 89, 41: 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89},
+		{85, 85, 9: 85, 85, 12: 85, 85, 41: 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 493, 491, 492},
+		{78, 78, 9: 78, 78, 12: 78, 78, 41: 78, 78, 78, 78, 78, 78, 78, 484, 487, 489, 486, 488, 485},
 		// 70
-		{75, 481, 9: 75, 75, 12: 75, 75, 41: 75, 75, 75, 75, 75, 75, 75},
-		{73, 9: 73, 73, 12: 73, 73, 41: 73, 73, 73, 73, 73, 73, 479},
-		{71, 9: 71, 71, 12: 71, 71, 41: 71, 71, 71, 71, 71, 477},
-		{69, 9: 69, 69, 12: 69, 69, 41: 69, 69, 69, 69, 475},
-		{67, 9: 67, 67, 12: 67, 67, 41: 67, 67, 470, 469},
+		{76, 482, 9: 76, 76, 12: 76, 76, 41: 76, 76, 76, 76, 76, 76, 76},
+		{74, 9: 74, 74, 12: 74, 74, 41: 74, 74, 74, 74, 74, 74, 480},
+		{72, 9: 72, 72, 12: 72, 72, 41: 72, 72, 72, 72, 72, 478},
+		{70, 9: 70, 70, 12: 70, 70, 41: 70, 70, 70, 70, 476},
+		{68, 9: 68, 68, 12: 68, 68, 41: 68, 68, 471, 470},
 		// 75
-		{65, 9: 65, 65, 12: 65, 65, 41: 65, 65},
-		{61, 9: 61, 61, 12: 61, 61, 41: 61, 61},
+		{66, 9: 66, 66, 12: 66, 66, 41: 66, 66},
+		{62, 9: 62, 62, 12: 62, 62, 41: 62, 62},
 		{47, 10: 47, 12: 47, 47, 41: 47, 47},
-		{380, 12: 332},
-		{2: 428, 11: 427, 119: 429, 426},
+		{381, 12: 333},
+		{2: 429, 11: 428, 119: 430, 427},
 		// 80
 		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 14: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 59: 41, 84: 41, 41, 41, 41, 41, 41, 41, 41, 41},
 		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 14: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 59: 40, 84: 40, 40, 40, 40, 40, 40, 40, 40, 40},
@@ -1017,246 +1021,246 @@ var (
 		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 14: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 59: 37, 84: 37, 37, 37, 37, 37, 37, 37, 37, 37},
 		// 85
 		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 14: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 59: 36, 84: 36, 36, 36, 36, 36, 36, 36, 36, 36},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 347, 302, 130: 423},
-		{41: 421},
-		{413},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 420, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 392},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 348, 303, 130: 424},
+		{41: 422},
+		{414},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 421, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 393},
 		// 90
 		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 14: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 59: 30, 84: 30, 30, 30, 30, 30, 30, 30, 30, 30},
 		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 14: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 59: 28, 84: 28, 28, 28, 28, 28, 28, 28, 28, 28},
 		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 14: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 59: 27, 84: 27, 27, 27, 27, 27, 27, 27, 27, 27},
 		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 14: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 59: 26, 84: 26, 26, 26, 26, 26, 26, 26, 26, 26},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 386, 302},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 387, 303},
 		// 95
-		{2: 382},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 369, 111: 370, 117: 371},
-		{11: 367},
+		{2: 383},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 370, 111: 371, 117: 372},
+		{11: 368},
+		{367},
 		{366},
-		{365},
 		// 100
-		{331, 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 330},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 335, 12: 133, 133, 41: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 61: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{333, 12: 332},
+		{332, 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 331},
+		{134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 336, 12: 134, 134, 41: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 58: 134, 61: 134, 134, 69: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134},
+		{334, 12: 333},
 		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 14: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 59: 2, 84: 2, 2, 2, 2, 2, 2, 2, 2, 2},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 334, 102: 304},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 335, 102: 305},
 		// 105
 		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 14: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 59: 1, 84: 1, 1, 1, 1, 1, 1, 1, 1, 1},
 		{46, 10: 46, 12: 46, 46, 41: 46, 46},
-		{9: 165, 11: 336, 165, 132: 337},
-		{41: 363},
-		{9: 339, 12: 338},
+		{9: 166, 11: 337, 166, 132: 338},
+		{41: 364},
+		{9: 340, 12: 339},
 		// 110
-		{11: 340},
-		{64, 9: 64, 64, 12: 64, 64, 41: 64, 64},
-		{41: 341},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 347, 302, 130: 342},
-		{9: 163, 12: 163},
+		{11: 341},
+		{65, 9: 65, 65, 12: 65, 65, 41: 65, 65},
+		{41: 342},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 348, 303, 130: 343},
+		{9: 164, 12: 164},
 		// 115
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 12: 133, 133, 41: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 61: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{104, 104, 350, 104, 104, 104, 349, 352, 351, 104, 104, 12: 104, 104, 41: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 58: 104, 61: 104, 104, 69: 104, 104, 104, 104, 104, 104, 104, 104, 353, 104, 104, 104},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 348, 345},
-		{95, 95, 3: 95, 95, 95, 9: 95, 95, 12: 95, 95, 41: 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 61: 95, 95},
+		{134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 12: 134, 134, 41: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 58: 134, 61: 134, 134, 69: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134},
+		{105, 105, 351, 105, 105, 105, 350, 353, 352, 105, 105, 12: 105, 105, 41: 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 58: 105, 61: 105, 105, 69: 105, 105, 105, 105, 105, 105, 105, 105, 354, 105, 105, 105},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 349, 346},
+		{96, 96, 3: 96, 96, 96, 9: 96, 96, 12: 96, 96, 41: 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 61: 96, 96},
 		{9: 45, 12: 45, 41: 45},
 		// 120
-		{101, 101, 3: 101, 101, 101, 9: 101, 101, 12: 101, 101, 41: 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 58: 101, 61: 101, 101, 69: 101, 101, 101, 101, 101, 101, 101, 101, 78: 101, 101, 101},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 361},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 13: 355, 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 357, 102: 304, 128: 356},
+		{102, 102, 3: 102, 102, 102, 9: 102, 102, 12: 102, 102, 41: 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 58: 102, 61: 102, 102, 69: 102, 102, 102, 102, 102, 102, 102, 102, 78: 102, 102, 102},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 362},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 13: 356, 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 358, 102: 305, 128: 357},
+		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 12: 110, 110, 41: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 58: 110, 61: 110, 110, 69: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110},
 		{109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 12: 109, 109, 41: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 58: 109, 61: 109, 109, 69: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109},
-		{108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 12: 108, 108, 41: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 58: 108, 61: 108, 108, 69: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108},
 		// 125
-		{11: 354},
-		{107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 12: 107, 107, 41: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 58: 107, 61: 107, 107, 69: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107},
+		{11: 355},
+		{108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 12: 108, 108, 41: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 58: 108, 61: 108, 108, 69: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108},
+		{112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 12: 112, 112, 41: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 58: 112, 61: 112, 112, 69: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
+		{12: 360, 359},
+		{9: 107, 12: 107, 107},
+		// 130
 		{111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 12: 111, 111, 41: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 58: 111, 61: 111, 111, 69: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
-		{12: 359, 358},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 361, 102: 305},
 		{9: 106, 12: 106, 106},
-		// 130
-		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 12: 110, 110, 41: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 58: 110, 61: 110, 110, 69: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 360, 102: 304},
-		{9: 105, 12: 105, 105},
-		{12: 332, 42: 362},
-		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 12: 113, 113, 41: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 58: 113, 61: 113, 113, 69: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
+		{12: 333, 42: 363},
+		{114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 12: 114, 114, 41: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 58: 114, 61: 114, 114, 69: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114},
 		// 135
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 347, 302, 130: 364},
-		{9: 164, 12: 164},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 348, 303, 130: 365},
+		{9: 165, 12: 165},
 		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 59: 3, 84: 3, 3, 3, 3, 3, 3, 3, 3, 3},
 		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 14: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 59: 4, 84: 4, 4, 4, 4, 4, 4, 4, 4, 4},
-		{368},
+		{369},
 		// 140
 		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 14: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 59: 5, 84: 5, 5, 5, 5, 5, 5, 5, 5, 5},
-		{380, 10: 249, 12: 332, 110: 381},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 111: 376},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 111: 372},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 10: 249, 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 373, 374},
+		{381, 10: 250, 12: 333, 110: 382},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 111: 377},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 111: 373},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 10: 250, 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 374, 375},
 		// 145
-		{10: 249, 12: 332, 110: 375},
+		{10: 250, 12: 333, 110: 376},
 		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 14: 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 59: 7, 84: 7, 7, 7, 7, 7, 7, 7, 7, 7},
 		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 14: 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 59: 6, 84: 6, 6, 6, 6, 6, 6, 6, 6, 6},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 10: 249, 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 377, 378},
-		{10: 249, 12: 332, 110: 379},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 10: 250, 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 378, 379},
+		{10: 250, 12: 333, 110: 380},
 		// 150
 		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 14: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 59: 9, 84: 9, 9, 9, 9, 9, 9, 9, 9, 9},
 		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 14: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 59: 8, 84: 8, 8, 8, 8, 8, 8, 8, 8, 8},
 		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 14: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 59: 25, 84: 25, 25, 25, 25, 25, 25, 25, 25, 25},
 		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 14: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 59: 10, 84: 10, 10, 10, 10, 10, 10, 10, 10, 10},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 383, 302},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 384, 303},
 		// 155
-		{13: 384},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 10: 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 385},
+		{13: 385},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 10: 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 386},
 		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 59: 17, 84: 17, 17, 17, 17, 17, 17, 17, 17, 17},
-		{10: 387, 110: 388},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 389, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 318, 124: 390},
+		{10: 388, 110: 389},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 390, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 319, 124: 391},
 		// 160
 		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 14: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 59: 18, 84: 18, 18, 18, 18, 18, 18, 18, 18, 18},
-		{413, 121: 396, 125: 414, 397, 133: 415},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 391, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 392},
-		{393, 121: 396, 125: 395, 397, 133: 394},
+		{414, 121: 397, 125: 415, 398, 133: 416},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 392, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 393},
+		{394, 121: 397, 125: 396, 398, 133: 395},
 		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 14: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 59: 29, 84: 29, 29, 29, 29, 29, 29, 29, 29, 29},
 		// 165
 		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 14: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 59: 31, 84: 31, 31, 31, 31, 31, 31, 31, 31, 31, 101: 31, 103: 31, 31, 31, 31, 31, 31},
-		{410, 121: 396, 125: 409, 411},
-		{408},
-		{10: 399, 84: 398},
+		{411, 121: 397, 125: 410, 412},
+		{409},
+		{10: 400, 84: 399},
 		{14, 121: 14},
 		// 170
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 403, 302},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 401, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 318, 124: 400},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 402, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 392},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 404, 303},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 402, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 319, 124: 401},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 403, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 393},
 		{11},
 		{12},
 		// 175
-		{10: 404},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 406, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 318, 124: 405},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 407, 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 392},
+		{10: 405},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 407, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 319, 124: 406},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 408, 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 393},
 		{15, 121: 15},
 		{16, 121: 16},
 		// 180
 		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 14: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 59: 23, 84: 23, 23, 23, 23, 23, 23, 23, 23, 23},
-		{412},
+		{413},
 		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 14: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 59: 21, 84: 21, 21, 21, 21, 21, 21, 21, 21, 21},
 		{13, 121: 13},
 		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 14: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 59: 24, 84: 24, 24, 24, 24, 24, 24, 24, 24, 24},
 		// 185
 		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 14: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 59: 32, 84: 32, 32, 32, 32, 32, 32, 32, 32, 32, 101: 32, 103: 32, 32, 32, 32, 32, 32},
-		{419},
-		{416, 121: 396, 125: 417, 411},
+		{420},
+		{417, 121: 397, 125: 418, 412},
 		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 14: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 59: 20, 84: 20, 20, 20, 20, 20, 20, 20, 20, 20},
-		{418},
+		{419},
 		// 190
 		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 14: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 59: 19, 84: 19, 19, 19, 19, 19, 19, 19, 19, 19},
 		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 14: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 59: 22, 84: 22, 22, 22, 22, 22, 22, 22, 22, 22},
-		{393},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 10: 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 422},
+		{394},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 10: 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 423},
 		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 14: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 59: 33, 84: 33, 33, 33, 33, 33, 33, 33, 33, 33},
 		// 195
-		{41: 424},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 10: 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 425},
+		{41: 425},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 10: 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 426},
 		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 14: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 59: 34, 84: 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{4: 197, 6: 197, 11: 197, 13: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 101: 197},
 		{4: 196, 6: 196, 11: 196, 13: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 101: 196},
-		{4: 195, 6: 195, 11: 195, 13: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 101: 195},
 		// 200
-		{2: 428, 11: 427, 119: 467, 426},
-		{4: 431, 6: 432, 11: 434, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 433, 60: 435, 101: 430, 122: 436},
-		{2: 456, 146: 457},
-		{4: 431, 6: 432, 11: 434, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 433, 60: 435, 101: 430, 122: 455},
-		{27: 452, 42: 451},
+		{2: 429, 11: 428, 119: 468, 427},
+		{4: 432, 6: 433, 11: 435, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 434, 60: 436, 101: 431, 122: 437},
+		{2: 457, 146: 458},
+		{4: 432, 6: 433, 11: 435, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 434, 60: 436, 101: 431, 122: 456},
+		{27: 453, 42: 452},
 		// 205
-		{184, 12: 184, 184, 58: 184, 77: 449},
-		{183, 12: 183, 183, 58: 183, 77: 447},
-		{6: 444, 11: 443, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 442},
-		{437, 58: 438},
+		{185, 12: 185, 185, 58: 185, 77: 450},
+		{184, 12: 184, 184, 58: 184, 77: 448},
+		{6: 445, 11: 444, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 443},
+		{438, 58: 439},
 		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 14: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 59: 44, 84: 44, 44, 44, 44, 44, 44, 44, 44, 44},
 		// 210
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 440, 102: 304, 140: 439},
-		{441},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 441, 102: 305, 140: 440},
+		{442},
 		{42},
 		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 14: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 59: 43, 84: 43, 43, 43, 43, 43, 43, 43, 43, 43},
-		{182, 12: 182, 182, 58: 182},
+		{183, 12: 183, 183, 58: 183},
 		// 215
-		{181, 12: 181, 181, 58: 181},
-		{27: 445},
-		{42: 446},
-		{6: 158, 11: 158, 14: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158},
-		{11: 448},
+		{182, 12: 182, 182, 58: 182},
+		{27: 446},
+		{42: 447},
+		{6: 159, 11: 159, 14: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159},
+		{11: 449},
 		// 220
+		{181, 12: 181, 181, 58: 181},
+		{11: 451},
 		{180, 12: 180, 180, 58: 180},
-		{11: 450},
-		{179, 12: 179, 179, 58: 179},
-		{4: 431, 6: 432, 11: 434, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 433, 60: 435, 101: 430, 122: 454},
-		{42: 453},
+		{4: 432, 6: 433, 11: 435, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 434, 60: 436, 101: 431, 122: 455},
+		{42: 454},
 		// 225
-		{6: 159, 11: 159, 14: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159},
-		{185, 12: 185, 185, 58: 185},
+		{6: 160, 11: 160, 14: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160},
 		{186, 12: 186, 186, 58: 186},
-		{11: 459, 13: 462, 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 460, 150: 461},
-		{2: 456, 146: 458},
-		// 230
 		{187, 12: 187, 187, 58: 187},
+		{11: 460, 13: 463, 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 461, 150: 462},
+		{2: 457, 146: 459},
+		// 230
+		{188, 12: 188, 188, 58: 188},
+		{12: 194, 194},
 		{12: 193, 193},
-		{12: 192, 192},
-		{12: 463, 464},
-		{188, 2: 188, 12: 188, 188, 58: 188},
-		// 235
-		{11: 465, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 466},
+		{12: 464, 465},
 		{189, 2: 189, 12: 189, 189, 58: 189},
+		// 235
+		{11: 466, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 467},
+		{190, 2: 190, 12: 190, 190, 58: 190},
+		{12: 192, 192},
 		{12: 191, 191},
-		{12: 190, 190},
-		{13: 468},
+		{13: 469},
 		// 240
-		{4: 194, 6: 194, 11: 194, 13: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 101: 194},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 474},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 471},
-		{12: 332, 41: 472},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 473, 302},
+		{4: 195, 6: 195, 11: 195, 13: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 101: 195},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 475},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 472},
+		{12: 333, 41: 473},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 474, 303},
 		// 245
-		{66, 9: 66, 66, 12: 66, 66, 41: 66, 66},
-		{68, 9: 68, 68, 12: 68, 68, 41: 68, 68, 68, 68, 475},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 299, 476},
-		{70, 9: 70, 70, 12: 70, 70, 41: 70, 70, 70, 70, 70, 477},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 298, 478},
+		{67, 9: 67, 67, 12: 67, 67, 41: 67, 67},
+		{69, 9: 69, 69, 12: 69, 69, 41: 69, 69, 69, 69, 476},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 477},
+		{71, 9: 71, 71, 12: 71, 71, 41: 71, 71, 71, 71, 71, 478},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 479},
 		// 250
-		{72, 9: 72, 72, 12: 72, 72, 41: 72, 72, 72, 72, 72, 72, 479},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 297, 480},
-		{74, 481, 9: 74, 74, 12: 74, 74, 41: 74, 74, 74, 74, 74, 74, 74},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 296, 93: 482},
-		{76, 76, 9: 76, 76, 12: 76, 76, 41: 76, 76, 76, 76, 76, 76, 76, 483, 486, 488, 485, 487, 484},
+		{73, 9: 73, 73, 12: 73, 73, 41: 73, 73, 73, 73, 73, 73, 480},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 481},
+		{75, 482, 9: 75, 75, 12: 75, 75, 41: 75, 75, 75, 75, 75, 75, 75},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 483},
+		{77, 77, 9: 77, 77, 12: 77, 77, 41: 77, 77, 77, 77, 77, 77, 77, 484, 487, 489, 486, 488, 485},
 		// 255
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 510},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 509},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 508},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 507},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 506},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 511},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 510},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 509},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 508},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 507},
 		// 260
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 295, 489},
-		{78, 78, 9: 78, 78, 12: 78, 78, 41: 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 492, 490, 491},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 505},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 504},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 294, 493},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 490},
+		{79, 79, 9: 79, 79, 12: 79, 79, 41: 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 493, 491, 492},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 506},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 505},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 494},
 		// 265
-		{85, 85, 3: 495, 5: 494, 9: 85, 85, 12: 85, 85, 41: 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 503},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 346, 345, 81: 496},
-		{89, 89, 3: 89, 497, 89, 9: 89, 89, 12: 89, 89, 41: 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 61: 498, 499},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 502, 345},
+		{86, 86, 3: 496, 5: 495, 9: 86, 86, 12: 86, 86, 41: 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 504},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 497},
+		{90, 90, 3: 90, 498, 90, 9: 90, 90, 12: 90, 90, 41: 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 61: 499, 500},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 503, 346},
 		// 270
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 501, 345},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 343, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 344, 282, 500, 345},
-		{92, 92, 3: 92, 92, 92, 9: 92, 92, 12: 92, 92, 41: 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 61: 92, 92},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 502, 346},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 501, 346},
 		{93, 93, 3: 93, 93, 93, 9: 93, 93, 12: 93, 93, 41: 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 61: 93, 93},
 		{94, 94, 3: 94, 94, 94, 9: 94, 94, 12: 94, 94, 41: 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 61: 94, 94},
+		{95, 95, 3: 95, 95, 95, 9: 95, 95, 12: 95, 95, 41: 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 61: 95, 95},
 		// 275
-		{90, 90, 3: 90, 497, 90, 9: 90, 90, 12: 90, 90, 41: 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 61: 498, 499},
-		{86, 86, 3: 495, 5: 494, 9: 86, 86, 12: 86, 86, 41: 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86},
-		{87, 87, 3: 495, 5: 494, 9: 87, 87, 12: 87, 87, 41: 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87},
-		{79, 79, 9: 79, 79, 12: 79, 79, 41: 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 492, 490, 491},
-		{80, 80, 9: 80, 80, 12: 80, 80, 41: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 492, 490, 491},
+		{91, 91, 3: 91, 498, 91, 9: 91, 91, 12: 91, 91, 41: 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 61: 499, 500},
+		{87, 87, 3: 496, 5: 495, 9: 87, 87, 12: 87, 87, 41: 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87},
+		{88, 88, 3: 496, 5: 495, 9: 88, 88, 12: 88, 88, 41: 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88},
+		{80, 80, 9: 80, 80, 12: 80, 80, 41: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 493, 491, 492},
+		{81, 81, 9: 81, 81, 12: 81, 81, 41: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 493, 491, 492},
 		// 280
-		{81, 81, 9: 81, 81, 12: 81, 81, 41: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 492, 490, 491},
-		{82, 82, 9: 82, 82, 12: 82, 82, 41: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 492, 490, 491},
-		{83, 83, 9: 83, 83, 12: 83, 83, 41: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 492, 490, 491},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 524, 102: 304},
-		{1: 59, 59, 59, 59, 59, 59, 59, 59, 11: 59, 14: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{82, 82, 9: 82, 82, 12: 82, 82, 41: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 493, 491, 492},
+		{83, 83, 9: 83, 83, 12: 83, 83, 41: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 493, 491, 492},
+		{84, 84, 9: 84, 84, 12: 84, 84, 41: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 493, 491, 492},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 527, 102: 305},
+		{1: 58, 58, 58, 58, 58, 58, 58, 58, 11: 58, 14: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 154: 525},
 		// 285
-		{1: 58, 58, 58, 58, 58, 58, 58, 58, 11: 58, 14: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
+		{1: 59, 59, 59, 59, 59, 59, 59, 59, 11: 59, 14: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
 		{1: 57, 57, 57, 57, 57, 57, 57, 57, 11: 57, 14: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
 		{1: 56, 56, 56, 56, 56, 56, 56, 56, 11: 56, 14: 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
 		{1: 55, 55, 55, 55, 55, 55, 55, 55, 11: 55, 14: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
@@ -1269,148 +1273,151 @@ var (
 		{1: 49, 49, 49, 49, 49, 49, 49, 49, 11: 49, 14: 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
 		// 295
 		{1: 48, 48, 48, 48, 48, 48, 48, 48, 11: 48, 14: 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 344, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 345, 283, 347, 346, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 526, 303},
 		{60, 9: 60, 60, 12: 60, 60, 41: 60, 60},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 526, 12: 133, 133, 41: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 61: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{9: 165, 11: 336, 165, 132: 527},
-		{9: 528, 12: 338},
+		{61, 9: 61, 61, 12: 61, 61, 41: 61, 61},
+		{134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 529, 12: 134, 134, 41: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 58: 134, 61: 134, 134, 69: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134},
 		// 300
-		{63, 9: 63, 63, 12: 63, 63, 41: 63, 63},
-		{102, 102, 3: 102, 102, 102, 9: 102, 102, 12: 102, 102, 41: 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 58: 102, 61: 102, 102, 69: 102, 102, 102, 102, 102, 102, 102, 102, 78: 102, 102, 102},
+		{9: 166, 11: 337, 166, 132: 530},
+		{9: 531, 12: 339},
+		{64, 9: 64, 64, 12: 64, 64, 41: 64, 64},
 		{103, 103, 3: 103, 103, 103, 9: 103, 103, 12: 103, 103, 41: 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 58: 103, 61: 103, 103, 69: 103, 103, 103, 103, 103, 103, 103, 103, 78: 103, 103, 103},
-		{11: 533, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 532, 147: 534},
-		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 12: 116, 116, 41: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 58: 116, 61: 116, 116, 69: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116},
+		{104, 104, 3: 104, 104, 104, 9: 104, 104, 12: 104, 104, 41: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 58: 104, 61: 104, 104, 69: 104, 104, 104, 104, 104, 104, 104, 104, 78: 104, 104, 104},
 		// 305
-		{115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 12: 115, 115, 41: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 58: 115, 61: 115, 115, 69: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115},
-		{112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 12: 112, 112, 41: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 58: 112, 61: 112, 112, 69: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
-		{11: 536},
-		{107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 537, 12: 107, 107, 41: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 58: 107, 61: 107, 107, 69: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107},
-		{9: 165, 11: 336, 165, 132: 538},
+		{11: 536, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 535, 147: 537},
+		{117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 12: 117, 117, 41: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 58: 117, 61: 117, 117, 69: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
+		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 12: 116, 116, 41: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 58: 116, 61: 116, 116, 69: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116},
+		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 12: 113, 113, 41: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 58: 113, 61: 113, 113, 69: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
+		{11: 539},
 		// 310
-		{9: 539, 12: 338},
-		{62, 9: 62, 62, 12: 62, 62, 41: 62, 62},
-		{12: 332, 541},
-		{119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 12: 119, 119, 41: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 58: 119, 61: 119, 119, 69: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119},
-		{9: 137, 11: 543, 127: 544, 152: 545, 546},
+		{108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 540, 12: 108, 108, 41: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 58: 108, 61: 108, 108, 69: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108},
+		{9: 166, 11: 337, 166, 132: 541},
+		{9: 542, 12: 339},
+		{63, 9: 63, 63, 12: 63, 63, 41: 63, 63},
+		{12: 333, 544},
 		// 315
-		{2: 551},
-		{550},
-		{9: 136, 11: 543, 127: 548},
-		{9: 547},
-		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 12: 132, 132, 41: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 58: 132, 61: 132, 132, 69: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
+		{120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 12: 120, 120, 41: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 58: 120, 61: 120, 120, 69: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
+		{9: 138, 11: 546, 127: 547, 152: 548, 549},
+		{2: 554},
+		{553},
+		{9: 137, 11: 546, 127: 551},
 		// 320
-		{549},
-		{9: 138, 11: 138},
+		{9: 550},
+		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 12: 133, 133, 41: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 61: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
+		{552},
 		{9: 139, 11: 139},
-		{11: 552, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 553, 57: 554, 127: 556, 151: 555},
-		{2: 551, 12: 146, 146},
+		{9: 140, 11: 140},
 		// 325
-		{12: 145, 145},
-		{77: 565},
-		{12: 561, 562},
-		{12: 558, 557},
-		{141, 12: 141, 141},
+		{11: 555, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 556, 57: 557, 127: 559, 151: 558},
+		{2: 554, 12: 147, 147},
+		{12: 146, 146},
+		{77: 568},
+		{12: 564, 565},
 		// 330
-		{11: 543, 127: 559},
-		{13: 560},
-		{140, 12: 140, 140},
-		{11: 563},
+		{12: 561, 560},
 		{142, 12: 142, 142},
+		{11: 546, 127: 562},
+		{13: 563},
+		{141, 12: 141, 141},
 		// 335
-		{13: 564},
-		{143, 12: 143, 143},
 		{11: 566},
-		{12: 144, 144},
-		{321, 288, 279, 291, 289, 290, 263, 286, 285, 10: 249, 265, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 59: 307, 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 322, 327, 314, 326, 315, 324, 325, 328, 323, 297, 298, 299, 300, 301, 303, 302, 305, 102: 304, 109: 306, 309, 310, 312, 313, 308, 311, 320, 319, 568},
+		{143, 12: 143, 143},
+		{13: 567},
+		{144, 12: 144, 144},
+		{11: 569},
 		// 340
+		{12: 145, 145},
+		{322, 289, 280, 292, 290, 291, 264, 287, 286, 10: 250, 266, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 59: 308, 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 323, 328, 315, 327, 316, 325, 326, 329, 324, 298, 299, 300, 301, 302, 304, 303, 306, 102: 305, 109: 307, 310, 311, 313, 314, 309, 312, 321, 320, 571},
 		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 14: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 59: 35, 84: 35, 35, 35, 35, 35, 35, 35, 35, 35},
-		{10: 581},
-		{10: 571},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 575, 573, 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 572, 102: 304, 129: 574},
-		{9: 162, 12: 162},
+		{10: 584},
+		{10: 574},
 		// 345
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 10: 573, 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 572, 102: 304, 129: 579},
-		{9: 577, 12: 576},
-		{152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 12: 152, 152, 41: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 58: 152, 61: 152, 152, 69: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 578, 102: 304},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 578, 576, 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 575, 102: 305, 129: 577},
+		{9: 163, 12: 163},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 10: 576, 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 575, 102: 305, 129: 582},
+		{9: 580, 12: 579},
 		{153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 12: 153, 153, 41: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 58: 153, 61: 153, 153, 69: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153},
 		// 350
-		{9: 160, 12: 160},
-		{9: 580, 12: 576},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 581, 102: 305},
+		{154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 12: 154, 154, 41: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 58: 154, 61: 154, 154, 69: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
 		{9: 161, 12: 161},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 583, 573, 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 572, 102: 304, 129: 582},
-		{9: 584, 12: 576},
+		{9: 583, 12: 579},
+		{9: 162, 12: 162},
 		// 355
-		{154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 12: 154, 154, 41: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 58: 154, 61: 154, 154, 69: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 586, 576, 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 575, 102: 305, 129: 585},
+		{9: 587, 12: 579},
 		{155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 12: 155, 155, 41: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 58: 155, 61: 155, 155, 69: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155},
-		{6: 586, 11: 587, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 588, 63: 589},
-		{42: 585},
-		{10: 594},
+		{156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 12: 156, 156, 41: 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 58: 156, 61: 156, 156, 69: 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156},
+		{6: 589, 11: 590, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 591, 63: 592},
 		// 360
-		{10: 590},
-		{147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 12: 147, 147, 41: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 58: 147, 61: 147, 147, 69: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 592, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 357, 102: 304, 128: 591},
-		{9: 593, 12: 359},
+		{42: 588},
+		{10: 597},
+		{10: 593},
 		{148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 12: 148, 148, 41: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 58: 148, 61: 148, 148, 69: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 595, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 358, 102: 305, 128: 594},
 		// 365
+		{9: 596, 12: 360},
 		{149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 12: 149, 149, 41: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 58: 149, 61: 149, 149, 69: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149},
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 596, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 357, 102: 304, 128: 595},
-		{9: 597, 12: 359},
 		{150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 12: 150, 150, 41: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 58: 150, 61: 150, 150, 69: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150},
-		{151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 12: 151, 151, 41: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 58: 151, 61: 151, 151, 69: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 599, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 358, 102: 305, 128: 598},
+		{9: 600, 12: 360},
 		// 370
-		{59: 203, 101: 203, 103: 203, 203, 203, 203, 203, 203},
-		{2: 206, 10: 206},
-		{13: 607},
-		{12: 605, 202},
-		{12: 201, 201},
+		{151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 12: 151, 151, 41: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 58: 151, 61: 151, 151, 69: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
+		{152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 12: 152, 152, 41: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 58: 152, 61: 152, 152, 69: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
+		{59: 204, 101: 204, 103: 204, 204, 204, 204, 204, 204},
+		{2: 207, 10: 207},
+		{13: 610},
 		// 375
-		{4: 431, 6: 432, 11: 434, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 433, 60: 435, 101: 430, 122: 604},
-		{199, 12: 199, 199},
-		{2: 428, 11: 427, 119: 603, 426, 123: 606},
-		{12: 200, 200},
-		{2: 205, 10: 205},
+		{12: 608, 203},
+		{12: 202, 202},
+		{4: 432, 6: 433, 11: 435, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 434, 60: 436, 101: 431, 122: 607},
+		{200, 12: 200, 200},
+		{2: 429, 11: 428, 119: 606, 427, 123: 609},
 		// 380
-		{2: 208},
-		{2: 428, 11: 427, 119: 603, 426, 123: 602, 142: 601, 610},
-		{13: 611},
-		{11: 612},
-		{2: 207},
+		{12: 201, 201},
+		{2: 206, 10: 206},
+		{2: 209},
+		{2: 429, 11: 428, 119: 606, 427, 123: 605, 142: 604, 613},
+		{13: 614},
 		// 385
-		{614},
-		{59: 209, 101: 209, 103: 209, 209, 209, 209, 209, 209},
-		{616},
+		{11: 615},
+		{2: 208},
+		{617},
 		{59: 210, 101: 210, 103: 210, 210, 210, 210, 210, 210},
-		{154: 618},
+		{619},
 		// 390
-		{10: 620, 155: 619},
-		{59: 215, 101: 215, 103: 215, 215, 215, 215, 215, 215},
-		{2: 428, 9: 621, 11: 427, 119: 603, 426, 123: 623, 149: 622},
-		{629},
-		{2: 428, 9: 625, 11: 427, 119: 603, 426, 123: 626},
+		{59: 211, 101: 211, 103: 211, 211, 211, 211, 211, 211},
+		{155: 621},
+		{10: 623, 156: 622},
+		{59: 216, 101: 216, 103: 216, 216, 216, 216, 216, 216},
+		{2: 429, 9: 624, 11: 428, 119: 606, 427, 123: 626, 149: 625},
 		// 395
-		{624},
-		{2: 212, 9: 212, 11: 212},
-		{628},
+		{632},
+		{2: 429, 9: 628, 11: 428, 119: 606, 427, 123: 629},
 		{627},
-		{2: 211, 9: 211, 11: 211},
+		{2: 213, 9: 213, 11: 213},
+		{631},
 		// 400
-		{59: 213, 101: 213, 103: 213, 213, 213, 213, 213, 213},
+		{630},
+		{2: 212, 9: 212, 11: 212},
 		{59: 214, 101: 214, 103: 214, 214, 214, 214, 214, 214},
-		{4: 431, 6: 432, 11: 434, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 57: 433, 60: 435, 101: 430, 122: 631},
-		{632, 58: 633},
-		{59: 217, 101: 217, 103: 217, 217, 217, 217, 217, 217},
+		{59: 215, 101: 215, 103: 215, 215, 215, 215, 215, 215},
+		{4: 432, 6: 433, 11: 435, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 57: 434, 60: 436, 101: 431, 122: 634},
 		// 405
-		{1: 288, 279, 291, 289, 290, 263, 286, 285, 11: 329, 14: 250, 251, 253, 254, 256, 257, 258, 255, 252, 260, 261, 262, 259, 271, 267, 268, 269, 278, 277, 266, 272, 292, 270, 273, 275, 276, 274, 57: 284, 60: 264, 63: 281, 280, 283, 282, 293, 287, 81: 294, 295, 296, 93: 297, 298, 299, 300, 301, 303, 302, 440, 102: 304, 140: 634},
-		{635},
-		{59: 216, 101: 216, 103: 216, 216, 216, 216, 216, 216},
+		{635, 58: 636},
 		{59: 218, 101: 218, 103: 218, 218, 218, 218, 218, 218},
-		{3: 135, 27: 135, 59: 135, 101: 135, 103: 135, 135, 135, 135, 135, 135},
+		{1: 289, 280, 292, 290, 291, 264, 287, 286, 11: 330, 14: 251, 252, 254, 255, 257, 258, 259, 256, 253, 261, 262, 263, 260, 272, 268, 269, 270, 279, 278, 267, 273, 293, 271, 274, 276, 277, 275, 57: 285, 60: 265, 63: 282, 281, 284, 283, 294, 288, 81: 295, 296, 297, 93: 298, 299, 300, 301, 302, 304, 303, 441, 102: 305, 140: 637},
+		{638},
+		{59: 217, 101: 217, 103: 217, 217, 217, 217, 217, 217},
 		// 410
-		{27: 639},
-		{3: 134, 27: 134, 59: 134, 101: 134, 103: 134, 134, 134, 134, 134, 134},
-		{3: 638, 27: 637, 131: 641},
 		{59: 219, 101: 219, 103: 219, 219, 219, 219, 219, 219},
-		{59: 226, 101: 226, 103: 226, 226, 226, 226, 226, 226},
+		{3: 136, 27: 136, 59: 136, 101: 136, 103: 136, 136, 136, 136, 136, 136},
+		{27: 642},
+		{3: 135, 27: 135, 59: 135, 101: 135, 103: 135, 135, 135, 135, 135, 135},
+		{3: 641, 27: 640, 131: 644},
+		// 415
+		{59: 220, 101: 220, 103: 220, 220, 220, 220, 220, 220},
+		{59: 227, 101: 227, 103: 227, 227, 227, 227, 227, 227},
 	}
 )
 
@@ -1451,7 +1458,7 @@ func yylex1(yylex yyLexer, lval *yySymType) (n int) {
 }
 
 func yyParse(yylex yyLexer) int {
-	const yyError = 177
+	const yyError = 178
 
 	yyEx, _ := yylex.(yyLexerEx)
 	var yyn int
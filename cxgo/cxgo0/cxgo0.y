@@ -97,7 +97,7 @@
                         I8 I16 I32 I64
                         STR
                         UI8 UI16 UI32 UI64
-                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE
+                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE RANGE
                         TYPE
                         
                         /* Types */
@@ -664,6 +664,7 @@ assignment_expression:
                 /* conditional_expression */
                 struct_literal_expression
 	|       unary_expression assignment_operator assignment_expression
+	|       unary_expression CASSIGN RANGE conditional_expression
                 ;
 
 assignment_operator:
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 
@@ -198,6 +199,8 @@ redonext:
 		s.stdString()
 	case '`':
 		s.rawString()
+	case '\'':
+		s.runeLiteral()
 	case '(':
 		s.nextch()
 		s.tok.yys = LPAREN
@@ -447,6 +450,7 @@ var keywordMap map[string]int = map[string]int{
 	"i16":       I16,
 	"ui16":      UI16,
 	"i32":       I32,
+	"rune":      I32,
 	"ui32":      UI32,
 	"f32":       F32,
 	"i64":       I64,
@@ -462,6 +466,7 @@ var keywordMap map[string]int = map[string]int{
 	"switch":    SWITCH,
 	"break":     BREAK,
 	"continue":  CONTINUE,
+	"range":     RANGE,
 	"type":      TYPE,
 	":dl":       DSTATE,
 	":dLocals":  DSTATE,
@@ -880,6 +885,38 @@ func (s *Lexer) stdString() {
 	s.nlsemi = true
 }
 
+// runeLiteral scans a rune literal, such as 'a', '\n' or '\u00e9', which is
+// an i32 literal with the value of its code point.
+func (s *Lexer) runeLiteral() {
+	s.nextch()
+
+	for {
+		if s.ch == '\'' {
+			s.nextch()
+			break
+		}
+		if s.ch == '\\' {
+			s.nextch()
+			s.escape('\'')
+			continue
+		}
+		if s.ch == '\n' || s.ch < 0 {
+			s.errorf("rune literal not terminated")
+			break
+		}
+		s.nextch()
+	}
+
+	lit := string(s.segment())
+	s.tok.yys = INT_LITERAL
+	r, _, tail, err := strconv.UnquoteChar(strings.TrimSuffix(strings.TrimPrefix(lit, "'"), "'"), '\'')
+	if err != nil || tail != "" || len(lit) < 3 {
+		s.errorf("invalid rune literal: " + lit)
+	}
+	s.tok.i32 = int32(r)
+	s.nlsemi = true
+}
+
 func (s *Lexer) escape(quote rune) bool {
 	var n int
 	var base, max uint32
@@ -223,8 +223,13 @@ func idGlobVars(filename string, r io.Reader, prePkg **cxcore.CXPackage) {
 
 			if match := re.impName.FindStringSubmatch(string(line)); match != nil {
 				pkgName := match[len(match)-1]
+				// A package of the workspace shadows the standard library package with the same name.
+				isCore := cxcore.IsCorePackage(pkgName)
+				if fi, err := os.Stat(filepath.Join(cxcore.SRCPATH, pkgName)); err == nil && fi.IsDir() {
+					isCore = false
+				}
 				// Checking if `pkgName` already exists and if it's not a standard library package.
-				if _, err := cxgo0.PRGRM0.GetPackage(pkgName); err != nil && !cxcore.IsCorePackage(pkgName) {
+				if _, err := cxgo0.PRGRM0.GetPackage(pkgName); err != nil && !isCore {
 					// _, sourceCode, srcNames := ParseArgsForCX([]string{fmt.Sprintf("%s%s", SRCPATH, pkgName)}, false)
 					_, sourceCode, fileNames := cxcore.ParseArgsForCX([]string{filepath.Join(cxcore.SRCPATH, pkgName)}, false)
 					ParseSourceCode(sourceCode, fileNames) // TODO @evanlinjin: Check return value.
@@ -0,0 +1,91 @@
+package cxmod
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// HashDir returns the content hash of the module in directory `dir`: the
+// base64 SHA-256 of the sorted list of the SHA-256 and the slash-separated
+// name of each file, prefixed with "h1:". Hidden directories are skipped.
+func HashDir(dir string) (string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, file := range files {
+		data, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%x  %s\n", sha256.Sum256(data), file)
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// CacheDir returns the directory of version `version` of module `path` in
+// the module cache `cache`.
+func CacheDir(cache, path, version string) string {
+	return filepath.Join(cache, filepath.FromSlash(path)+"@"+version)
+}
+
+// Download makes sure that version `version` of module `path` is in the
+// module cache `cache`, by cloning the tag `version` of the git repository
+// https://<path>, and returns its directory. Local mirrors can be used with
+// git's `url.<base>.insteadOf` setting.
+func Download(cache, path, version string) (string, error) {
+	dir := CacheDir(cache, path, version)
+	if _, err := os.Stat(dir); err == nil {
+		return dir, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return "", err
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), ".download")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	repo := filepath.Join(tmp, "repo")
+	cmd := exec.Command("git", "clone", "--quiet", "--depth", "1", "--branch", version, "https://"+path, repo)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("downloading %s %s: %v\n%s", path, version, err, strings.TrimSpace(string(out)))
+	}
+	if err := os.RemoveAll(filepath.Join(repo, ".git")); err != nil {
+		return "", err
+	}
+	if err := os.Rename(repo, dir); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
@@ -0,0 +1,339 @@
+package cxmod
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Init creates the manifest of module `modPath` in directory `root`.
+func Init(root, modPath string) error {
+	fileName := filepath.Join(root, ModFileName)
+	if _, err := os.Stat(fileName); err == nil {
+		return fmt.Errorf("%s already exists", fileName)
+	}
+	f := &File{Module: modPath}
+	return ioutil.WriteFile(fileName, f.Format(), 0644)
+}
+
+var reImport = regexp.MustCompile(`(^|\s)import\s+"([^"]+)"`)
+
+// scanImports returns the paths imported by the CX files of directory `dir`.
+// If `recursive` is set, the subdirectories are scanned too, except for
+// hidden ones, vendor directories and nested modules.
+func scanImports(dir string, recursive bool) ([]string, error) {
+	var imports []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path == dir {
+				return nil
+			}
+			if !recursive || strings.HasPrefix(info.Name(), ".") || info.Name() == VendorDir {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, ModFileName)); err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".cx" {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if i := strings.Index(line, "//"); i >= 0 {
+				line = line[:i]
+			}
+			if match := reImport.FindStringSubmatch(line); match != nil {
+				imports = append(imports, match[2])
+			}
+		}
+		return scanner.Err()
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return imports, err
+}
+
+// LatestVersion returns the greatest version tagged in the git repository of
+// module `path`.
+func LatestVersion(path string) (string, error) {
+	cmd := exec.Command("git", "ls-remote", "--tags", "https://"+path)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("listing the versions of %s: %v", path, err)
+	}
+
+	latest := ""
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "refs/tags/") {
+			continue
+		}
+		tag := strings.TrimSuffix(strings.TrimPrefix(fields[1], "refs/tags/"), "^{}")
+		if IsValidVersion(tag) && (latest == "" || CompareVersions(tag, latest) > 0) {
+			latest = tag
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("%s has no version tags", path)
+	}
+	return latest, nil
+}
+
+// findModule looks for the module providing package `importPath` among its
+// path prefixes, and returns its path and latest version.
+func findModule(importPath string) (string, string, error) {
+	for path := importPath; ; {
+		if version, err := LatestVersion(path); err == nil {
+			return path, version, nil
+		}
+		i := strings.LastIndexByte(path, '/')
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return "", "", fmt.Errorf("no module provides package %s", importPath)
+}
+
+// Tidy updates the manifest and the lock file of the module in directory
+// `root` to require exactly the modules that its packages use. Imports
+// without a requirement are added at their latest version, unless
+// `external` reports that they're resolved without modules, like the core
+// packages. The requirements of the required modules are added as indirect
+// ones, and the greatest required version of each module is selected.
+func Tidy(root, cache string, external func(importPath string) bool) error {
+	l, err := newLoader(root, cache, false)
+	if err != nil {
+		return err
+	}
+
+	imports, err := scanImports(root, true)
+	if err != nil {
+		return err
+	}
+	direct := make(map[string]bool)
+	for _, imp := range imports {
+		if _, ok := providesPackage(l.Mod.Module, imp); ok {
+			continue
+		}
+		if req, _, ok := l.module(imp); ok {
+			direct[req.Path] = true
+			continue
+		}
+		if external(imp) {
+			continue
+		}
+		path, version, err := findModule(imp)
+		if err != nil {
+			return err
+		}
+		l.Mod.Require = append(l.Mod.Require, &Requirement{Path: path, Version: version})
+		l.Mod.sortRequirements()
+		direct[path] = true
+	}
+
+	// Selecting the versions of the modules required by the direct ones.
+	versions := make(map[string]string)
+	var queue []string
+	for path := range direct {
+		versions[path] = l.Mod.Requirement(path).Version
+		queue = append(queue, path)
+	}
+	sort.Strings(queue)
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+
+		dir, err := l.tidyModuleDir(&Requirement{Path: path, Version: versions[path]})
+		if err != nil {
+			return err
+		}
+		mod, err := ReadModFile(filepath.Join(dir, ModFileName))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, req := range mod.Require {
+			version, ok := versions[req.Path]
+			if !ok {
+				version = req.Version
+				if own := l.Mod.Requirement(req.Path); own != nil && CompareVersions(own.Version, version) > 0 {
+					version = own.Version
+				}
+			} else if CompareVersions(req.Version, version) > 0 {
+				version = req.Version
+			} else {
+				continue
+			}
+			versions[req.Path] = version
+			queue = append(queue, req.Path)
+		}
+	}
+
+	require := make([]*Requirement, 0, len(versions))
+	sum := make(Sum)
+	for path, version := range versions {
+		req := &Requirement{Path: path, Version: version, Indirect: !direct[path]}
+		require = append(require, req)
+		if l.Mod.Replacement(path) != nil {
+			continue
+		}
+		hash, err := HashDir(CacheDir(cache, path, version))
+		if err != nil {
+			return err
+		}
+		sum[sumKey(path, version)] = hash
+	}
+	l.Mod.Require = require
+	l.Mod.sortRequirements()
+
+	if err := ioutil.WriteFile(filepath.Join(root, ModFileName), l.Mod.Format(), 0644); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(root, SumFileName), sum.Format(), 0644)
+}
+
+// tidyModuleDir returns the directory of the module `req`, downloading it if
+// needed, without checking the lock file, which is being rewritten.
+func (l *Loader) tidyModuleDir(req *Requirement) (string, error) {
+	if repl := l.Mod.Replacement(req.Path); repl != nil {
+		return l.replacementDir(repl), nil
+	}
+	return Download(l.Cache, req.Path, req.Version)
+}
+
+// Vendor copies the modules required by the module in directory `root` to
+// its vendor directory, which is then used instead of the module cache.
+func Vendor(root, cache string) error {
+	l, err := newLoader(root, cache, false)
+	if err != nil {
+		return err
+	}
+
+	vendor := filepath.Join(root, VendorDir)
+	if err := os.RemoveAll(vendor); err != nil {
+		return err
+	}
+	if len(l.Mod.Require) == 0 {
+		return nil
+	}
+
+	var list bytes.Buffer
+	for _, req := range l.Mod.Require {
+		dir, err := l.ModuleDir(req)
+		if err != nil {
+			return err
+		}
+		if err := copyDir(dir, filepath.Join(vendor, filepath.FromSlash(req.Path))); err != nil {
+			return err
+		}
+		fmt.Fprintf(&list, "# %s %s\n", req.Path, req.Version)
+	}
+	return ioutil.WriteFile(filepath.Join(vendor, vendorListName), list.Bytes(), 0644)
+}
+
+// copyDir copies the files of directory `src` to directory `dst`, skipping
+// hidden directories.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			if path != src && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(target, 0755)
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(target, data, 0644)
+	})
+}
+
+// Verify checks that the modules required by the module in directory `root`
+// weren't modified since they were downloaded or vendored, by comparing
+// their hashes with the lock file. It returns the number of verified modules
+// and an error for each mismatch.
+func Verify(root, cache string) (int, []error) {
+	l, err := newLoader(root, cache, false)
+	if err != nil {
+		return 0, []error{err}
+	}
+	vendored, err := readVendorList(filepath.Join(root, VendorDir, vendorListName))
+	if err != nil {
+		return 0, []error{err}
+	}
+
+	verified := 0
+	var errs []error
+	check := func(req *Requirement, dir, want string) bool {
+		got, err := HashDir(dir)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		if got != want {
+			errs = append(errs, fmt.Errorf("%s %s: %s was modified\n\thash: %s\n\t%s: %s", req.Path, req.Version, dir, got, SumFileName, want))
+			return false
+		}
+		return true
+	}
+
+	for _, req := range l.Mod.Require {
+		if l.Mod.Replacement(req.Path) != nil {
+			continue
+		}
+		want, ok := l.Sum.Hash(req.Path, req.Version)
+		if !ok {
+			errs = append(errs, fmt.Errorf("missing %s entry for %s %s, run 'cx mod tidy'", SumFileName, req.Path, req.Version))
+			continue
+		}
+		ok = true
+		if dir := CacheDir(cache, req.Path, req.Version); dirExists(dir) {
+			ok = check(req, dir, want) && ok
+		}
+		if vendored[req.Path] == req.Version {
+			ok = check(req, filepath.Join(root, VendorDir, filepath.FromSlash(req.Path)), want) && ok
+		}
+		if ok {
+			verified++
+		}
+	}
+	return verified, errs
+}
+
+func dirExists(dir string) bool {
+	fi, err := os.Stat(dir)
+	return err == nil && fi.IsDir()
+}
@@ -0,0 +1,204 @@
+package cxmod
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// VendorDir is the directory of a module where `cx mod vendor` copies the
+// required modules.
+const VendorDir = "vendor"
+
+// vendorListName is the name of the file of VendorDir listing the versions of
+// the vendored modules.
+const vendorListName = "modules.txt"
+
+// FindRoot returns the root of the module containing directory `dir`, which
+// is the closest directory with a manifest, walking up from `dir`.
+func FindRoot(dir string) (string, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	for {
+		if fi, err := os.Stat(filepath.Join(dir, ModFileName)); err == nil && fi.Mode().IsRegular() {
+			return dir, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// Loader finds the directories of the packages imported by the packages of a
+// module, the main module, and of the modules that it requires. The main
+// module decides the version of every module, the requirements of the other
+// modules only count when running `cx mod tidy`.
+type Loader struct {
+	Root  string // root directory of the main module
+	Mod   *File  // manifest of the main module
+	Sum   Sum    // lock file of the main module
+	Cache string // module cache directory
+
+	vendored map[string]string // versions of the vendored modules, or nil
+	verified map[string]bool   // modules of the cache whose hashes were checked
+}
+
+// NewLoader reads the manifest and lock file of the main module in directory
+// `root`. The modules are downloaded to the module cache `cache`, unless the
+// main module has a vendor directory.
+func NewLoader(root, cache string) (*Loader, error) {
+	return newLoader(root, cache, true)
+}
+
+// newLoader is NewLoader, ignoring the vendor directory if `useVendor` isn't
+// set.
+func newLoader(root, cache string, useVendor bool) (*Loader, error) {
+	mod, err := ReadModFile(filepath.Join(root, ModFileName))
+	if err != nil {
+		return nil, err
+	}
+	sum, err := ReadSumFile(filepath.Join(root, SumFileName))
+	if err != nil {
+		return nil, err
+	}
+	var vendored map[string]string
+	if useVendor {
+		vendored, err = readVendorList(filepath.Join(root, VendorDir, vendorListName))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	l := &Loader{
+		Root:     root,
+		Mod:      mod,
+		Sum:      sum,
+		Cache:    cache,
+		vendored: vendored,
+		verified: make(map[string]bool),
+	}
+	if vendored != nil {
+		if err := l.checkVendor(); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// readVendorList reads the "# <path> <version>" lines of the list of vendored
+// modules `fileName`. It returns nil if the list doesn't exist.
+func readVendorList(fileName string) (map[string]string, error) {
+	file, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	vendored := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 3 && fields[0] == "#" {
+			vendored[fields[1]] = fields[2]
+		}
+	}
+	return vendored, scanner.Err()
+}
+
+// checkVendor checks that the vendor directory holds the required versions.
+func (l *Loader) checkVendor() error {
+	for _, req := range l.Mod.Require {
+		if l.vendored[req.Path] != req.Version {
+			return fmt.Errorf("%s: %s %s is not vendored, run 'cx mod vendor'", l.Root, req.Path, req.Version)
+		}
+	}
+	for path := range l.vendored {
+		if l.Mod.Requirement(path) == nil {
+			return fmt.Errorf("%s: %s is vendored but not required, run 'cx mod vendor'", l.Root, path)
+		}
+	}
+	return nil
+}
+
+// module returns the required module providing package `importPath` and the
+// directory of the package relative to the module. If several modules
+// provide the package, the one with the longest path wins.
+func (l *Loader) module(importPath string) (*Requirement, string, bool) {
+	var found *Requirement
+	var foundRel string
+	for _, req := range l.Mod.Require {
+		if rel, ok := providesPackage(req.Path, importPath); ok {
+			if found == nil || len(req.Path) > len(found.Path) {
+				found, foundRel = req, rel
+			}
+		}
+	}
+	return found, foundRel, found != nil
+}
+
+// Dir returns the directory of the package imported as `importPath`. It
+// returns false if neither the main module nor its requirements provide the
+// package, and the package must be looked for elsewhere.
+func (l *Loader) Dir(importPath string) (string, bool, error) {
+	if rel, ok := providesPackage(l.Mod.Module, importPath); ok {
+		return filepath.Join(l.Root, filepath.FromSlash(rel)), true, nil
+	}
+
+	req, rel, ok := l.module(importPath)
+	if !ok {
+		return "", false, nil
+	}
+	dir, err := l.ModuleDir(req)
+	if err != nil {
+		return "", true, err
+	}
+	return filepath.Join(dir, filepath.FromSlash(rel)), true, nil
+}
+
+// ModuleDir returns the directory of the required module `req`, which is
+// replaced, vendored or in the module cache. Modules are downloaded into the
+// cache if needed, and their hashes are checked against the lock file.
+func (l *Loader) ModuleDir(req *Requirement) (string, error) {
+	if repl := l.Mod.Replacement(req.Path); repl != nil {
+		return l.replacementDir(repl), nil
+	}
+	if l.vendored != nil {
+		return filepath.Join(l.Root, VendorDir, filepath.FromSlash(req.Path)), nil
+	}
+
+	hash, ok := l.Sum.Hash(req.Path, req.Version)
+	if !ok {
+		return "", fmt.Errorf("missing %s entry for %s %s, run 'cx mod tidy'", SumFileName, req.Path, req.Version)
+	}
+	dir, err := Download(l.Cache, req.Path, req.Version)
+	if err != nil {
+		return "", err
+	}
+	if !l.verified[dir] {
+		got, err := HashDir(dir)
+		if err != nil {
+			return "", err
+		}
+		if got != hash {
+			return "", fmt.Errorf("checksum mismatch for %s %s\n\tdownloaded: %s\n\t%s: %s", req.Path, req.Version, got, SumFileName, hash)
+		}
+		l.verified[dir] = true
+	}
+	return dir, nil
+}
+
+func (l *Loader) replacementDir(repl *Replacement) string {
+	if filepath.IsAbs(repl.Dir) {
+		return repl.Dir
+	}
+	return filepath.Join(l.Root, filepath.FromSlash(repl.Dir))
+}
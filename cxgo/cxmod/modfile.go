@@ -0,0 +1,213 @@
+// Package cxmod implements CX modules: a directory tree of CX packages with
+// a `cx.mod` manifest at its root, which declares the path of the module and
+// the versions of the modules that it depends on:
+//
+//	module example.com/app
+//
+//	require (
+//		example.com/greet v1.2.0
+//		example.com/strutil v0.3.1 // indirect
+//	)
+//
+//	replace example.com/greet => ../greet
+//
+// The `cx.sum` file next to the manifest locks the content hash of every
+// required module. The modules are downloaded by cloning their git
+// repositories into the module cache at $CXPATH/pkg/mod/<path>@<version>, or
+// are copied to the `vendor` directory of the module by `cx mod vendor`.
+// Every module resolves its imports with its own manifest, so two modules
+// can depend on different versions of the same module.
+package cxmod
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// ModFileName is the name of the manifest of a module.
+const ModFileName = "cx.mod"
+
+// File is a parsed `cx.mod` manifest.
+type File struct {
+	Module  string         // path of the module
+	Require []*Requirement // required modules, sorted by path
+	Replace []*Replacement // modules replaced by local directories
+}
+
+// Requirement is a module required at a version.
+type Requirement struct {
+	Path     string
+	Version  string
+	Indirect bool // only required by other requirements
+}
+
+// Replacement makes a module be loaded from a local directory, relative to
+// the root of the module, instead of a downloaded version.
+type Replacement struct {
+	Path string
+	Dir  string
+}
+
+// ReadModFile reads and parses the manifest `fileName`.
+func ReadModFile(fileName string) (*File, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModFile(fileName, data)
+}
+
+// ParseModFile parses `data`, the content of the manifest `fileName`.
+func ParseModFile(fileName string, data []byte) (*File, error) {
+	f := &File{}
+	inRequire := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		indirect := false
+		if i := strings.Index(line, "//"); i >= 0 {
+			indirect = strings.TrimSpace(line[i+2:]) == "indirect"
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		errorf := func(format string, args ...interface{}) error {
+			return fmt.Errorf("%s:%d: %s", fileName, lineNo, fmt.Sprintf(format, args...))
+		}
+
+		if inRequire {
+			if fields[0] == ")" {
+				inRequire = false
+				continue
+			}
+			if len(fields) != 2 {
+				return nil, errorf("expected module path and version")
+			}
+			if err := f.addRequirement(fields[0], fields[1], indirect); err != nil {
+				return nil, errorf("%v", err)
+			}
+			continue
+		}
+
+		switch fields[0] {
+		case "module":
+			if len(fields) != 2 {
+				return nil, errorf("expected module path")
+			}
+			if f.Module != "" {
+				return nil, errorf("repeated module statement")
+			}
+			f.Module = fields[1]
+		case "require":
+			if len(fields) == 2 && fields[1] == "(" {
+				inRequire = true
+				continue
+			}
+			if len(fields) != 3 {
+				return nil, errorf("expected module path and version")
+			}
+			if err := f.addRequirement(fields[1], fields[2], indirect); err != nil {
+				return nil, errorf("%v", err)
+			}
+		case "replace":
+			if len(fields) != 4 || fields[2] != "=>" {
+				return nil, errorf("expected 'replace <module path> => <directory>'")
+			}
+			f.Replace = append(f.Replace, &Replacement{Path: fields[1], Dir: fields[3]})
+		default:
+			return nil, errorf("unknown directive '%s'", fields[0])
+		}
+	}
+	if inRequire {
+		return nil, fmt.Errorf("%s: unterminated require block", fileName)
+	}
+	if f.Module == "" {
+		return nil, fmt.Errorf("%s: missing module statement", fileName)
+	}
+
+	f.sortRequirements()
+	return f, nil
+}
+
+func (f *File) addRequirement(path, version string, indirect bool) error {
+	if !IsValidVersion(version) {
+		return fmt.Errorf("invalid version '%s' of module '%s'", version, path)
+	}
+	if f.Requirement(path) != nil {
+		return fmt.Errorf("module '%s' is required twice", path)
+	}
+	f.Require = append(f.Require, &Requirement{Path: path, Version: version, Indirect: indirect})
+	return nil
+}
+
+func (f *File) sortRequirements() {
+	sort.Slice(f.Require, func(i, j int) bool {
+		return f.Require[i].Path < f.Require[j].Path
+	})
+}
+
+// Requirement returns the requirement of module `path`, or nil.
+func (f *File) Requirement(path string) *Requirement {
+	for _, req := range f.Require {
+		if req.Path == path {
+			return req
+		}
+	}
+	return nil
+}
+
+// Replacement returns the replacement of module `path`, or nil.
+func (f *File) Replacement(path string) *Replacement {
+	for _, repl := range f.Replace {
+		if repl.Path == path {
+			return repl
+		}
+	}
+	return nil
+}
+
+// Format returns the content of the manifest `f`.
+func (f *File) Format() []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "module %s\n", f.Module)
+
+	if len(f.Require) > 0 {
+		buf.WriteString("\nrequire (\n")
+		for _, req := range f.Require {
+			fmt.Fprintf(&buf, "\t%s %s", req.Path, req.Version)
+			if req.Indirect {
+				buf.WriteString(" // indirect")
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(")\n")
+	}
+
+	if len(f.Replace) > 0 {
+		buf.WriteString("\n")
+		for _, repl := range f.Replace {
+			fmt.Fprintf(&buf, "replace %s => %s\n", repl.Path, repl.Dir)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// providesPackage checks if the package imported as `importPath` belongs to
+// the module `modPath`, and returns its directory relative to the module.
+func providesPackage(modPath, importPath string) (string, bool) {
+	if importPath == modPath {
+		return "", true
+	}
+	if strings.HasPrefix(importPath, modPath+"/") {
+		return importPath[len(modPath)+1:], true
+	}
+	return "", false
+}
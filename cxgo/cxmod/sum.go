@@ -0,0 +1,69 @@
+package cxmod
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+// SumFileName is the name of the lock file of a module.
+const SumFileName = "cx.sum"
+
+// Sum is a parsed `cx.sum` lock file. It maps "<path> <version>" to the
+// content hash of that version of the module (see HashDir).
+type Sum map[string]string
+
+// ReadSumFile reads and parses the lock file `fileName`. A missing lock
+// file is an empty one.
+func ReadSumFile(fileName string) (Sum, error) {
+	sum := make(Sum)
+	data, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return sum, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: expected module path, version and hash", fileName, lineNo)
+		}
+		sum[sumKey(fields[0], fields[1])] = fields[2]
+	}
+	return sum, nil
+}
+
+func sumKey(path, version string) string {
+	return path + " " + version
+}
+
+// Hash returns the locked hash of version `version` of module `path`.
+func (sum Sum) Hash(path, version string) (string, bool) {
+	hash, ok := sum[sumKey(path, version)]
+	return hash, ok
+}
+
+// Format returns the content of the lock file `sum`.
+func (sum Sum) Format() []byte {
+	keys := make([]string, 0, len(sum))
+	for key := range sum {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%s %s\n", key, sum[key])
+	}
+	return buf.Bytes()
+}
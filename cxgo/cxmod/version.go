@@ -0,0 +1,74 @@
+package cxmod
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IsValidVersion checks if `v` is a semantic version prefixed with "v", like
+// "v1.2.3" or "v0.1.0-beta.1". Versions name the git tags of the modules.
+func IsValidVersion(v string) bool {
+	_, ok := parseVersion(v)
+	return ok
+}
+
+type version struct {
+	numbers    [3]int
+	prerelease string
+}
+
+func parseVersion(v string) (version, bool) {
+	var ver version
+	if !strings.HasPrefix(v, "v") {
+		return ver, false
+	}
+	v = v[1:]
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		ver.prerelease = v[i+1:]
+		if ver.prerelease == "" {
+			return ver, false
+		}
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return ver, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || part != strconv.Itoa(n) {
+			return ver, false
+		}
+		ver.numbers[i] = n
+	}
+	return ver, true
+}
+
+// CompareVersions returns -1, 0 or 1 if the valid version `a` is lower than,
+// equal to or greater than the valid version `b`. A pre-release is lower
+// than its release.
+func CompareVersions(a, b string) int {
+	va, _ := parseVersion(a)
+	vb, _ := parseVersion(b)
+	for i := range va.numbers {
+		if va.numbers[i] != vb.numbers[i] {
+			if va.numbers[i] < vb.numbers[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case va.prerelease == vb.prerelease:
+		return 0
+	case va.prerelease == "":
+		return 1
+	case vb.prerelease == "":
+		return -1
+	case va.prerelease < vb.prerelease:
+		return -1
+	default:
+		return 1
+	}
+}
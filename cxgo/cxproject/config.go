@@ -0,0 +1,253 @@
+// Package cxproject implements the `cx.toml` configuration of CX projects
+// and the project templates of `cx new`. A configuration looks like:
+//
+//	[project]
+//	name = "hello"
+//	entry = ["main.cx"]
+//
+//	[runtime]
+//	heap-initial = "32M"
+//	stack-size = "1M"
+//
+//	[packages]
+//	enabled = ["os", "time"]
+//
+//	[test]
+//	files = ["tests/*_test.cx"]
+//	timeout = 60
+//
+// The keys of [runtime] are the names of the command-line flags that they
+// set, which override them.
+package cxproject
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ConfigFileName is the name of the configuration file of a project.
+const ConfigFileName = "cx.toml"
+
+// Config is a parsed `cx.toml` configuration.
+type Config struct {
+	Dir string // directory of the configuration file, the root of the project
+
+	Name  string
+	Entry []string // files or directories run when `cx` gets no source files
+
+	Runtime Runtime
+
+	// Core packages that the programs of the project can import. A nil
+	// slice enables all of them.
+	Packages []string
+
+	Test Test
+}
+
+// Runtime holds the default settings of the CX runtime. The zero values
+// keep the defaults of `cx`.
+type Runtime struct {
+	HeapInitial      string  // heap-initial
+	HeapMax          string  // heap-max
+	StackSize        string  // stack-size
+	CallStackMax     int     // callstack-max
+	MinHeapFreeRatio float64 // min-heap-free
+	MaxHeapFreeRatio float64 // max-heap-free
+}
+
+// Test holds the options of `cx test`.
+type Test struct {
+	Files   []string // glob patterns of the test programs
+	Timeout int      // seconds after which a test program is stopped, or 0
+}
+
+// FindConfig returns the path of the configuration of the project
+// containing directory `dir`, which is the closest configuration walking up
+// from `dir`.
+func FindConfig(dir string) (string, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	for {
+		fileName := filepath.Join(dir, ConfigFileName)
+		if fi, err := os.Stat(fileName); err == nil && fi.Mode().IsRegular() {
+			return fileName, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// ReadConfig reads and parses the configuration `fileName`.
+func ReadConfig(fileName string) (*Config, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := parseTOML(fileName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{Dir: filepath.Dir(fileName)}
+	d := decoder{fileName: fileName, tables: tables}
+	d.str("project", "name", &cfg.Name)
+	d.strs("project", "entry", &cfg.Entry)
+	d.str("runtime", "heap-initial", &cfg.Runtime.HeapInitial)
+	d.str("runtime", "heap-max", &cfg.Runtime.HeapMax)
+	d.str("runtime", "stack-size", &cfg.Runtime.StackSize)
+	d.int("runtime", "callstack-max", &cfg.Runtime.CallStackMax)
+	d.float("runtime", "min-heap-free", &cfg.Runtime.MinHeapFreeRatio)
+	d.float("runtime", "max-heap-free", &cfg.Runtime.MaxHeapFreeRatio)
+	d.strs("packages", "enabled", &cfg.Packages)
+	d.strs("test", "files", &cfg.Test.Files)
+	d.int("test", "timeout", &cfg.Test.Timeout)
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	// Checking for misspelled tables and keys.
+	known := map[string][]string{
+		"":         nil,
+		"project":  {"name", "entry"},
+		"runtime":  {"heap-initial", "heap-max", "stack-size", "callstack-max", "min-heap-free", "max-heap-free"},
+		"packages": {"enabled"},
+		"test":     {"files", "timeout"},
+	}
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		keys, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown table [%s]", fileName, name)
+		}
+		for key := range tables[name] {
+			if !contains(keys, key) {
+				return nil, fmt.Errorf("%s: unknown key '%s' in [%s]", fileName, key, name)
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// EntryPaths returns the paths of the entry files of the project.
+func (cfg *Config) EntryPaths() []string {
+	paths := make([]string, len(cfg.Entry))
+	for i, entry := range cfg.Entry {
+		paths[i] = filepath.Join(cfg.Dir, filepath.FromSlash(entry))
+	}
+	return paths
+}
+
+// TestPaths returns the paths of the test programs of the project, sorted.
+func (cfg *Config) TestPaths() ([]string, error) {
+	var paths []string
+	for _, pattern := range cfg.Test.Files {
+		matches, err := filepath.Glob(filepath.Join(cfg.Dir, filepath.FromSlash(pattern)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid test pattern '%s': %v", pattern, err)
+		}
+		for _, match := range matches {
+			if !contains(paths, match) {
+				paths = append(paths, match)
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// PackageEnabled checks if the programs of the project can import the core
+// package `name`.
+func (cfg *Config) PackageEnabled(name string) bool {
+	return cfg.Packages == nil || contains(cfg.Packages, name)
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// decoder copies the values of the parsed tables to a Config, keeping the
+// first type error.
+type decoder struct {
+	fileName string
+	tables   map[string]table
+	err      error
+}
+
+func (d *decoder) value(tbl, key string) (interface{}, bool) {
+	v, ok := d.tables[tbl][key]
+	return v, ok && d.err == nil
+}
+
+func (d *decoder) typeError(tbl, key, typ string) {
+	d.err = fmt.Errorf("%s: '%s' in [%s] must be %s", d.fileName, key, tbl, typ)
+}
+
+func (d *decoder) str(tbl, key string, dst *string) {
+	if v, ok := d.value(tbl, key); ok {
+		if s, ok := v.(string); ok {
+			*dst = s
+		} else {
+			d.typeError(tbl, key, "a string")
+		}
+	}
+}
+
+func (d *decoder) strs(tbl, key string, dst *[]string) {
+	if v, ok := d.value(tbl, key); ok {
+		list, ok := v.([]interface{})
+		if !ok {
+			d.typeError(tbl, key, "an array of strings")
+			return
+		}
+		*dst = make([]string, len(list))
+		for i, e := range list {
+			s, ok := e.(string)
+			if !ok {
+				d.typeError(tbl, key, "an array of strings")
+				return
+			}
+			(*dst)[i] = s
+		}
+	}
+}
+
+func (d *decoder) int(tbl, key string, dst *int) {
+	if v, ok := d.value(tbl, key); ok {
+		if i, ok := v.(int64); ok {
+			*dst = int(i)
+		} else {
+			d.typeError(tbl, key, "an integer")
+		}
+	}
+}
+
+func (d *decoder) float(tbl, key string, dst *float64) {
+	if v, ok := d.value(tbl, key); ok {
+		switch f := v.(type) {
+		case float64:
+			*dst = f
+		case int64:
+			*dst = float64(f)
+		default:
+			d.typeError(tbl, key, "a number")
+		}
+	}
+}
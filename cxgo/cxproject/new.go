@@ -0,0 +1,213 @@
+package cxproject
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Templates are the names of the project templates of New.
+var Templates = []string{"app", "gui", "http", "lib"}
+
+// project holds the values used by the templates.
+type project struct {
+	Path string // module path of the project
+	Name string // name of the project and of its library package
+}
+
+var reIdent = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
+// New creates a project from template `tmpl` in a new directory of `dir`.
+// `modPath` is the module path of the project, and its last element names
+// the directory and the library package of the project. It returns the
+// directory of the project.
+func New(dir, modPath, tmpl string) (string, error) {
+	files, ok := templates[tmpl]
+	if !ok {
+		return "", fmt.Errorf("unknown template '%s', expected one of %s", tmpl, strings.Join(Templates, ", "))
+	}
+	name := path.Base(modPath)
+	if !reIdent.MatchString(name) {
+		return "", fmt.Errorf("invalid project name '%s', the last element of the path must be an identifier", name)
+	}
+
+	root := filepath.Join(dir, name)
+	if _, err := os.Stat(root); err == nil {
+		return "", fmt.Errorf("%s already exists", root)
+	}
+
+	p := project{Path: modPath, Name: name}
+
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	for _, fileName := range fileNames {
+		var buf bytes.Buffer
+		if err := template.Must(template.New(fileName).Parse(files[fileName])).Execute(&buf, p); err != nil {
+			return "", err
+		}
+		fileName = strings.Replace(fileName, "NAME", name, -1)
+		fileName = filepath.Join(root, filepath.FromSlash(fileName))
+		if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+			return "", err
+		}
+		if err := ioutil.WriteFile(fileName, buf.Bytes(), 0644); err != nil {
+			return "", err
+		}
+	}
+	return root, nil
+}
+
+const modTemplate = `module {{.Path}}
+`
+
+// configTemplate is the configuration of the projects, which enables the
+// core packages `packages`.
+func configTemplate(entry, packages string) string {
+	return `[project]
+name = "{{.Name}}"
+# Files run by 'cx' when it gets no source files.
+entry = [` + entry + `]
+
+[runtime]
+# Defaults of the runtime settings, overridden by the flags of the same names.
+# heap-initial = "2M"
+# heap-max = "64M"
+# stack-size = "1M"
+# callstack-max = 1048576
+# min-heap-free = 0.4
+# max-heap-free = 0.7
+
+[packages]
+# Core packages that the programs can import.
+enabled = [` + packages + `]
+
+[test]
+# Programs run by 'cx test', which fail if they exit with an error.
+files = ["tests/*_test.cx"]
+timeout = 60
+`
+}
+
+const greetTemplate = `package greet
+
+// Hello returns the greeting of name.
+func Hello(name str) (greeting str) {
+	greeting = sprintf("Hello, %s!", name)
+}
+`
+
+const greetTestTemplate = `package main
+
+import "{{.Path}}/greet"
+
+func main() {
+	test(greet.Hello("CX"), "Hello, CX!", "greet.Hello")
+}
+`
+
+// templates are the files of each template, indexed by their paths, in
+// which NAME is replaced by the name of the project.
+var templates = map[string]map[string]string{
+	"lib": {
+		"cx.mod":  modTemplate,
+		"cx.toml": configTemplate(``, ``),
+		"NAME.cx": `package {{.Name}}
+
+// Hello returns the greeting of name.
+func Hello(name str) (greeting str) {
+	greeting = sprintf("Hello, %s!", name)
+}
+`,
+		"tests/NAME_test.cx": `package main
+
+import "{{.Path}}"
+
+func main() {
+	test({{.Name}}.Hello("CX"), "Hello, CX!", "{{.Name}}.Hello")
+}
+`,
+	},
+
+	"app": {
+		"cx.mod":              modTemplate,
+		"cx.toml":             configTemplate(`"main.cx"`, `"os"`),
+		"greet/greet.cx":      greetTemplate,
+		"tests/greet_test.cx": greetTestTemplate,
+		"main.cx": `package main
+
+import "{{.Path}}/greet"
+
+func main() {
+	str.print(greet.Hello("world"))
+}
+`,
+	},
+
+	"gui": {
+		"cx.mod":              modTemplate,
+		"cx.toml":             configTemplate(`"main.cx"`, `"gl", "glfw"`),
+		"greet/greet.cx":      greetTemplate,
+		"tests/greet_test.cx": greetTestTemplate,
+		"main.cx": `package main
+
+import "gl"
+import "glfw"
+import "{{.Path}}/greet"
+
+var width i32 = 800
+var height i32 = 600
+
+func main() {
+	glfw.Init()
+	glfw.WindowHint(glfw.Resizable, glfw.False)
+	glfw.WindowHint(glfw.ContextVersionMajor, 2)
+	glfw.WindowHint(glfw.ContextVersionMinor, 1)
+
+	glfw.CreateWindow("window", width, height, greet.Hello("world"))
+	glfw.MakeContextCurrent("window")
+	gl.Init()
+
+	for bool.not(glfw.ShouldClose("window")) {
+		gl.ClearColor(0.2, 0.3, 0.3, 1.0)
+		gl.Clear(gl.COLOR_BUFFER_BIT)
+
+		glfw.PollEvents()
+		glfw.SwapBuffers("window")
+	}
+}
+`,
+	},
+
+	"http": {
+		"cx.mod":              modTemplate,
+		"cx.toml":             configTemplate(`"main.cx"`, `"http"`),
+		"greet/greet.cx":      greetTemplate,
+		"tests/greet_test.cx": greetTestTemplate,
+		"main.cx": `package main
+
+import "http"
+import "{{.Path}}/greet"
+
+// index writes the response of the requests to "/" to w.
+func index(w str, r *http.Request) {
+	w = greet.Hello("world")
+}
+
+func main() {
+	http.Handle("/", index)
+	str.print("Listening on http://localhost:8080")
+	str.print(http.ListenAndServe(":8080"))
+}
+`,
+	},
+}
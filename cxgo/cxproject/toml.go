@@ -0,0 +1,165 @@
+package cxproject
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// table is a parsed TOML table, which maps keys to strings, int64s, float64s,
+// bools or []interface{}s of those.
+type table map[string]interface{}
+
+// parseTOML parses the subset of TOML used by the configuration files:
+// tables of keys whose values are strings, integers, floats, booleans or
+// arrays of them. The keys outside of a table are in the table "".
+func parseTOML(fileName string, data []byte) (map[string]table, error) {
+	tables := map[string]table{"": {}}
+	current := tables[""]
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		errorf := func(format string, args ...interface{}) error {
+			return fmt.Errorf("%s:%d: %s", fileName, lineNo, fmt.Sprintf(format, args...))
+		}
+
+		line := strings.TrimSpace(stripComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, errorf("expected ']'")
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, errorf("expected table name")
+			}
+			if _, ok := tables[name]; ok {
+				return nil, errorf("table '%s' is defined twice", name)
+			}
+			current = table{}
+			tables[name] = current
+			continue
+		}
+
+		eq := strings.IndexByte(line, '=')
+		if eq < 0 {
+			return nil, errorf("expected 'key = value'")
+		}
+		key := strings.TrimSpace(line[:eq])
+		text := strings.TrimSpace(line[eq+1:])
+		if key == "" || text == "" {
+			return nil, errorf("expected 'key = value'")
+		}
+		if _, ok := current[key]; ok {
+			return nil, errorf("key '%s' is defined twice", key)
+		}
+
+		// Arrays can span several lines.
+		for strings.HasPrefix(text, "[") && strings.Count(text, "[") > strings.Count(text, "]") && scanner.Scan() {
+			lineNo++
+			text += " " + strings.TrimSpace(stripComment(scanner.Text()))
+		}
+
+		value, rest, err := parseValue(text)
+		if err != nil {
+			return nil, errorf("%v", err)
+		}
+		if strings.TrimSpace(rest) != "" {
+			return nil, errorf("unexpected '%s' after value", strings.TrimSpace(rest))
+		}
+		current[key] = value
+	}
+	return tables, scanner.Err()
+}
+
+// stripComment removes the comment starting with '#' outside of a string.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// parseValue parses the value at the beginning of `text`, and returns it
+// with the rest of `text`.
+func parseValue(text string) (interface{}, string, error) {
+	text = strings.TrimLeft(text, " \t")
+	if text == "" {
+		return nil, "", fmt.Errorf("expected value")
+	}
+
+	switch text[0] {
+	case '"':
+		for i := 1; i < len(text); i++ {
+			if text[i] == '\\' {
+				i++
+			} else if text[i] == '"' {
+				s, err := strconv.Unquote(text[:i+1])
+				if err != nil {
+					return nil, "", fmt.Errorf("invalid string %s", text[:i+1])
+				}
+				return s, text[i+1:], nil
+			}
+		}
+		return nil, "", fmt.Errorf("unterminated string")
+	case '\'':
+		end := strings.IndexByte(text[1:], '\'')
+		if end < 0 {
+			return nil, "", fmt.Errorf("unterminated string")
+		}
+		return text[1 : end+1], text[end+2:], nil
+	case '[':
+		var values []interface{}
+		rest := strings.TrimLeft(text[1:], " \t")
+		for !strings.HasPrefix(rest, "]") {
+			value, r, err := parseValue(rest)
+			if err != nil {
+				return nil, "", err
+			}
+			values = append(values, value)
+			rest = strings.TrimLeft(r, " \t")
+			if strings.HasPrefix(rest, ",") {
+				rest = strings.TrimLeft(rest[1:], " \t")
+			} else if !strings.HasPrefix(rest, "]") {
+				return nil, "", fmt.Errorf("expected ',' or ']' in array")
+			}
+		}
+		return values, rest[1:], nil
+	}
+
+	end := strings.IndexAny(text, " \t,]")
+	if end < 0 {
+		end = len(text)
+	}
+	word, rest := text[:end], text[end:]
+	switch word {
+	case "true":
+		return true, rest, nil
+	case "false":
+		return false, rest, nil
+	}
+	if i, err := strconv.ParseInt(strings.Replace(word, "_", "", -1), 0, 64); err == nil {
+		return i, rest, nil
+	}
+	if f, err := strconv.ParseFloat(strings.Replace(word, "_", "", -1), 64); err == nil {
+		return f, rest, nil
+	}
+	return nil, "", fmt.Errorf("invalid value '%s'", word)
+}
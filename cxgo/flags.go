@@ -20,6 +20,7 @@ type cxCmdFlags struct {
 	initialHeap       string
 	maxHeap           string
 	stackSize         string
+	maxCallStackSize  int
 	blockchainMode    bool
 	publisherMode     bool
 	peerMode          bool
@@ -38,6 +39,20 @@ type cxCmdFlags struct {
 	minHeapFreeRatio  float64
 	maxHeapFreeRatio  float64
 	cxpath            string
+	optimizationLevel int
+
+	// Used by `cx build`
+	buildMode   bool
+	buildTarget string
+	buildOutput string
+
+	// Used by `cx mod`
+	modCommand string
+
+	// Used by `cx new` and `cx test`
+	newMode     bool
+	newTemplate string
+	testMode    bool
 
 	// Debug flags for the CX developers
 	debugLexer   bool
@@ -64,6 +79,9 @@ func defaultCmdFlags() cxCmdFlags {
 		pubKey:            "",
 		genesisAddress:    "",
 		genesisSignature:  "",
+		optimizationLevel: 1,
+		buildTarget:       "go",
+		newTemplate:       "app",
 
 		debugLexer:   false,
 		debugProfile: 0,
@@ -72,6 +90,31 @@ func defaultCmdFlags() cxCmdFlags {
 
 var commandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
 
+// optLevelFlag is a boolean flag that sets an optimization level, so
+// `-O0` and `-O1` can be used without a value.
+type optLevelFlag struct {
+	level *int
+	value int
+}
+
+func (f optLevelFlag) String() string {
+	if f.level == nil {
+		return "false"
+	}
+	return fmt.Sprint(*f.level == f.value)
+}
+
+func (f optLevelFlag) Set(s string) error {
+	if s == "true" {
+		*f.level = f.value
+	}
+	return nil
+}
+
+func (f optLevelFlag) IsBoolFlag() bool {
+	return true
+}
+
 func parseFlags(options *cxCmdFlags, args []string) {
 	if len(args) <= 0 {
 		options.replMode = true
@@ -93,10 +136,11 @@ func parseFlags(options *cxCmdFlags, args []string) {
 	commandLine.StringVar(&options.initialHeap, "hi", options.initialHeap, "alias for -initial-heap")
 	commandLine.StringVar(&options.maxHeap, "heap-max", options.maxHeap, "Set the max heap for the CX virtual machine. The value is in bytes, but the suffixes 'G', 'M' or 'K' can be used to express gigabytes, megabytes or kilobytes, respectively. Lowercase suffixes are allowed. Note that this parameter overrides --heap-initial if --heap-max is equal to a lesser value than --heap-max's.")
 	commandLine.StringVar(&options.maxHeap, "hm", options.maxHeap, "alias for -max-heap")
-	commandLine.StringVar(&options.stackSize, "stack-size", options.stackSize, "Set the stack size for the CX virtual machine. The value is in bytes, but the suffixes 'G', 'M' or 'K' can be used to express gigabytes, megabytes or kilobytes, respectively. Lowercase suffixes are allowed.")
+	commandLine.StringVar(&options.stackSize, "stack-size", options.stackSize, "Set the maximum stack size for the CX virtual machine. The stack grows on demand up to this size. The value is in bytes, but the suffixes 'G', 'M' or 'K' can be used to express gigabytes, megabytes or kilobytes, respectively. Lowercase suffixes are allowed.")
 	commandLine.StringVar(&options.stackSize, "ss", options.stackSize, "alias for -stack-size")
-	commandLine.Float64Var(&options.minHeapFreeRatio, "--min-heap-free", options.minHeapFreeRatio, "Minimum heap space percentage that should be free after calling the garbage collector. Value must be in the range of 0.0 and 1.0.")
-	commandLine.Float64Var(&options.maxHeapFreeRatio, "--max-heap-free", options.maxHeapFreeRatio, "Maximum heap space percentage that should be free after calling the garbage collector. Value must be in the range of 0.0 and 1.0.")
+	commandLine.IntVar(&options.maxCallStackSize, "callstack-max", options.maxCallStackSize, "Set the maximum number of nested function calls for the CX virtual machine. The call stack grows on demand up to this number of calls.")
+	commandLine.Float64Var(&options.minHeapFreeRatio, "min-heap-free", options.minHeapFreeRatio, "Minimum heap space percentage that should be free after calling the garbage collector. Value must be in the range of 0.0 and 1.0.")
+	commandLine.Float64Var(&options.maxHeapFreeRatio, "max-heap-free", options.maxHeapFreeRatio, "Maximum heap space percentage that should be free after calling the garbage collector. Value must be in the range of 0.0 and 1.0.")
 
 	// commandLine.BoolVar(&options.blockchainMode, "bc", options.blockchainMode, "alias for -blockchain")
 	// commandLine.BoolVar(&options.publisherMode, "pb", options.publisherMode, "alias for -publisher")
@@ -104,6 +148,12 @@ func parseFlags(options *cxCmdFlags, args []string) {
 	commandLine.BoolVar(&options.broadcastMode, "broadcast", options.broadcastMode, "Broadcast a CX blockchain transaction")
 	commandLine.BoolVar(&options.walletMode, "create-wallet", options.walletMode, "Create a wallet from a seed")
 	commandLine.StringVar(&options.cxpath, "cxpath", options.cxpath, "Used for dynamically setting the value of the environment variable CXPATH")
+	commandLine.Var(optLevelFlag{&options.optimizationLevel, 0}, "O0", "Disable the optimizer")
+	commandLine.Var(optLevelFlag{&options.optimizationLevel, 1}, "O1", "Enable constant folding, temporaries forwarding, dead code elimination, inlining and tail calls (default)")
+
+	commandLine.StringVar(&options.buildTarget, "target", options.buildTarget, "Kind of package generated by `cx build`. The only target is \"go\" (or its former name \"bundle\"), a Go module that makes the compiled program and runs it with the CX runtime")
+	commandLine.StringVar(&options.newTemplate, "template", options.newTemplate, "Template of the project created by `cx new`: app, gui, http or lib")
+	commandLine.StringVar(&options.buildOutput, "o", options.buildOutput, "Directory where `cx build` writes the generated package. Defaults to the name of the first source file followed by \"_go\"")
 
 	//deprecated
 
@@ -133,15 +183,46 @@ func parseFlags(options *cxCmdFlags, args []string) {
 
 func printHelp() {
 	fmt.Printf(`Usage: cx [options] [source-files]
+       cx build [--target=go] [-o output-dir] [options] [source-files]
+       cx mod <init [module-path]|tidy|vendor|verify>
+       cx new <name> [--template app|gui|http|lib]
+       cx test [options]
 
 CX options:
 -h, --help                        Prints this message.
--n, --new                         Creates a new project located at $CXPATH/src
 -r, --repl                        Loads source files into memory and starts a read-eval-print loop.
 -w, --web                         Start CX as a web service.
+-O0, -O1                          Disable or enable (default) the optimizer.
+
+Build options:
+--target                          Kind of the generated package. Only "go" is supported.
+-o                                Directory of the generated package.
+
+Project commands:
+cx new <name>                     Creates a project in the directory <name>. The name can be
+                                  a module path, like example.com/hello.
+--template                        Template of the project: app (default), gui, http or lib.
+cx test                           Runs the test programs of the project, listed in cx.toml.
+
+Module commands:
+cx mod init [module-path]         Creates a cx.mod manifest in the current directory.
+cx mod tidy                       Requires the modules imported by the module and updates cx.sum.
+cx mod vendor                     Copies the required modules to the vendor directory.
+cx mod verify                     Checks the downloaded and vendored modules against cx.sum.
 
 Notes:
 * Option --web makes every other flag to be ignored.
+* The package generated by 'cx build' is a Go module that makes the program
+  as cx compiled it and runs the functions that it could translate to Go
+  natively. It requires the version of github.com/skycoin/cx of cx: run
+  'go mod tidy' in it, then build it with 'go build -tags base', like cx.
+* The configuration of a project, cx.toml, declares its entry files, which
+  'cx' runs when it gets no source files, the defaults of the runtime
+  settings, the core packages that it can import and the options of 'cx test'.
+* The imports of a program inside a module, a directory tree with a cx.mod
+  manifest at its root, are resolved with the manifest. The required modules
+  are cloned from https://<module-path> into $CXPATH/pkg/mod, unless the
+  module has a vendor directory.
 `)
 }
 
@@ -151,7 +232,7 @@ func printVersion() {
 
 func checkhelp(args []string) bool {
 
-	if strings.Contains(args[0], "help") {
+	if len(args) > 0 && strings.Contains(args[0], "help") {
 		return true
 	}
 	return false
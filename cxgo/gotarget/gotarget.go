@@ -0,0 +1,505 @@
+// Package gotarget generates a Go package from a compiled CX program, for
+// `cx build --target=go`.
+//
+// The generated package makes the packages, structs, functions, expressions
+// and arguments of the program, and its data segment, as they were compiled
+// (see program.go), so it doesn't parse any CX source code. Every CX
+// function is also translated to a Go function that runs on the memory of
+// the CX runtime (see cxcore.GoFunction) and that is bound to the compiled
+// CX function. The expressions that only read and write values of basic
+// types, like arithmetic, comparisons, casts and copies, are translated to
+// Go code, and jumps are translated to gotos. The rest of the expressions are
+// run by the runtime, which also runs the natives of the `cx/base` package
+// that the generated package links against.
+//
+// The generated package is a Go module that requires the version of
+// github.com/skycoin/cx that generated it.
+package gotarget
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	cxcore "github.com/skycoin/cx/cx"
+)
+
+// Config holds the settings of the CX runtime that are embedded in the
+// generated program.
+type Config struct {
+	OptimizationLevel int
+	StackSize         int
+	InitHeapSize      int
+	MaxHeapSize       int
+	MaxCallStackSize  int
+	MinHeapFreeRatio  float32
+	MaxHeapFreeRatio  float32
+
+	// Dir is the directory from which the names of the source files are
+	// made relative, so the generated code doesn't depend on where the
+	// program was compiled.
+	Dir string
+
+	Module    string // module path of the generated package
+	CXVersion string // version of github.com/skycoin/cx required by the module
+}
+
+// Generate translates `prgrm`, which must have been compiled with the
+// settings of `cfg`, to a Go module with a main package. It returns the
+// contents of the files of the module indexed by their names.
+func Generate(prgrm *cxcore.CXProgram, cfg Config) (map[string][]byte, error) {
+	if _, err := prgrm.GetFunction(cxcore.MAIN_FUNC, cxcore.MAIN_PKG); err != nil {
+		return nil, errors.New("the program doesn't have a main function")
+	}
+
+	// the translation depends on the operands and tail calls resolved by Lower
+	prgrm.SelectProgram()
+	prgrm.Lower()
+
+	g := &generator{stackSize: prgrm.StackSize}
+	g.header(cfg)
+	for _, pkg := range prgrm.Packages {
+		for _, strct := range pkg.Structs {
+			g.structComment(strct)
+		}
+		for _, fn := range pkg.Functions {
+			if !fn.IsNative && len(fn.Expressions) > 0 {
+				g.function(fn)
+			}
+		}
+	}
+	g.bindings()
+
+	main, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	program, err := format.Source(programFile(prgrm, cfg.Dir))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		"go.mod":     goMod(cfg),
+		"main.go":    main,
+		"program.go": program,
+		"base.go":    []byte(tagFile("base", "github.com/skycoin/cx/cx/base")),
+		"cxfx.go":    []byte(tagFile("cxfx", "github.com/skycoin/cx/cxfx")),
+	}, nil
+}
+
+// goMod returns the manifest of the generated module.
+func goMod(cfg Config) []byte {
+	return []byte(fmt.Sprintf("module %s\n\ngo 1.14\n\nrequire github.com/skycoin/cx %s\n", cfg.Module, cfg.CXVersion))
+}
+
+// tagFile returns a file that imports the natives of package `path` when
+// the generated program is built with the tag `tag`, like the `cx` binary.
+func tagFile(tag, path string) string {
+	return fmt.Sprintf("// Code generated by cx build. DO NOT EDIT.\n\n// +build %s\n\npackage main\n\nimport _ %q\n", tag, path)
+}
+
+// relativeFileName returns the name of the source file `name` relative to
+// `dir`. The files outside of `dir`, like the ones of the packages in
+// CXPATH, are named by their base name.
+func relativeFileName(name, dir string) string {
+	if !filepath.IsAbs(name) {
+		return filepath.ToSlash(name)
+	}
+	if rel, err := filepath.Rel(dir, name); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.Base(name)
+}
+
+// goFunction is a CX function translated to the Go function `id`.
+type goFunction struct {
+	fn *cxcore.CXFunction
+	id string
+}
+
+type generator struct {
+	buf       bytes.Buffer
+	stackSize int
+	dir       string
+	functions []goFunction
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// header writes the package clause and the `main` function of the generated
+// program.
+func (g *generator) header(cfg Config) {
+	g.dir = cfg.Dir
+	g.printf("// Code generated by cx build. DO NOT EDIT.\n\n")
+	g.printf("package main\n\n")
+	g.printf("import (\n\"os\"\n\"path/filepath\"\n\"runtime\"\n\n")
+	g.printf("cxcore %q\n)\n\n", "github.com/skycoin/cx/cx")
+
+	g.printf("func main() {\n")
+	g.printf("runtime.LockOSThread()\n\n")
+	g.printf("cxcore.STACK_SIZE = %d\n", cfg.StackSize)
+	g.printf("cxcore.INIT_HEAP_SIZE = %d\n", cfg.InitHeapSize)
+	g.printf("cxcore.MAX_HEAP_SIZE = %d\n", cfg.MaxHeapSize)
+	g.printf("cxcore.MAX_CALLSTACK_SIZE = %d\n", cfg.MaxCallStackSize)
+	g.printf("cxcore.MIN_HEAP_FREE_RATIO = %s\n", formatFloat(cfg.MinHeapFreeRatio))
+	g.printf("cxcore.MAX_HEAP_FREE_RATIO = %s\n", formatFloat(cfg.MaxHeapFreeRatio))
+	g.printf("cxcore.TAIL_CALLS = %t\n\n", cfg.OptimizationLevel > 0)
+
+	g.printf("prgrm := newProgram()\n")
+	g.printf("// the files of the program are looked for next to its executable\n")
+	g.printf("if exe, err := os.Executable(); err == nil {\n")
+	g.printf("prgrm.Path = filepath.Dir(exe)\n")
+	g.printf("}\n\n")
+
+	g.printf("for _, fn := range functions {\n")
+	g.printf("if err := prgrm.BindGoFunction(fn.pkg, fn.name, fn.length, fn.size, fn.body); err != nil {\n")
+	g.printf("panic(err)\n")
+	g.printf("}\n")
+	g.printf("}\n\n")
+
+	g.printf("if err := prgrm.RunCompiled(0, os.Args[1:]); err != nil {\n")
+	g.printf("panic(err)\n")
+	g.printf("}\n")
+	g.printf("if cxcore.AssertFailed() {\n")
+	g.printf("os.Exit(cxcore.CX_ASSERT)\n")
+	g.printf("}\n")
+	g.printf("}\n\n")
+
+	g.printf("// zero wipes the memory of a declared variable.\n")
+	g.printf("func zero(mem []byte) {\n")
+	g.printf("for c := range mem {\n")
+	g.printf("mem[c] = 0\n")
+	g.printf("}\n")
+	g.printf("}\n\n")
+}
+
+func formatFloat(f float32) string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+}
+
+// structComment documents the memory layout of `strct`, which is the
+// layout used by the generated code to access its fields.
+func (g *generator) structComment(strct *cxcore.CXStruct) {
+	g.printf("// struct %s.%s (%d bytes)\n", strct.Package.Name, strct.Name, strct.Size)
+	for _, fld := range strct.Fields {
+		g.printf("//   %s %s at %d\n", fld.Name, cxcore.GetFormattedType(fld), fld.Offset)
+	}
+	g.printf("\n")
+}
+
+// bindings writes the table that binds the Go functions to the CX functions.
+func (g *generator) bindings() {
+	g.printf("var functions = []struct {\n")
+	g.printf("pkg, name    string\n")
+	g.printf("length, size int\n")
+	g.printf("body         cxcore.GoFunction\n")
+	g.printf("}{\n")
+	for _, f := range g.functions {
+		g.printf("{%q, %q, %d, %d, %s},\n", f.fn.Package.Name, f.fn.Name, f.fn.Length, f.fn.Size, f.id)
+	}
+	g.printf("}\n")
+}
+
+// function writes the Go translation of `fn`.
+func (g *generator) function(fn *cxcore.CXFunction) {
+	id := fmt.Sprintf("fn%d_%s_%s", len(g.functions), identifier(fn.Package.Name), identifier(fn.Name))
+	g.functions = append(g.functions, goFunction{fn: fn, id: id})
+
+	// translating the reachable expressions first, as they determine which
+	// of them are jumped to and need a label
+	t := &translation{fn: fn, labels: make(map[int]bool)}
+	stmts := make([]string, len(fn.Expressions))
+	reachable := make([]bool, len(fn.Expressions))
+	pending := []int{0}
+	for len(pending) > 0 {
+		i := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if i < 0 {
+			i = 0
+		}
+		if i >= len(fn.Expressions) || reachable[i] {
+			continue
+		}
+		reachable[i] = true
+
+		var next []int
+		stmts[i], next = g.expression(t, i)
+		pending = append(pending, next...)
+	}
+
+	g.printf("// %s translates %s.%s, declared in %s:%d.\n", id, fn.Package.Name, fn.Name, relativeFileName(fn.FileName, g.dir), fn.FileLine)
+	g.printf("func %s(prgrm *cxcore.CXProgram, fp int) {\n", id)
+	for i, stmt := range stmts {
+		if !reachable[i] {
+			continue
+		}
+		if t.labels[i] {
+			g.printf("L%d:\n", i)
+		}
+		g.printf("%s\n", stmt)
+	}
+	g.printf("}\n\n")
+}
+
+// identifier replaces the characters of `name` that can't be part of a Go
+// identifier, e.g. the ones of `*init` or of a method name.
+func identifier(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// translation holds the state of the translation of a function.
+type translation struct {
+	fn     *cxcore.CXFunction
+	labels map[int]bool // expressions that are jumped to
+}
+
+// jump returns the statement that makes the ith expression of the function
+// continue with the expression at `target`.
+func (t *translation) jump(i, target int) string {
+	if target >= len(t.fn.Expressions) {
+		return "return"
+	}
+	if target < 0 {
+		target = 0
+	}
+	if target == i+1 {
+		return ""
+	}
+	t.labels[target] = true
+	return fmt.Sprintf("goto L%d", target)
+}
+
+// expression returns the statements that run the ith expression of the
+// translated function and the indexes of the expressions that can run after it.
+func (g *generator) expression(t *translation, i int) (string, []int) {
+	expr := t.fn.Expressions[i]
+
+	if expr.Operator == nil {
+		// declaration
+		out := expr.Outputs[0]
+		start, end := g.address(out, 0), g.address(out, cxcore.GetSize(out))
+		return fmt.Sprintf("zero(prgrm.Memory[%s:%s]) // var %s", start, end, out.Name), []int{i + 1}
+	}
+
+	if !expr.Operator.IsNative {
+		callee := expr.Operator.Package.Name + "." + expr.Operator.Name
+		if expr.IsTailCall() {
+			return fmt.Sprintf("prgrm.GoTailCall(%d) // %s\nreturn", i, callee), nil
+		}
+		return fmt.Sprintf("prgrm.GoCall(%d) // %s", i, callee), []int{i + 1}
+	}
+
+	if expr.Operator.OpCode == cxcore.OP_JMP {
+		thenLine := i + expr.ThenLines + 1
+		if expr.Label != "" {
+			// goto or return
+			return t.jump(i, thenLine), []int{thenLine}
+		}
+
+		elseLine := i + expr.ElseLines + 1
+		cond := fmt.Sprintf("prgrm.GoCond(%d)", i)
+		if inp := expr.Inputs[0]; isBasicOperand(inp) && inp.Type == cxcore.TYPE_BOOL {
+			cond = g.read(inp)
+		}
+
+		thenJmp, elseJmp := t.jump(i, thenLine), t.jump(i, elseLine)
+		var stmt string
+		switch {
+		case thenJmp == elseJmp:
+			stmt = thenJmp
+		case thenJmp == "":
+			stmt = fmt.Sprintf("if !%s {\n%s\n}", cond, elseJmp)
+		default:
+			stmt = fmt.Sprintf("if %s {\n%s\n}\n%s", cond, thenJmp, elseJmp)
+		}
+		return stmt, []int{thenLine, elseLine}
+	}
+
+	name := nativeName(expr)
+	if stmt, ok := g.native(expr, name); ok {
+		return fmt.Sprintf("%s // %s", stmt, name), []int{i + 1}
+	}
+	return fmt.Sprintf("prgrm.GoExec(%d) // %s", i, name), []int{i + 1}
+}
+
+// nativeName returns the name of the native operator of `expr`. Operators
+// of undefined type are named after their typed counterpart, which is the
+// one run by the interpreter (see cxcore.Lower).
+func nativeName(expr *cxcore.CXExpression) string {
+	name := cxcore.OpNames[expr.Operator.OpCode]
+	if len(expr.Inputs) > 0 && !strings.Contains(name, ".") {
+		typed := cxcore.TypeNames[expr.Inputs[0].Type] + "." + name
+		if _, ok := cxcore.OpCodes[typed]; ok {
+			return typed
+		}
+	}
+	return name
+}
+
+// goTypes are the names of the Go types of the basic CX types, which also
+// name the functions that read and write them.
+var goTypes = map[int]struct{ name, suffix string }{
+	cxcore.TYPE_BOOL: {"bool", "Bool"},
+	cxcore.TYPE_I8:   {"int8", "I8"},
+	cxcore.TYPE_I16:  {"int16", "I16"},
+	cxcore.TYPE_I32:  {"int32", "I32"},
+	cxcore.TYPE_I64:  {"int64", "I64"},
+	cxcore.TYPE_UI8:  {"uint8", "UI8"},
+	cxcore.TYPE_UI16: {"uint16", "UI16"},
+	cxcore.TYPE_UI32: {"uint32", "UI32"},
+	cxcore.TYPE_UI64: {"uint64", "UI64"},
+	cxcore.TYPE_F32:  {"float32", "F32"},
+	cxcore.TYPE_F64:  {"float64", "F64"},
+}
+
+// binaryOps are the Go operators of the binary natives that are translated,
+// and whether their result is a bool. Division and modulo are left to the
+// runtime, as they can fail.
+var binaryOps = map[string]struct {
+	op        string
+	isCompare bool
+}{
+	"add":      {"+", false},
+	"sub":      {"-", false},
+	"mul":      {"*", false},
+	"bitand":   {"&", false},
+	"bitor":    {"|", false},
+	"bitxor":   {"^", false},
+	"bitclear": {"&^", false},
+	"and":      {"&&", false},
+	"or":       {"||", false},
+	"lt":       {"<", true},
+	"gt":       {">", true},
+	"lteq":     {"<=", true},
+	"gteq":     {">=", true},
+	"eq":       {"==", true},
+	"uneq":     {"!=", true},
+}
+
+// native returns the Go statement that runs the native expression `expr`,
+// whose operator is `name`, if its operands and operator can be translated.
+func (g *generator) native(expr *cxcore.CXExpression, name string) (string, bool) {
+	if expr.Operator.OpCode == cxcore.OP_IDENTITY {
+		if len(expr.Inputs) != 1 || len(expr.Outputs) != 1 ||
+			!isDirectOperand(expr.Inputs[0]) || !isDirectOperand(expr.Outputs[0]) {
+			return "", false
+		}
+		inp, out := expr.Inputs[0], expr.Outputs[0]
+		size := cxcore.GetSize(inp)
+		return fmt.Sprintf("copy(prgrm.Memory[%s:%s], prgrm.Memory[%s:%s])",
+			g.address(out, 0), g.address(out, size), g.address(inp, 0), g.address(inp, size)), true
+	}
+
+	if len(expr.Outputs) != 1 || !isBasicOperand(expr.Outputs[0]) {
+		return "", false
+	}
+	for _, inp := range expr.Inputs {
+		if !isBasicOperand(inp) {
+			return "", false
+		}
+	}
+
+	dot := strings.IndexByte(name, '.')
+	if dot < 0 || len(expr.Inputs) == 0 {
+		return "", false
+	}
+	typ, ok := cxcore.TypeCodes[name[:dot]]
+	if !ok || goTypes[typ].name == "" {
+		return "", false
+	}
+	op := name[dot+1:]
+	out := expr.Outputs[0]
+	for _, inp := range expr.Inputs {
+		if inp.Type != typ {
+			return "", false
+		}
+	}
+
+	var value string
+	if bin, ok := binaryOps[op]; ok && len(expr.Inputs) == 2 {
+		isLogical := op == "and" || op == "or"
+		if typ == cxcore.TYPE_BOOL && !isLogical && op != "eq" && op != "uneq" ||
+			typ != cxcore.TYPE_BOOL && isLogical || isFloat(typ) && strings.HasPrefix(op, "bit") {
+			return "", false
+		}
+		outType := typ
+		if bin.isCompare {
+			outType = cxcore.TYPE_BOOL
+		}
+		if out.Type != outType {
+			return "", false
+		}
+		value = fmt.Sprintf("%s %s %s", g.read(expr.Inputs[0]), bin.op, g.read(expr.Inputs[1]))
+	} else if len(expr.Inputs) == 1 && (op == "neg" && typ != cxcore.TYPE_BOOL || op == "not" && typ == cxcore.TYPE_BOOL) {
+		if out.Type != typ {
+			return "", false
+		}
+		operator := "-"
+		if op == "not" {
+			operator = "!"
+		}
+		value = operator + g.read(expr.Inputs[0])
+	} else if castType, ok := cxcore.TypeCodes[op]; ok && len(expr.Inputs) == 1 &&
+		castType == out.Type && typ != cxcore.TYPE_BOOL && castType != cxcore.TYPE_BOOL && goTypes[castType].name != "" {
+		value = fmt.Sprintf("%s(%s)", goTypes[castType].name, g.read(expr.Inputs[0]))
+	} else {
+		return "", false
+	}
+
+	return fmt.Sprintf("cxcore.WriteMem%s(prgrm.Memory, %s, %s)", goTypes[out.Type].suffix, g.address(out, 0), value), true
+}
+
+func isFloat(typ int) bool {
+	return typ == cxcore.TYPE_F32 || typ == cxcore.TYPE_F64
+}
+
+// isDirectOperand checks if the final offset of `arg` only depends on the
+// frame pointer and if it's passed by value, so it can be accessed directly.
+func isDirectOperand(arg *cxcore.CXArgument) bool {
+	return arg.IsDirect() && len(arg.Indexes) == 0 && arg.PassBy == cxcore.PASSBY_VALUE &&
+		!arg.DoesEscape && !arg.IsInnerReference
+}
+
+// isBasicOperand checks if `arg` is a direct operand of a basic type.
+func isBasicOperand(arg *cxcore.CXArgument) bool {
+	if _, ok := goTypes[arg.Type]; !ok {
+		return false
+	}
+	return isDirectOperand(arg) && arg.CustomType == nil && !arg.IsPointer && !arg.IsSlice &&
+		!arg.IsArray && len(arg.Lengths) == 0
+}
+
+// address returns the expression of the final offset of the direct operand
+// `arg`, plus `delta`.
+func (g *generator) address(arg *cxcore.CXArgument, delta int) string {
+	if arg.Offset < g.stackSize {
+		return frameAddress(arg.Offset + delta)
+	}
+	// global or literal
+	return strconv.Itoa(arg.Offset + delta)
+}
+
+// frameAddress returns the expression of the offset `offset` of the stack frame.
+func frameAddress(offset int) string {
+	if offset == 0 {
+		return "fp"
+	}
+	return fmt.Sprintf("fp+%d", offset)
+}
+
+// read returns the expression of the value of the basic operand `arg`.
+func (g *generator) read(arg *cxcore.CXArgument) string {
+	return fmt.Sprintf("cxcore.ReadMem%s(prgrm.Memory, %s)", goTypes[arg.Type].suffix, g.address(arg, 0))
+}
@@ -0,0 +1,512 @@
+package gotarget
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	cxcore "github.com/skycoin/cx/cx"
+)
+
+// The file program.go of the generated package makes the compiled program
+// in `newProgram`. The packages, structs, functions, expressions and
+// arguments of the program are allocated first, in the slices `pkgs`,
+// `strcts`, `fns`, `exprs` and `args`, and then they are linked to each
+// other, so the objects that are shared, and the cycles between them, are
+// kept. The core packages and their structs are the ones of the runtime,
+// which its natives refer to, and the natives are looked up by name.
+
+// statementsPerFunction is how many statements are written in each of the
+// functions that make the objects, so none of them is too big for the Go
+// compiler.
+const statementsPerFunction = 1000
+
+// objects are the objects of a program, indexed like the slices of the
+// generated code.
+type objects struct {
+	pkgs   []*cxcore.CXPackage
+	strcts []*cxcore.CXStruct
+	fns    []*cxcore.CXFunction
+	exprs  []*cxcore.CXExpression
+	args   []*cxcore.CXArgument
+	ids    map[interface{}]int
+}
+
+// isCorePackage checks if `pkg` is a package of the runtime, which isn't
+// declared by CX source code.
+func isCorePackage(pkg *cxcore.CXPackage) bool {
+	return !pkg.IsSource && cxcore.IsCorePackage(pkg.Name)
+}
+
+func (o *objects) seen(obj interface{}) bool {
+	if _, ok := o.ids[obj]; ok {
+		return true
+	}
+	return false
+}
+
+func (o *objects) addPackage(pkg *cxcore.CXPackage) {
+	if pkg == nil || o.seen(pkg) {
+		return
+	}
+	o.ids[pkg] = len(o.pkgs)
+	o.pkgs = append(o.pkgs, pkg)
+
+	for _, imp := range pkg.Imports {
+		o.addPackage(imp)
+	}
+	for _, strct := range pkg.Structs {
+		o.addStruct(strct)
+	}
+	for _, glbl := range pkg.Globals {
+		o.addArgument(glbl)
+	}
+	for _, fn := range pkg.Functions {
+		o.addFunction(fn)
+	}
+	o.addFunction(pkg.CurrentFunction)
+	o.addStruct(pkg.CurrentStruct)
+}
+
+func (o *objects) addStruct(strct *cxcore.CXStruct) {
+	if strct == nil || o.seen(strct) {
+		return
+	}
+	o.ids[strct] = len(o.strcts)
+	o.strcts = append(o.strcts, strct)
+
+	o.addPackage(strct.Package)
+	if !isCorePackage(strct.Package) {
+		for _, fld := range strct.Fields {
+			o.addArgument(fld)
+		}
+	}
+}
+
+// addFunction adds `fn` unless it's a native, which isn't part of the
+// program.
+func (o *objects) addFunction(fn *cxcore.CXFunction) {
+	if fn == nil || fn.IsNative || o.seen(fn) {
+		return
+	}
+	o.ids[fn] = len(o.fns)
+	o.fns = append(o.fns, fn)
+
+	o.addPackage(fn.Package)
+	o.addArguments(fn.Inputs, fn.Outputs, fn.ListOfPointers)
+	for _, expr := range fn.Expressions {
+		o.addExpression(expr)
+	}
+	o.addExpression(fn.CurrentExpression)
+}
+
+func (o *objects) addExpression(expr *cxcore.CXExpression) {
+	if expr == nil || o.seen(expr) {
+		return
+	}
+	o.ids[expr] = len(o.exprs)
+	o.exprs = append(o.exprs, expr)
+
+	o.addArguments(expr.Inputs, expr.Outputs)
+	o.addFunction(expr.Operator)
+	o.addFunction(expr.Function)
+	o.addPackage(expr.Package)
+}
+
+func (o *objects) addArguments(lists ...[]*cxcore.CXArgument) {
+	for _, list := range lists {
+		for _, arg := range list {
+			o.addArgument(arg)
+		}
+	}
+}
+
+func (o *objects) addArgument(arg *cxcore.CXArgument) {
+	if arg == nil || o.seen(arg) {
+		return
+	}
+	o.ids[arg] = len(o.args)
+	o.args = append(o.args, arg)
+
+	o.addArguments(arg.Indexes, arg.Fields, arg.Inputs, arg.Outputs)
+	o.addStruct(arg.CustomType)
+	o.addPackage(arg.Package)
+}
+
+// literal builds a composite literal with the fields that don't have their
+// zero values.
+type literal struct {
+	fields []string
+}
+
+func (l *literal) add(name, value string) {
+	l.fields = append(l.fields, name+": "+value)
+}
+
+func (l *literal) str(name, value string) {
+	if value != "" {
+		l.add(name, strconv.Quote(value))
+	}
+}
+
+func (l *literal) int(name string, value int) {
+	if value != 0 {
+		l.add(name, strconv.Itoa(value))
+	}
+}
+
+func (l *literal) bool(name string, value bool) {
+	if value {
+		l.add(name, "true")
+	}
+}
+
+func (l *literal) ints(name string, values []int) {
+	if values != nil {
+		l.add(name, intsLiteral(values))
+	}
+}
+
+func (l *literal) String(typ string) string {
+	return fmt.Sprintf("&cxcore.%s{%s}", typ, strings.Join(l.fields, ", "))
+}
+
+func intsLiteral(values []int) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return "[]int{" + strings.Join(strs, ", ") + "}"
+}
+
+// programWriter writes the statements that make the objects of a program.
+type programWriter struct {
+	*objects
+	dir   string
+	stmts []string
+}
+
+func (w *programWriter) printf(format string, args ...interface{}) {
+	w.stmts = append(w.stmts, fmt.Sprintf(format, args...))
+}
+
+// ref returns the expression of the object `obj`, which is nil or an
+// object of the program.
+func (w *programWriter) ref(obj interface{}) string {
+	switch obj := obj.(type) {
+	case *cxcore.CXPackage:
+		if obj != nil {
+			return fmt.Sprintf("pkgs[%d]", w.ids[obj])
+		}
+	case *cxcore.CXStruct:
+		if obj != nil {
+			return fmt.Sprintf("strcts[%d]", w.ids[obj])
+		}
+	case *cxcore.CXFunction:
+		if obj != nil && obj.IsNative {
+			return fmt.Sprintf("native(%q)", cxcore.OpNames[obj.OpCode])
+		}
+		if obj != nil {
+			return fmt.Sprintf("fns[%d]", w.ids[obj])
+		}
+	case *cxcore.CXExpression:
+		if obj != nil {
+			return fmt.Sprintf("exprs[%d]", w.ids[obj])
+		}
+	case *cxcore.CXArgument:
+		if obj != nil {
+			return fmt.Sprintf("args[%d]", w.ids[obj])
+		}
+	}
+	return "nil"
+}
+
+// link writes the assignment of the object `obj` to the field `field`, if
+// it isn't nil.
+func (w *programWriter) link(field string, obj interface{}) {
+	if ref := w.ref(obj); ref != "nil" {
+		w.printf("%s = %s", field, ref)
+	}
+}
+
+// linkList writes the assignment of a list of objects of type `typ` to the
+// field `field`, if it isn't nil.
+func (w *programWriter) linkList(field, typ string, n int, obj func(i int) interface{}, isNil bool) {
+	if isNil {
+		return
+	}
+	refs := make([]string, n)
+	for i := range refs {
+		refs[i] = w.ref(obj(i))
+	}
+	w.printf("%s = []*cxcore.%s{%s}", field, typ, strings.Join(refs, ", "))
+}
+
+func (w *programWriter) linkArguments(field string, list []*cxcore.CXArgument) {
+	w.linkList(field, "CXArgument", len(list), func(i int) interface{} { return list[i] }, list == nil)
+}
+
+func (w *programWriter) fileName(name string) string {
+	return relativeFileName(name, w.dir)
+}
+
+// allocate writes the allocation of every object, with its fields that
+// aren't links.
+func (w *programWriter) allocate() {
+	for i, pkg := range w.pkgs {
+		if isCorePackage(pkg) {
+			w.printf("pkgs[%d] = corePackage(%q)", i, pkg.Name)
+			continue
+		}
+		var l literal
+		l.str("Name", pkg.Name)
+		l.bool("IsSource", pkg.IsSource)
+		w.printf("pkgs[%d] = %s", i, l.String("CXPackage"))
+	}
+
+	for i, strct := range w.strcts {
+		if isCorePackage(strct.Package) {
+			w.printf("strcts[%d] = coreStruct(%s, %q)", i, w.ref(strct.Package), strct.Name)
+			continue
+		}
+		var l literal
+		l.str("Name", strct.Name)
+		l.int("Size", strct.Size)
+		w.printf("strcts[%d] = %s", i, l.String("CXStruct"))
+	}
+
+	for i, fn := range w.fns {
+		var l literal
+		l.str("Name", fn.Name)
+		l.int("OpCode", fn.OpCode)
+		l.int("Length", fn.Length)
+		l.int("Size", fn.Size)
+		l.str("FileName", w.fileName(fn.FileName))
+		l.int("FileLine", fn.FileLine)
+		w.printf("fns[%d] = %s", i, l.String("CXFunction"))
+	}
+
+	for i, expr := range w.exprs {
+		var l literal
+		l.str("Label", expr.Label)
+		l.str("FileName", w.fileName(expr.FileName))
+		l.int("FileLine", expr.FileLine)
+		l.int("ThenLines", expr.ThenLines)
+		l.int("ElseLines", expr.ElseLines)
+		l.int("ScopeOperation", expr.ScopeOperation)
+		l.bool("IsMethodCall", expr.IsMethodCall)
+		l.bool("IsStructLiteral", expr.IsStructLiteral)
+		l.bool("IsArrayLiteral", expr.IsArrayLiteral)
+		l.bool("IsUndType", expr.IsUndType)
+		l.bool("IsBreak", expr.IsBreak)
+		l.bool("IsContinue", expr.IsContinue)
+		l.bool("IsRange", expr.IsRange)
+		w.printf("exprs[%d] = %s", i, l.String("CXExpression"))
+	}
+
+	for i, arg := range w.args {
+		var l literal
+		l.ints("Lengths", arg.Lengths)
+		l.ints("DereferenceOperations", arg.DereferenceOperations)
+		l.ints("DeclarationSpecifiers", arg.DeclarationSpecifiers)
+		l.str("Name", arg.Name)
+		l.str("FileName", w.fileName(arg.FileName))
+		l.int("Type", arg.Type)
+		l.int("Size", arg.Size)
+		l.int("TotalSize", arg.TotalSize)
+		l.int("Offset", arg.Offset)
+		l.int("IndirectionLevels", arg.IndirectionLevels)
+		l.int("DereferenceLevels", arg.DereferenceLevels)
+		l.int("PassBy", arg.PassBy)
+		l.int("FileLine", arg.FileLine)
+		l.bool("IsSlice", arg.IsSlice)
+		l.bool("IsArray", arg.IsArray)
+		l.bool("IsArrayFirst", arg.IsArrayFirst)
+		l.bool("IsPointer", arg.IsPointer)
+		l.bool("IsReference", arg.IsReference)
+		l.bool("IsDereferenceFirst", arg.IsDereferenceFirst)
+		l.bool("IsStruct", arg.IsStruct)
+		l.bool("IsRest", arg.IsRest)
+		l.bool("IsLocalDeclaration", arg.IsLocalDeclaration)
+		l.bool("IsShortDeclaration", arg.IsShortDeclaration)
+		l.bool("IsInnerReference", arg.IsInnerReference)
+		l.bool("PreviouslyDeclared", arg.PreviouslyDeclared)
+		l.bool("DoesEscape", arg.DoesEscape)
+		w.printf("args[%d] = %s", i, l.String("CXArgument"))
+	}
+}
+
+// linkObjects writes the assignments of the fields of every object that
+// refer to other objects. The core structs keep their fields.
+func (w *programWriter) linkObjects() {
+	for i, pkg := range w.pkgs {
+		v := fmt.Sprintf("pkgs[%d]", i)
+		w.linkList(v+".Imports", "CXPackage", len(pkg.Imports), func(i int) interface{} { return pkg.Imports[i] }, pkg.Imports == nil)
+		w.linkList(v+".Functions", "CXFunction", len(pkg.Functions), func(i int) interface{} { return pkg.Functions[i] }, pkg.Functions == nil)
+		w.linkList(v+".Structs", "CXStruct", len(pkg.Structs), func(i int) interface{} { return pkg.Structs[i] }, pkg.Structs == nil)
+		w.linkArguments(v+".Globals", pkg.Globals)
+		w.link(v+".CurrentFunction", pkg.CurrentFunction)
+		w.link(v+".CurrentStruct", pkg.CurrentStruct)
+	}
+
+	for i, strct := range w.strcts {
+		if isCorePackage(strct.Package) {
+			continue
+		}
+		v := fmt.Sprintf("strcts[%d]", i)
+		w.link(v+".Package", strct.Package)
+		w.linkArguments(v+".Fields", strct.Fields)
+	}
+
+	for i, fn := range w.fns {
+		v := fmt.Sprintf("fns[%d]", i)
+		w.link(v+".Package", fn.Package)
+		w.linkArguments(v+".Inputs", fn.Inputs)
+		w.linkArguments(v+".Outputs", fn.Outputs)
+		w.linkList(v+".Expressions", "CXExpression", len(fn.Expressions), func(i int) interface{} { return fn.Expressions[i] }, fn.Expressions == nil)
+		w.linkArguments(v+".ListOfPointers", fn.ListOfPointers)
+		w.link(v+".CurrentExpression", fn.CurrentExpression)
+	}
+
+	for i, expr := range w.exprs {
+		v := fmt.Sprintf("exprs[%d]", i)
+		w.linkArguments(v+".Inputs", expr.Inputs)
+		w.linkArguments(v+".Outputs", expr.Outputs)
+		w.link(v+".Operator", expr.Operator)
+		w.link(v+".Function", expr.Function)
+		w.link(v+".Package", expr.Package)
+	}
+
+	for i, arg := range w.args {
+		v := fmt.Sprintf("args[%d]", i)
+		w.linkArguments(v+".Indexes", arg.Indexes)
+		w.linkArguments(v+".Fields", arg.Fields)
+		w.linkArguments(v+".Inputs", arg.Inputs)
+		w.linkArguments(v+".Outputs", arg.Outputs)
+		w.link(v+".CustomType", arg.CustomType)
+		w.link(v+".Package", arg.Package)
+	}
+}
+
+// memory writes the statements that copy the memory of `prgrm`, which holds
+// its data segment, to the memory of the generated program. Only the runs of
+// bytes that aren't zeros are copied.
+func (w *programWriter) memory(prgrm *cxcore.CXProgram) {
+	mem := prgrm.Memory
+	w.printf("if n := %d - len(prgrm.Memory); n > 0 {\nprgrm.Memory = append(prgrm.Memory, make([]byte, n)...)\n}", len(mem))
+	for i := 0; i < len(mem); {
+		if mem[i] == 0 {
+			i++
+			continue
+		}
+		// the runs are joined unless there are enough zeros between them
+		end, zeros := i, 0
+		for j := i; j < len(mem) && zeros < 16; j++ {
+			if mem[j] == 0 {
+				zeros++
+			} else {
+				end, zeros = j+1, 0
+			}
+		}
+		w.printf("copy(prgrm.Memory[%d:], %q)", i, mem[i:end])
+		i = end
+	}
+}
+
+// programFile returns program.go, which makes `prgrm` as it was compiled.
+// The names of the source files are made relative to `dir`.
+func programFile(prgrm *cxcore.CXProgram, dir string) []byte {
+	o := &objects{ids: make(map[interface{}]int)}
+	for _, pkg := range prgrm.Packages {
+		o.addPackage(pkg)
+	}
+	o.addPackage(prgrm.CurrentPackage)
+	o.addArguments(prgrm.Inputs, prgrm.Outputs)
+
+	w := &programWriter{objects: o, dir: dir}
+	w.allocate()
+	w.linkObjects()
+
+	var buf bytes.Buffer
+	printf := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format, args...)
+	}
+
+	printf("// Code generated by cx build. DO NOT EDIT.\n\n")
+	printf("package main\n\n")
+	printf("import (\n\"fmt\"\n\ncxcore %q\n)\n\n", "github.com/skycoin/cx/cx")
+
+	printf("// The objects of the program, see newProgram.\n")
+	printf("var (\n")
+	printf("pkgs = make([]*cxcore.CXPackage, %d)\n", len(o.pkgs))
+	printf("strcts = make([]*cxcore.CXStruct, %d)\n", len(o.strcts))
+	printf("fns = make([]*cxcore.CXFunction, %d)\n", len(o.fns))
+	printf("exprs = make([]*cxcore.CXExpression, %d)\n", len(o.exprs))
+	printf("args = make([]*cxcore.CXArgument, %d)\n", len(o.args))
+	printf(")\n\n")
+
+	var parts int
+	for start := 0; start < len(w.stmts); start += statementsPerFunction {
+		end := start + statementsPerFunction
+		if end > len(w.stmts) {
+			end = len(w.stmts)
+		}
+		printf("func makeObjects%d() {\n%s\n}\n\n", parts, strings.Join(w.stmts[start:end], "\n"))
+		parts++
+	}
+
+	printf("// newProgram makes the program as it was compiled.\n")
+	printf("func newProgram() *cxcore.CXProgram {\n")
+	for i := 0; i < parts; i++ {
+		printf("makeObjects%d()\n", i)
+	}
+	printf("\nprgrm := cxcore.MakeProgram()\n")
+	w.stmts = nil
+	w.linkList("prgrm.Packages", "CXPackage", len(prgrm.Packages), func(i int) interface{} { return prgrm.Packages[i] }, false)
+	w.link("prgrm.CurrentPackage", prgrm.CurrentPackage)
+	w.linkArguments("prgrm.Inputs", prgrm.Inputs)
+	w.linkArguments("prgrm.Outputs", prgrm.Outputs)
+	w.printf("prgrm.StackStartsAt = %d", prgrm.StackStartsAt)
+	w.printf("prgrm.HeapStartsAt = %d", prgrm.HeapStartsAt)
+	w.printf("prgrm.HeapPointer = %d", prgrm.HeapPointer)
+	if prgrm.BCPackageCount != 0 {
+		w.printf("prgrm.BCPackageCount = %d", prgrm.BCPackageCount)
+	}
+	if prgrm.Version != "" {
+		w.printf("prgrm.Version = %q", prgrm.Version)
+	}
+	w.memory(prgrm)
+	printf("%s\n", strings.Join(w.stmts, "\n"))
+	printf("return prgrm\n")
+	printf("}\n\n")
+
+	printf("// corePackage returns the core package `name` of the runtime, or a new\n")
+	printf("// package if the runtime doesn't have one yet.\n")
+	printf("func corePackage(name string) *cxcore.CXPackage {\n")
+	printf("if pkg, err := cxcore.PROGRAM.GetPackage(name); err == nil {\n")
+	printf("return pkg\n")
+	printf("}\n")
+	printf("return &cxcore.CXPackage{Name: name}\n")
+	printf("}\n\n")
+
+	printf("// coreStruct returns the struct `name` of the core package `pkg`.\n")
+	printf("func coreStruct(pkg *cxcore.CXPackage, name string) *cxcore.CXStruct {\n")
+	printf("strct, err := pkg.GetStruct(name)\n")
+	printf("if err != nil {\n")
+	printf("panic(err)\n")
+	printf("}\n")
+	printf("return strct\n")
+	printf("}\n\n")
+
+	printf("// native returns the native function of the operator `name`.\n")
+	printf("func native(name string) *cxcore.CXFunction {\n")
+	printf("code, ok := cxcore.OpCodes[name]\n")
+	printf("if !ok {\n")
+	printf("panic(fmt.Sprintf(\"unknown operator '%%s', the program must be built with the tags it was compiled with\", name))\n")
+	printf("}\n")
+	printf("return cxcore.Natives[code]\n")
+	printf("}\n")
+
+	return buf.Bytes()
+}
@@ -497,6 +497,12 @@ func parseProgram(options cxCmdFlags, fileNames []string, sourceCode []*os.File)
 	// Setting what function to start in if using the REPL.
 	actions.ReplTargetFn = cxcore.MAIN_FUNC
 
+	// Inlining small functions. The REPL can redefine them, so it is only done
+	// when running a program.
+	if !options.replMode {
+		actions.InlineFunctions()
+	}
+
 	// Adding *init function that initializes all the global variables.
 	cxgo.AddInitFunction(actions.PRGRM)
 
@@ -701,12 +707,46 @@ func Run(args []string) {
 	runtime.GOMAXPROCS(2)
 
 	options := defaultCmdFlags()
-	parseFlags(&options, args)
+	if len(args) > 0 && args[0] == "build" {
+		// `cx build` translates the program instead of running it
+		options.buildMode = true
+		parseFlags(&options, args[1:])
+		options.replMode = false
+	} else if len(args) > 0 && args[0] == "new" {
+		// `cx new <name>` creates a project
+		options.newMode = true
+		if len(args) > 1 && !strings.HasPrefix(args[1], "-") {
+			// the name can come before the flags
+			parseFlags(&options, append(append([]string{}, args[2:]...), args[1]))
+		} else {
+			parseFlags(&options, args[1:])
+		}
+	} else if len(args) > 0 && args[0] == "test" {
+		// `cx test` runs the tests of the current project
+		options.testMode = true
+		parseFlags(&options, args[1:])
+		options.replMode = false
+	} else if len(args) > 1 && args[0] == "mod" {
+		// `cx mod <command>` manages the manifest of the current module
+		options.modCommand = args[1]
+		parseFlags(&options, args[2:])
+	} else {
+		parseFlags(&options, args)
+	}
 
 	// Checking if CXPATH is set, either by setting an environment variable
 	// or by setting the `--cxpath` flag.
 	checkCXPathSet(options)
 
+	if options.modCommand != "" {
+		modCommand(options.modCommand, commandLine.Args())
+		return
+	}
+	if options.newMode {
+		newProject(options, commandLine.Args())
+		return
+	}
+
 	// Does the user want to run a CX publisher or peer node?
 	if options.publisherMode || options.peerMode {
 		optionRunNode(options)
@@ -715,13 +755,11 @@ func Run(args []string) {
 	if options.genAddress {
 		panic("genAddress features is now moved to github.com/skycoin/cx-chains repo")
 		// optionGenAddress(options)
-		return
 	}
 	// Does the user want to generate a new wallet address?
 	if options.walletMode {
 		panic("genWallet features is now moved to github.com/skycoin/cx-chains repo")
 		// optionGenWallet(options)
-		return
 	}
 
 	if checkhelp(args) {
@@ -741,6 +779,11 @@ func Run(args []string) {
 		return
 	}
 
+	// The configuration of the project sets the defaults of the options.
+	project := loadProjectConfig(&options, commandLine.Args())
+
+	// The memory options are applied before any program is made, as its
+	// memory is allocated with them.
 	if options.initialHeap != "" {
 		cxcore.INIT_HEAP_SIZE = parseMemoryString(options.initialHeap)
 	}
@@ -755,6 +798,9 @@ func Run(args []string) {
 		cxcore.STACK_SIZE = parseMemoryString(options.stackSize)
 		actions.DataOffset = cxcore.STACK_SIZE
 	}
+	if options.maxCallStackSize > 0 {
+		cxcore.MAX_CALLSTACK_SIZE = options.maxCallStackSize
+	}
 	if options.minHeapFreeRatio != float64(0) {
 		cxcore.MIN_HEAP_FREE_RATIO = float32(options.minHeapFreeRatio)
 	}
@@ -762,15 +808,37 @@ func Run(args []string) {
 		cxcore.MAX_HEAP_FREE_RATIO = float32(options.maxHeapFreeRatio)
 	}
 
+	if options.testMode {
+		testProject(options, project)
+		return
+	}
+
 	// options, file pointers, filenames
 	cxArgs, sourceCode, fileNames := cxcore.ParseArgsForCX(commandLine.Args(), true)
+	if len(fileNames) == 0 && project != nil && !isFlagSet("repl", "r") {
+		if len(project.Entry) == 0 {
+			// a library, which only has tests
+			fmt.Fprintf(os.Stderr, "cx: the project in %s has no entry files, use 'cx test' to run its tests or 'cx -r' to start the REPL\n", project.Dir)
+			os.Exit(cxcore.CX_COMPILATION_ERROR)
+		}
+		// running the entry files of the project
+		_, sourceCode, fileNames = cxcore.ParseArgsForCX(project.EntryPaths(), true)
+		options.replMode = false
+	}
+
+	// Resolving the imports with the manifest of the module of the program.
+	loadModule(fileNames)
 
 	// Propagate some options out to other packages.
 	parser.DebugLexer = options.debugLexer // in package parser
+	actions.OptimizationLevel = options.optimizationLevel
+	cxcore.TAIL_CALLS = options.optimizationLevel > 0
 	DebugProfileRate = options.debugProfile
 	DebugProfile = DebugProfileRate > 0
 
-	if run, bcHeap, sPrgrm := parseProgram(options, fileNames, sourceCode); run {
+	if run, bcHeap, sPrgrm := parseProgram(options, fileNames, sourceCode); run && options.buildMode {
+		buildProgram(options, fileNames)
+	} else if run {
 		runProgram(options, cxArgs, sourceCode, bcHeap, sPrgrm)
 	}
 }
@@ -10,8 +10,8 @@ import (
 
 func main() {
 	cx.CXLogFile(true)
-	if os.Args != nil && len(os.Args) > 1 {
-		Run(os.Args[1:])
-	}
+	// Without arguments, `cx` runs the entry files of the project in the
+	// working directory, or starts the REPL.
+	Run(os.Args[1:])
 
 }
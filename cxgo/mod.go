@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cxcore "github.com/skycoin/cx/cx"
+	"github.com/skycoin/cx/cxgo/cxgo"
+	"github.com/skycoin/cx/cxgo/cxmod"
+)
+
+// modCache returns the directory of the module cache.
+func modCache() string {
+	return filepath.Join(cxcore.PKGPATH, "mod")
+}
+
+// loadModule makes the imports of the program in the files `fileNames` be
+// resolved with the manifest of the module containing the first file, if
+// there's one. The packages not provided by the module or its requirements
+// are still looked for in SRCPATH.
+func loadModule(fileNames []string) {
+	dir := "."
+	if len(fileNames) > 0 {
+		dir = filepath.Dir(fileNames[0])
+	}
+	root, ok := cxmod.FindRoot(dir)
+	if !ok {
+		return
+	}
+
+	loader, err := cxmod.NewLoader(root, modCache())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+	cxgo.FindPackage = func(path string) (string, error) {
+		dir, ok, err := loader.Dir(path)
+		if !ok {
+			return filepath.Join(cxcore.SRCPATH, path), nil
+		}
+		return dir, err
+	}
+}
+
+// isExternalPackage checks if the package imported as `path` is resolved
+// without modules, because it's a core package or it's in SRCPATH.
+func isExternalPackage(path string) bool {
+	if cxcore.IsCorePackage(path) {
+		return true
+	}
+	fi, err := os.Stat(filepath.Join(cxcore.SRCPATH, filepath.FromSlash(path)))
+	return err == nil && fi.IsDir()
+}
+
+// modCommand runs `cx mod <command> [args]` on the module containing the
+// working directory.
+func modCommand(command string, args []string) {
+	fail := func(err error) {
+		fmt.Fprintf(os.Stderr, "cx mod %s: %v\n", command, err)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+
+	if command == "init" {
+		wd, err := os.Getwd()
+		if err != nil {
+			fail(err)
+		}
+		modPath := filepath.Base(wd)
+		if len(args) > 0 {
+			modPath = args[0]
+		}
+		if err := cxmod.Init(wd, modPath); err != nil {
+			fail(err)
+		}
+		return
+	}
+
+	root, ok := cxmod.FindRoot(".")
+	if !ok {
+		fail(fmt.Errorf("%s not found in the current directory or any parent directory", cxmod.ModFileName))
+	}
+
+	switch command {
+	case "tidy":
+		if err := cxmod.Tidy(root, modCache(), isExternalPackage); err != nil {
+			fail(err)
+		}
+	case "vendor":
+		if err := cxmod.Vendor(root, modCache()); err != nil {
+			fail(err)
+		}
+	case "verify":
+		verified, errs := cxmod.Verify(root, modCache())
+		for _, err := range errs {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		if len(errs) > 0 {
+			os.Exit(cxcore.CX_RUNTIME_ERROR)
+		}
+		fmt.Printf("%d modules verified\n", verified)
+	default:
+		fail(fmt.Errorf("unknown command, expected init, tidy, vendor or verify"))
+	}
+}
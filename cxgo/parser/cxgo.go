@@ -12,7 +12,7 @@ import (
 	"strconv"
 )
 
-// var PRGRM = MakeProgram(CALLSTACK_SIZE, STACK_SIZE, INIT_HEAP_SIZE)
+// var PRGRM = MakeProgram(INIT_CALLSTACK_SIZE, STACK_SIZE, INIT_HEAP_SIZE)
 
 func Parse(lexer *Lexer) int {
 	return yyParse(lexer)
@@ -60,18 +60,18 @@ type yyXError struct {
 }
 
 const (
-	yyDefault              = 57495
+	yyDefault              = 57496
 	yyEofCode              = 57344
-	ADDR                   = 57492
+	ADDR                   = 57493
 	ADD_ASSIGN             = 57439
 	ADD_OP                 = 57399
-	AFF                    = 57487
+	AFF                    = 57488
 	AFFVAR                 = 57406
 	AND                    = 57397
 	AND_ASSIGN             = 57440
 	AND_OP                 = 57437
 	ASSIGN                 = 57379
-	BASICTYPE              = 57470
+	BASICTYPE              = 57471
 	BITANDEQ               = 57425
 	BITCLEAR_OP            = 57416
 	BITOREQ                = 57427
@@ -82,25 +82,25 @@ const (
 	BOOLEAN_LITERAL        = 57346
 	BREAK                  = 57467
 	BYTE_LITERAL           = 57347
-	CAFF                   = 57488
+	CAFF                   = 57489
 	CASE                   = 57464
 	CASSIGN                = 57380
-	CLAUSES                = 57478
+	CLAUSES                = 57479
 	COLON                  = 57389
 	COMMA                  = 57367
 	COMMENT                = 57369
 	CONST                  = 57463
 	CONTINUE               = 57468
 	DEC_OP                 = 57428
-	DEF                    = 57475
+	DEF                    = 57476
 	DEFAULT                = 57465
 	DIVEQ                  = 57420
 	DIV_ASSIGN             = 57444
 	DIV_OP                 = 57402
 	DOUBLE_LITERAL         = 57356
-	DPROGRAM               = 57485
-	DSTACK                 = 57484
-	DSTATE                 = 57486
+	DPROGRAM               = 57486
+	DSTACK                 = 57485
+	DSTATE                 = 57487
 	ELSE                   = 57373
 	ENUM                   = 57462
 	EQUAL                  = 57388
@@ -108,10 +108,10 @@ const (
 	EQ_OP                  = 57435
 	EXP                    = 57412
 	EXPEQ                  = 57422
-	EXPR                   = 57476
+	EXPR                   = 57477
 	F32                    = 57450
 	F64                    = 57451
-	FIELD                  = 57477
+	FIELD                  = 57478
 	FLOAT_LITERAL          = 57355
 	FOR                    = 57374
 	FUNC                   = 57357
@@ -129,7 +129,7 @@ const (
 	IF                     = 57372
 	IMPORT                 = 57381
 	INC_OP                 = 57429
-	INFER                  = 57490
+	INFER                  = 57491
 	INT_LITERAL            = 57349
 	LBRACE                 = 57361
 	LBRACK                 = 57363
@@ -156,8 +156,8 @@ const (
 	NEWLINE                = 57378
 	NE_OP                  = 57436
 	NOT                    = 57413
-	OBJECT                 = 57479
-	OBJECTS                = 57480
+	OBJECT                 = 57480
+	OBJECTS                = 57481
 	OP                     = 57358
 	OR                     = 57398
 	OR_ASSIGN              = 57445
@@ -166,12 +166,13 @@ const (
 	PERIOD                 = 57368
 	PLUSEQ                 = 57417
 	PLUSPLUS               = 57407
-	PSTEP                  = 57482
+	PSTEP                  = 57483
 	PTR_OP                 = 57430
+	RANGE                  = 57469
 	RBRACE                 = 57362
 	RBRACK                 = 57364
 	REF_OP                 = 57404
-	REM                    = 57474
+	REM                    = 57475
 	REMAINDER              = 57409
 	REMAINDEREQ            = 57421
 	RETURN                 = 57382
@@ -181,20 +182,20 @@ const (
 	RIGHT_OP               = 57432
 	RPAREN                 = 57360
 	SEMICOLON              = 57377
-	SFUNC                  = 57473
+	SFUNC                  = 57474
 	SHORT_LITERAL          = 57348
-	SPACKAGE               = 57471
-	SSTRUCT                = 57472
-	STEP                   = 57481
+	SPACKAGE               = 57472
+	SSTRUCT                = 57473
+	STEP                   = 57482
 	STR                    = 57456
 	STRING_LITERAL         = 57370
 	STRUCT                 = 57376
 	SUB_ASSIGN             = 57447
 	SUB_OP                 = 57400
 	SWITCH                 = 57466
-	TAG                    = 57489
-	TSTEP                  = 57483
-	TYPE                   = 57469
+	TAG                    = 57490
+	TSTEP                  = 57484
+	TYPE                   = 57470
 	TYPSTRUCT              = 57375
 	UI16                   = 57458
 	UI32                   = 57459
@@ -206,13 +207,13 @@ const (
 	UNSIGNED_INT_LITERAL   = 57353
 	UNSIGNED_LONG_LITERAL  = 57354
 	UNSIGNED_SHORT_LITERAL = 57352
-	VALUE                  = 57491
+	VALUE                  = 57492
 	VAR                    = 57366
 	XOR_ASSIGN             = 57448
 	yyErrCode              = 57345
 
 	yyMaxDepth = 200
-	yyTabOfs   = -240
+	yyTabOfs   = -241
 )
 
 var (
@@ -222,83 +223,83 @@ var (
 	}
 
 	yyXLAT = map[int]int{
-		57377: 0,   // SEMICOLON (217x)
-		57404: 1,   // REF_OP (210x)
-		57359: 2,   // LPAREN (209x)
-		57401: 3,   // MUL_OP (203x)
-		57400: 4,   // SUB_OP (203x)
-		57399: 5,   // ADD_OP (199x)
-		57363: 6,   // LBRACK (197x)
-		57428: 7,   // DEC_OP (184x)
-		57429: 8,   // INC_OP (184x)
-		57362: 9,   // RBRACE (182x)
-		57361: 10,  // LBRACE (179x)
-		57365: 11,  // IDENTIFIER (178x)
-		57367: 12,  // COMMA (159x)
-		57357: 13,  // FUNC (155x)
-		57487: 14,  // AFF (145x)
-		57449: 15,  // BOOL (145x)
-		57450: 16,  // F32 (145x)
-		57451: 17,  // F64 (145x)
-		57453: 18,  // I16 (145x)
-		57454: 19,  // I32 (145x)
-		57455: 20,  // I64 (145x)
-		57452: 21,  // I8 (145x)
-		57360: 22,  // RPAREN (145x)
-		57456: 23,  // STR (145x)
-		57458: 24,  // UI16 (145x)
-		57459: 25,  // UI32 (145x)
-		57460: 26,  // UI64 (145x)
-		57457: 27,  // UI8 (145x)
-		57349: 28,  // INT_LITERAL (136x)
-		57370: 29,  // STRING_LITERAL (129x)
-		57346: 30,  // BOOLEAN_LITERAL (128x)
-		57347: 31,  // BYTE_LITERAL (128x)
-		57356: 32,  // DOUBLE_LITERAL (128x)
-		57355: 33,  // FLOAT_LITERAL (128x)
-		57490: 34,  // INFER (128x)
-		57350: 35,  // LONG_LITERAL (128x)
-		57405: 36,  // NEG_OP (128x)
-		57348: 37,  // SHORT_LITERAL (128x)
-		57351: 38,  // UNSIGNED_BYTE_LITERAL (128x)
-		57353: 39,  // UNSIGNED_INT_LITERAL (128x)
-		57354: 40,  // UNSIGNED_LONG_LITERAL (128x)
-		57352: 41,  // UNSIGNED_SHORT_LITERAL (128x)
-		57389: 42,  // COLON (104x)
-		57364: 43,  // RBRACK (103x)
+		57377: 0,   // SEMICOLON (218x)
+		57404: 1,   // REF_OP (211x)
+		57359: 2,   // LPAREN (210x)
+		57401: 3,   // MUL_OP (204x)
+		57400: 4,   // SUB_OP (204x)
+		57399: 5,   // ADD_OP (200x)
+		57363: 6,   // LBRACK (198x)
+		57428: 7,   // DEC_OP (185x)
+		57429: 8,   // INC_OP (185x)
+		57362: 9,   // RBRACE (183x)
+		57361: 10,  // LBRACE (180x)
+		57365: 11,  // IDENTIFIER (179x)
+		57367: 12,  // COMMA (160x)
+		57357: 13,  // FUNC (156x)
+		57488: 14,  // AFF (146x)
+		57449: 15,  // BOOL (146x)
+		57450: 16,  // F32 (146x)
+		57451: 17,  // F64 (146x)
+		57453: 18,  // I16 (146x)
+		57454: 19,  // I32 (146x)
+		57455: 20,  // I64 (146x)
+		57452: 21,  // I8 (146x)
+		57360: 22,  // RPAREN (146x)
+		57456: 23,  // STR (146x)
+		57458: 24,  // UI16 (146x)
+		57459: 25,  // UI32 (146x)
+		57460: 26,  // UI64 (146x)
+		57457: 27,  // UI8 (146x)
+		57349: 28,  // INT_LITERAL (137x)
+		57370: 29,  // STRING_LITERAL (130x)
+		57346: 30,  // BOOLEAN_LITERAL (129x)
+		57347: 31,  // BYTE_LITERAL (129x)
+		57356: 32,  // DOUBLE_LITERAL (129x)
+		57355: 33,  // FLOAT_LITERAL (129x)
+		57491: 34,  // INFER (129x)
+		57350: 35,  // LONG_LITERAL (129x)
+		57405: 36,  // NEG_OP (129x)
+		57348: 37,  // SHORT_LITERAL (129x)
+		57351: 38,  // UNSIGNED_BYTE_LITERAL (129x)
+		57353: 39,  // UNSIGNED_INT_LITERAL (129x)
+		57354: 40,  // UNSIGNED_LONG_LITERAL (129x)
+		57352: 41,  // UNSIGNED_SHORT_LITERAL (129x)
+		57389: 42,  // COLON (105x)
+		57364: 43,  // RBRACK (104x)
 		63:    44,  // '?' (89x)
 		57438: 45,  // OR_OP (89x)
 		57437: 46,  // AND_OP (88x)
 		57415: 47,  // BITOR_OP (86x)
 		57414: 48,  // BITXOR_OP (84x)
-		57485: 49,  // DPROGRAM (80x)
+		57486: 49,  // DPROGRAM (80x)
 		57435: 50,  // EQ_OP (80x)
 		57384: 51,  // GT_OP (80x)
 		57386: 52,  // GTEQ_OP (80x)
 		57385: 53,  // LT_OP (80x)
 		57387: 54,  // LTEQ_OP (80x)
 		57436: 55,  // NE_OP (80x)
-		57473: 56,  // SFUNC (80x)
-		57471: 57,  // SPACKAGE (80x)
-		57472: 58,  // SSTRUCT (80x)
+		57474: 56,  // SFUNC (80x)
+		57472: 57,  // SPACKAGE (80x)
+		57473: 58,  // SSTRUCT (80x)
 		57416: 59,  // BITCLEAR_OP (78x)
 		57431: 60,  // LEFT_OP (78x)
 		57432: 61,  // RIGHT_OP (78x)
-		57481: 62,  // STEP (77x)
-		57483: 63,  // TSTEP (77x)
+		57482: 62,  // STEP (77x)
+		57484: 63,  // TSTEP (77x)
 		57366: 64,  // VAR (77x)
-		57563: 65,  // type_specifier (74x)
+		57564: 65,  // type_specifier (75x)
 		57379: 66,  // ASSIGN (73x)
-		57402: 67,  // DIV_OP (67x)
-		57529: 68,  // indexing_literal (67x)
+		57530: 67,  // indexing_literal (68x)
+		57402: 68,  // DIV_OP (67x)
 		57403: 69,  // MOD_OP (67x)
-		57554: 70,  // slice_literal_expression (63x)
-		57500: 71,  // array_literal_expression (62x)
-		57372: 72,  // IF (62x)
-		57547: 73,  // postfix_expression (62x)
-		57548: 74,  // primary_expression (62x)
-		57565: 75,  // unary_expression (62x)
-		57566: 76,  // unary_operator (62x)
+		57555: 70,  // slice_literal_expression (64x)
+		57501: 71,  // array_literal_expression (63x)
+		57548: 72,  // postfix_expression (63x)
+		57549: 73,  // primary_expression (63x)
+		57566: 74,  // unary_expression (63x)
+		57567: 75,  // unary_operator (63x)
+		57372: 76,  // IF (62x)
 		57467: 77,  // BREAK (61x)
 		57464: 78,  // CASE (61x)
 		57468: 79,  // CONTINUE (61x)
@@ -319,133 +320,134 @@ var (
 		57446: 94,  // RIGHT_ASSIGN (60x)
 		57447: 95,  // SUB_ASSIGN (60x)
 		57448: 96,  // XOR_ASSIGN (60x)
-		57542: 97,  // multiplicative_expression (55x)
-		57496: 98,  // additive_expression (53x)
-		57553: 99,  // shift_expression (50x)
-		57549: 100, // relational_expression (44x)
-		57498: 101, // and_expression (43x)
-		57517: 102, // exclusive_or_expression (42x)
-		57528: 103, // inclusive_or_expression (41x)
-		57540: 104, // logical_and_expression (40x)
-		57507: 105, // conditional_expression (39x)
-		57541: 106, // logical_or_expression (39x)
-		57560: 107, // struct_literal_expression (33x)
+		57543: 97,  // multiplicative_expression (56x)
+		57497: 98,  // additive_expression (54x)
+		57554: 99,  // shift_expression (51x)
+		57550: 100, // relational_expression (45x)
+		57499: 101, // and_expression (44x)
+		57518: 102, // exclusive_or_expression (43x)
+		57529: 103, // inclusive_or_expression (42x)
+		57541: 104, // logical_and_expression (41x)
+		57508: 105, // conditional_expression (40x)
+		57542: 106, // logical_or_expression (40x)
+		57561: 107, // struct_literal_expression (33x)
 		57381: 108, // IMPORT (32x)
 		57371: 109, // PACKAGE (32x)
-		57469: 110, // TYPE (32x)
+		57470: 110, // TYPE (32x)
 		57344: 111, // $end (31x)
-		57502: 112, // assignment_expression (31x)
-		57506: 113, // compound_statement (19x)
-		57518: 114, // expression (19x)
-		57509: 115, // debugging (14x)
-		57519: 116, // expression_statement (14x)
-		57552: 117, // selector (14x)
-		57537: 118, // iteration_statement (12x)
-		57538: 119, // jump_statement (12x)
-		57539: 120, // labeled_statement (12x)
-		57551: 121, // selection_statement (12x)
-		57556: 122, // statement (12x)
-		57557: 123, // stepping (11x)
-		57504: 124, // block_item (9x)
-		57510: 125, // declaration (9x)
-		57512: 126, // declarator (8x)
-		57513: 127, // direct_declarator (8x)
+		57503: 112, // assignment_expression (31x)
+		57507: 113, // compound_statement (19x)
+		57519: 114, // expression (19x)
+		57510: 115, // debugging (14x)
+		57520: 116, // expression_statement (14x)
+		57553: 117, // selector (14x)
+		57538: 118, // iteration_statement (12x)
+		57539: 119, // jump_statement (12x)
+		57540: 120, // labeled_statement (12x)
+		57552: 121, // selection_statement (12x)
+		57557: 122, // statement (12x)
+		57558: 123, // stepping (11x)
+		57505: 124, // block_item (9x)
+		57511: 125, // declaration (9x)
+		57513: 126, // declarator (8x)
+		57514: 127, // direct_declarator (8x)
 		57373: 128, // ELSE (8x)
-		57511: 129, // declaration_specifiers (5x)
-		57544: 130, // parameter_declaration (5x)
-		57505: 131, // block_item_list (4x)
-		57514: 132, // else_statement (4x)
-		57515: 133, // elseif (4x)
-		57531: 134, // infer_action (4x)
-		57536: 135, // int_value (4x)
-		57508: 136, // constant_expression (3x)
-		57561: 137, // struct_literal_fields (3x)
-		57501: 138, // array_literal_expression_list (2x)
-		57516: 139, // elseif_list (2x)
-		57520: 140, // external_declaration (2x)
-		57522: 141, // function_declaration (2x)
-		57523: 142, // function_header (2x)
-		57524: 143, // function_parameters (2x)
-		57525: 144, // global_declaration (2x)
-		57527: 145, // import_declaration (2x)
-		57535: 146, // initializer (2x)
-		57543: 147, // package_declaration (2x)
-		57545: 148, // parameter_list (2x)
-		57546: 149, // parameter_type_list (2x)
-		57555: 150, // slice_literal_expression_list (2x)
-		57558: 151, // struct_declaration (2x)
-		57559: 152, // struct_fields (2x)
-		57564: 153, // types_list (2x)
-		57493: 154, // $@1 (1x)
-		57494: 155, // $@2 (1x)
-		57497: 156, // after_period (1x)
-		57499: 157, // argument_expression_list (1x)
-		57503: 158, // assignment_operator (1x)
-		57521: 159, // fields (1x)
-		57526: 160, // id_list (1x)
-		57532: 161, // infer_action_arg (1x)
-		57533: 162, // infer_actions (1x)
-		57534: 163, // infer_clauses (1x)
-		57550: 164, // return_expression (1x)
-		57376: 165, // STRUCT (1x)
-		57562: 166, // translation_unit (1x)
-		57495: 167, // $default (0x)
-		57492: 168, // ADDR (0x)
-		57406: 169, // AFFVAR (0x)
-		57397: 170, // AND (0x)
-		57470: 171, // BASICTYPE (0x)
-		57425: 172, // BITANDEQ (0x)
-		57427: 173, // BITOREQ (0x)
-		57426: 174, // BITXOREQ (0x)
-		57488: 175, // CAFF (0x)
-		57478: 176, // CLAUSES (0x)
-		57369: 177, // COMMENT (0x)
-		57463: 178, // CONST (0x)
-		57475: 179, // DEF (0x)
-		57420: 180, // DIVEQ (0x)
-		57484: 181, // DSTACK (0x)
-		57486: 182, // DSTATE (0x)
-		57462: 183, // ENUM (0x)
-		57388: 184, // EQUAL (0x)
-		57391: 185, // EQUALWORD (0x)
-		57345: 186, // error (0x)
-		57412: 187, // EXP (0x)
-		57422: 188, // EXPEQ (0x)
-		57476: 189, // EXPR (0x)
-		57477: 190, // FIELD (0x)
-		57433: 191, // GE_OP (0x)
-		57394: 192, // GTHANEQ (0x)
-		57392: 193, // GTHANWORD (0x)
-		57530: 194, // indexing_slice_literal (0x)
-		57434: 195, // LE_OP (0x)
-		57410: 196, // LEFTSHIFT (0x)
-		57423: 197, // LEFTSHIFTEQ (0x)
-		57395: 198, // LTHANEQ (0x)
-		57393: 199, // LTHANWORD (0x)
-		57418: 200, // MINUSEQ (0x)
-		57408: 201, // MINUSMINUS (0x)
-		57419: 202, // MULTEQ (0x)
-		57390: 203, // NEW (0x)
-		57378: 204, // NEWLINE (0x)
-		57413: 205, // NOT (0x)
-		57479: 206, // OBJECT (0x)
-		57480: 207, // OBJECTS (0x)
-		57358: 208, // OP (0x)
-		57398: 209, // OR (0x)
-		57417: 210, // PLUSEQ (0x)
-		57407: 211, // PLUSPLUS (0x)
-		57482: 212, // PSTEP (0x)
-		57430: 213, // PTR_OP (0x)
-		57474: 214, // REM (0x)
-		57409: 215, // REMAINDER (0x)
-		57421: 216, // REMAINDEREQ (0x)
-		57411: 217, // RIGHTSHIFT (0x)
-		57424: 218, // RIGHTSHIFTEQ (0x)
-		57489: 219, // TAG (0x)
-		57375: 220, // TYPSTRUCT (0x)
-		57396: 221, // UNEQUAL (0x)
-		57461: 222, // UNION (0x)
-		57491: 223, // VALUE (0x)
+		57512: 129, // declaration_specifiers (5x)
+		57545: 130, // parameter_declaration (5x)
+		57506: 131, // block_item_list (4x)
+		57515: 132, // else_statement (4x)
+		57516: 133, // elseif (4x)
+		57532: 134, // infer_action (4x)
+		57537: 135, // int_value (4x)
+		57509: 136, // constant_expression (3x)
+		57562: 137, // struct_literal_fields (3x)
+		57502: 138, // array_literal_expression_list (2x)
+		57517: 139, // elseif_list (2x)
+		57521: 140, // external_declaration (2x)
+		57523: 141, // function_declaration (2x)
+		57524: 142, // function_header (2x)
+		57525: 143, // function_parameters (2x)
+		57526: 144, // global_declaration (2x)
+		57528: 145, // import_declaration (2x)
+		57536: 146, // initializer (2x)
+		57544: 147, // package_declaration (2x)
+		57546: 148, // parameter_list (2x)
+		57547: 149, // parameter_type_list (2x)
+		57556: 150, // slice_literal_expression_list (2x)
+		57559: 151, // struct_declaration (2x)
+		57560: 152, // struct_fields (2x)
+		57565: 153, // types_list (2x)
+		57494: 154, // $@1 (1x)
+		57495: 155, // $@2 (1x)
+		57498: 156, // after_period (1x)
+		57500: 157, // argument_expression_list (1x)
+		57504: 158, // assignment_operator (1x)
+		57522: 159, // fields (1x)
+		57527: 160, // id_list (1x)
+		57533: 161, // infer_action_arg (1x)
+		57534: 162, // infer_actions (1x)
+		57535: 163, // infer_clauses (1x)
+		57469: 164, // RANGE (1x)
+		57551: 165, // return_expression (1x)
+		57376: 166, // STRUCT (1x)
+		57563: 167, // translation_unit (1x)
+		57496: 168, // $default (0x)
+		57493: 169, // ADDR (0x)
+		57406: 170, // AFFVAR (0x)
+		57397: 171, // AND (0x)
+		57471: 172, // BASICTYPE (0x)
+		57425: 173, // BITANDEQ (0x)
+		57427: 174, // BITOREQ (0x)
+		57426: 175, // BITXOREQ (0x)
+		57489: 176, // CAFF (0x)
+		57479: 177, // CLAUSES (0x)
+		57369: 178, // COMMENT (0x)
+		57463: 179, // CONST (0x)
+		57476: 180, // DEF (0x)
+		57420: 181, // DIVEQ (0x)
+		57485: 182, // DSTACK (0x)
+		57487: 183, // DSTATE (0x)
+		57462: 184, // ENUM (0x)
+		57388: 185, // EQUAL (0x)
+		57391: 186, // EQUALWORD (0x)
+		57345: 187, // error (0x)
+		57412: 188, // EXP (0x)
+		57422: 189, // EXPEQ (0x)
+		57477: 190, // EXPR (0x)
+		57478: 191, // FIELD (0x)
+		57433: 192, // GE_OP (0x)
+		57394: 193, // GTHANEQ (0x)
+		57392: 194, // GTHANWORD (0x)
+		57531: 195, // indexing_slice_literal (0x)
+		57434: 196, // LE_OP (0x)
+		57410: 197, // LEFTSHIFT (0x)
+		57423: 198, // LEFTSHIFTEQ (0x)
+		57395: 199, // LTHANEQ (0x)
+		57393: 200, // LTHANWORD (0x)
+		57418: 201, // MINUSEQ (0x)
+		57408: 202, // MINUSMINUS (0x)
+		57419: 203, // MULTEQ (0x)
+		57390: 204, // NEW (0x)
+		57378: 205, // NEWLINE (0x)
+		57413: 206, // NOT (0x)
+		57480: 207, // OBJECT (0x)
+		57481: 208, // OBJECTS (0x)
+		57358: 209, // OP (0x)
+		57398: 210, // OR (0x)
+		57417: 211, // PLUSEQ (0x)
+		57407: 212, // PLUSPLUS (0x)
+		57483: 213, // PSTEP (0x)
+		57430: 214, // PTR_OP (0x)
+		57475: 215, // REM (0x)
+		57409: 216, // REMAINDER (0x)
+		57421: 217, // REMAINDEREQ (0x)
+		57411: 218, // RIGHTSHIFT (0x)
+		57424: 219, // RIGHTSHIFTEQ (0x)
+		57490: 220, // TAG (0x)
+		57375: 221, // TYPSTRUCT (0x)
+		57396: 222, // UNEQUAL (0x)
+		57461: 223, // UNION (0x)
+		57492: 224, // VALUE (0x)
 	}
 
 	yySymNames = []string{
@@ -516,16 +518,16 @@ var (
 		"VAR",
 		"type_specifier",
 		"ASSIGN",
-		"DIV_OP",
 		"indexing_literal",
+		"DIV_OP",
 		"MOD_OP",
 		"slice_literal_expression",
 		"array_literal_expression",
-		"IF",
 		"postfix_expression",
 		"primary_expression",
 		"unary_expression",
 		"unary_operator",
+		"IF",
 		"BREAK",
 		"CASE",
 		"CONTINUE",
@@ -613,6 +615,7 @@ var (
 		"infer_action_arg",
 		"infer_actions",
 		"infer_clauses",
+		"RANGE",
 		"return_expression",
 		"STRUCT",
 		"translation_unit",
@@ -679,8 +682,8 @@ var (
 
 	yyReductions = map[int]struct{ xsym, components int }{
 		0:   {0, 1},
-		1:   {166, 1},
-		2:   {166, 2},
+		1:   {167, 1},
+		2:   {167, 2},
 		3:   {140, 1},
 		4:   {140, 1},
 		5:   {140, 1},
@@ -753,10 +756,10 @@ var (
 		72:  {137, 5},
 		73:  {138, 1},
 		74:  {138, 3},
-		75:  {68, 3},
-		76:  {68, 4},
-		77:  {194, 2},
-		78:  {194, 3},
+		75:  {67, 3},
+		76:  {67, 4},
+		77:  {195, 2},
+		78:  {195, 3},
 		79:  {71, 5},
 		80:  {71, 4},
 		81:  {71, 5},
@@ -781,45 +784,45 @@ var (
 		100: {163, 1},
 		101: {135, 1},
 		102: {135, 2},
-		103: {74, 1},
-		104: {74, 3},
-		105: {74, 4},
-		106: {74, 1},
-		107: {74, 1},
-		108: {74, 1},
-		109: {74, 1},
-		110: {74, 1},
-		111: {74, 1},
-		112: {74, 1},
-		113: {74, 1},
-		114: {74, 1},
-		115: {74, 1},
-		116: {74, 1},
-		117: {74, 1},
-		118: {74, 3},
-		119: {74, 1},
-		120: {74, 1},
+		103: {73, 1},
+		104: {73, 3},
+		105: {73, 4},
+		106: {73, 1},
+		107: {73, 1},
+		108: {73, 1},
+		109: {73, 1},
+		110: {73, 1},
+		111: {73, 1},
+		112: {73, 1},
+		113: {73, 1},
+		114: {73, 1},
+		115: {73, 1},
+		116: {73, 1},
+		117: {73, 1},
+		118: {73, 3},
+		119: {73, 1},
+		120: {73, 1},
 		121: {156, 1},
 		122: {156, 1},
-		123: {73, 1},
-		124: {73, 4},
-		125: {73, 3},
-		126: {73, 3},
-		127: {73, 4},
-		128: {73, 2},
-		129: {73, 2},
-		130: {73, 3},
+		123: {72, 1},
+		124: {72, 4},
+		125: {72, 3},
+		126: {72, 3},
+		127: {72, 4},
+		128: {72, 2},
+		129: {72, 2},
+		130: {72, 3},
 		131: {157, 1},
 		132: {157, 3},
-		133: {75, 1},
-		134: {75, 2},
-		135: {75, 2},
-		136: {75, 2},
-		137: {76, 1},
-		138: {76, 1},
-		139: {76, 1},
-		140: {76, 1},
-		141: {76, 1},
+		133: {74, 1},
+		134: {74, 2},
+		135: {74, 2},
+		136: {74, 2},
+		137: {75, 1},
+		138: {75, 1},
+		139: {75, 1},
+		140: {75, 1},
+		141: {75, 1},
 		142: {97, 1},
 		143: {97, 3},
 		144: {97, 3},
@@ -856,7 +859,7 @@ var (
 		175: {107, 6},
 		176: {112, 1},
 		177: {112, 3},
-		178: {158, 1},
+		178: {112, 4},
 		179: {158, 1},
 		180: {158, 1},
 		181: {158, 1},
@@ -868,13 +871,13 @@ var (
 		187: {158, 1},
 		188: {158, 1},
 		189: {158, 1},
-		190: {114, 1},
-		191: {114, 3},
-		192: {136, 1},
-		193: {125, 4},
-		194: {125, 6},
-		195: {146, 1},
-		196: {122, 1},
+		190: {158, 1},
+		191: {114, 1},
+		192: {114, 3},
+		193: {136, 1},
+		194: {125, 4},
+		195: {125, 6},
+		196: {146, 1},
 		197: {122, 1},
 		198: {122, 1},
 		199: {122, 1},
@@ -882,400 +885,401 @@ var (
 		201: {122, 1},
 		202: {122, 1},
 		203: {122, 1},
-		204: {120, 3},
-		205: {120, 4},
-		206: {120, 3},
-		207: {113, 3},
-		208: {113, 4},
-		209: {131, 1},
-		210: {131, 2},
-		211: {124, 1},
+		204: {122, 1},
+		205: {120, 3},
+		206: {120, 4},
+		207: {120, 3},
+		208: {113, 3},
+		209: {113, 4},
+		210: {131, 1},
+		211: {131, 2},
 		212: {124, 1},
 		213: {124, 1},
-		214: {116, 1},
-		215: {116, 2},
-		216: {121, 8},
-		217: {121, 7},
-		218: {121, 6},
-		219: {121, 7},
-		220: {121, 6},
-		221: {121, 7},
-		222: {121, 3},
-		223: {121, 5},
-		224: {133, 6},
-		225: {133, 5},
-		226: {139, 1},
-		227: {139, 2},
-		228: {132, 4},
-		229: {132, 3},
-		230: {118, 3},
-		231: {118, 4},
-		232: {118, 5},
-		233: {164, 1},
-		234: {164, 3},
-		235: {119, 3},
-		236: {119, 2},
+		214: {124, 1},
+		215: {116, 1},
+		216: {116, 2},
+		217: {121, 8},
+		218: {121, 7},
+		219: {121, 6},
+		220: {121, 7},
+		221: {121, 6},
+		222: {121, 7},
+		223: {121, 3},
+		224: {121, 5},
+		225: {133, 6},
+		226: {133, 5},
+		227: {139, 1},
+		228: {139, 2},
+		229: {132, 4},
+		230: {132, 3},
+		231: {118, 3},
+		232: {118, 4},
+		233: {118, 5},
+		234: {165, 1},
+		235: {165, 3},
+		236: {119, 3},
 		237: {119, 2},
 		238: {119, 2},
-		239: {119, 3},
+		239: {119, 2},
+		240: {119, 3},
 	}
 
 	yyXErrors = map[yyXError]string{}
 
-	yyParseTab = [434][]uint16{
+	yyParseTab = [436][]uint16{
 		// 0
-		{13: 261, 49: 251, 56: 255, 254, 256, 62: 253, 252, 257, 108: 260, 259, 258, 115: 250, 117: 249, 123: 248, 140: 242, 245, 262, 144: 244, 246, 147: 243, 151: 247, 166: 241},
-		{13: 261, 49: 251, 56: 255, 254, 256, 62: 253, 252, 257, 108: 260, 259, 258, 240, 115: 250, 117: 249, 123: 248, 140: 673, 245, 262, 144: 244, 246, 147: 243, 151: 247},
-		{13: 239, 49: 239, 56: 239, 239, 239, 62: 239, 239, 239, 108: 239, 239, 239, 239},
+		{13: 262, 49: 252, 56: 256, 255, 257, 62: 254, 253, 258, 108: 261, 260, 259, 115: 251, 117: 250, 123: 249, 140: 243, 246, 263, 144: 245, 247, 147: 244, 151: 248, 167: 242},
+		{13: 262, 49: 252, 56: 256, 255, 257, 62: 254, 253, 258, 108: 261, 260, 259, 241, 115: 251, 117: 250, 123: 249, 140: 676, 246, 263, 144: 245, 247, 147: 244, 151: 248},
+		{13: 240, 49: 240, 56: 240, 240, 240, 62: 240, 240, 240, 108: 240, 240, 240, 240},
+		{13: 238, 49: 238, 56: 238, 238, 238, 62: 238, 238, 238, 108: 238, 238, 238, 238},
 		{13: 237, 49: 237, 56: 237, 237, 237, 62: 237, 237, 237, 108: 237, 237, 237, 237},
-		{13: 236, 49: 236, 56: 236, 236, 236, 62: 236, 236, 236, 108: 236, 236, 236, 236},
 		// 5
+		{13: 236, 49: 236, 56: 236, 236, 236, 62: 236, 236, 236, 108: 236, 236, 236, 236},
 		{13: 235, 49: 235, 56: 235, 235, 235, 62: 235, 235, 235, 108: 235, 235, 235, 235},
 		{13: 234, 49: 234, 56: 234, 234, 234, 62: 234, 234, 234, 108: 234, 234, 234, 234},
 		{13: 233, 49: 233, 56: 233, 233, 233, 62: 233, 233, 233, 108: 233, 233, 233, 233},
 		{13: 232, 49: 232, 56: 232, 232, 232, 62: 232, 232, 232, 108: 232, 232, 232, 232},
-		{13: 231, 49: 231, 56: 231, 231, 231, 62: 231, 231, 231, 108: 231, 231, 231, 231},
 		// 10
-		{13: 230, 49: 230, 56: 230, 230, 230, 62: 230, 230, 230, 108: 230, 230, 230, 230},
-		{229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 13: 229, 229, 229, 229, 229, 229, 229, 229, 229, 23: 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 49: 229, 56: 229, 229, 229, 62: 229, 229, 229, 72: 229, 77: 229, 229, 229, 229, 229, 229, 229, 229, 108: 229, 229, 229, 229},
-		{4: 578, 28: 577, 135: 671},
-		{4: 578, 28: 577, 135: 670},
-		{11: 668},
+		{13: 231, 49: 231, 56: 231, 231, 231, 62: 231, 231, 231, 108: 231, 231, 231, 231},
+		{230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 13: 230, 230, 230, 230, 230, 230, 230, 230, 230, 23: 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 49: 230, 56: 230, 230, 230, 62: 230, 230, 230, 76: 230, 230, 230, 230, 230, 230, 230, 230, 230, 108: 230, 230, 230, 230},
+		{4: 581, 28: 580, 135: 674},
+		{4: 581, 28: 580, 135: 673},
+		{11: 671},
 		// 15
-		{11: 665},
-		{11: 661},
-		{2: 448, 11: 447, 126: 655, 446},
-		{11: 642},
-		{11: 640},
+		{11: 668},
+		{11: 664},
+		{2: 449, 11: 448, 126: 658, 447},
+		{11: 645},
+		{11: 643},
 		// 20
-		{29: 638},
-		{2: 634, 11: 633},
-		{2: 263, 143: 264},
-		{2: 448, 11: 447, 22: 624, 126: 628, 446, 130: 627, 148: 626, 625},
-		{2: 263, 10: 267, 113: 265, 143: 266},
+		{29: 641},
+		{2: 637, 11: 636},
+		{2: 264, 143: 265},
+		{2: 449, 11: 448, 22: 627, 126: 631, 447, 130: 630, 148: 629, 628},
+		{2: 264, 10: 268, 113: 266, 143: 267},
 		// 25
-		{13: 207, 49: 207, 56: 207, 207, 207, 62: 207, 207, 207, 108: 207, 207, 207, 207},
-		{10: 267, 113: 623},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 337, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 339, 340, 131: 338},
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 12: 183, 22: 183, 42: 183, 183, 183, 183, 183, 183, 183, 50: 183, 183, 183, 183, 183, 183, 59: 183, 183, 183, 66: 183, 183, 69: 183, 85: 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183},
-		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 12: 182, 22: 182, 42: 182, 182, 182, 182, 182, 182, 182, 50: 182, 182, 182, 182, 182, 182, 59: 182, 182, 182, 66: 182, 182, 69: 182, 85: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182},
+		{13: 208, 49: 208, 56: 208, 208, 208, 62: 208, 208, 208, 108: 208, 208, 208, 208},
+		{10: 268, 113: 626},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 338, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 340, 341, 131: 339},
+		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 12: 184, 22: 184, 42: 184, 184, 184, 184, 184, 184, 184, 50: 184, 184, 184, 184, 184, 184, 59: 184, 184, 184, 66: 184, 68: 184, 184, 85: 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184},
+		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 12: 183, 22: 183, 42: 183, 183, 183, 183, 183, 183, 183, 50: 183, 183, 183, 183, 183, 183, 59: 183, 183, 183, 66: 183, 68: 183, 183, 85: 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183},
 		// 30
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 12: 181, 22: 181, 42: 181, 181, 181, 181, 181, 181, 181, 50: 181, 181, 181, 181, 181, 181, 59: 181, 181, 181, 66: 181, 181, 69: 181, 85: 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181},
-		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 12: 180, 22: 180, 42: 180, 180, 180, 180, 180, 180, 180, 50: 180, 180, 180, 180, 180, 180, 59: 180, 180, 180, 66: 180, 180, 69: 180, 85: 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180},
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 12: 179, 22: 179, 42: 179, 179, 179, 179, 179, 179, 179, 50: 179, 179, 179, 179, 179, 179, 59: 179, 179, 179, 66: 179, 179, 69: 179, 85: 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
-		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 12: 178, 22: 178, 42: 178, 178, 178, 178, 178, 178, 178, 50: 178, 178, 178, 178, 178, 178, 59: 178, 178, 178, 66: 178, 178, 69: 178, 85: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
-		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 12: 177, 22: 177, 42: 177, 177, 177, 177, 177, 177, 177, 50: 177, 177, 177, 177, 177, 177, 59: 177, 177, 177, 66: 177, 177, 69: 177, 85: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177},
+		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 12: 182, 22: 182, 42: 182, 182, 182, 182, 182, 182, 182, 50: 182, 182, 182, 182, 182, 182, 59: 182, 182, 182, 66: 182, 68: 182, 182, 85: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182},
+		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 12: 181, 22: 181, 42: 181, 181, 181, 181, 181, 181, 181, 50: 181, 181, 181, 181, 181, 181, 59: 181, 181, 181, 66: 181, 68: 181, 181, 85: 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181},
+		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 12: 180, 22: 180, 42: 180, 180, 180, 180, 180, 180, 180, 50: 180, 180, 180, 180, 180, 180, 59: 180, 180, 180, 66: 180, 68: 180, 180, 85: 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180},
+		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 12: 179, 22: 179, 42: 179, 179, 179, 179, 179, 179, 179, 50: 179, 179, 179, 179, 179, 179, 59: 179, 179, 179, 66: 179, 68: 179, 179, 85: 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
+		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 12: 178, 22: 178, 42: 178, 178, 178, 178, 178, 178, 178, 50: 178, 178, 178, 178, 178, 178, 59: 178, 178, 178, 66: 178, 68: 178, 178, 85: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
 		// 35
-		{176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 12: 176, 22: 176, 42: 176, 176, 176, 176, 176, 176, 176, 50: 176, 176, 176, 176, 176, 176, 59: 176, 176, 176, 66: 176, 176, 69: 176, 85: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176},
-		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 12: 175, 22: 175, 42: 175, 175, 175, 175, 175, 175, 175, 50: 175, 175, 175, 175, 175, 175, 59: 175, 175, 175, 66: 175, 175, 69: 175, 85: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175},
-		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 12: 174, 22: 174, 42: 174, 174, 174, 174, 174, 174, 174, 50: 174, 174, 174, 174, 174, 174, 59: 174, 174, 174, 66: 174, 174, 69: 174, 85: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174},
-		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 12: 173, 22: 173, 42: 173, 173, 173, 173, 173, 173, 173, 50: 173, 173, 173, 173, 173, 173, 59: 173, 173, 173, 66: 173, 173, 69: 173, 85: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
-		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 12: 172, 22: 172, 42: 172, 172, 172, 172, 172, 172, 172, 50: 172, 172, 172, 172, 172, 172, 59: 172, 172, 172, 66: 172, 172, 69: 172, 85: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
+		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 12: 177, 22: 177, 42: 177, 177, 177, 177, 177, 177, 177, 50: 177, 177, 177, 177, 177, 177, 59: 177, 177, 177, 66: 177, 68: 177, 177, 85: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177},
+		{176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 12: 176, 22: 176, 42: 176, 176, 176, 176, 176, 176, 176, 50: 176, 176, 176, 176, 176, 176, 59: 176, 176, 176, 66: 176, 68: 176, 176, 85: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176},
+		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 12: 175, 22: 175, 42: 175, 175, 175, 175, 175, 175, 175, 50: 175, 175, 175, 175, 175, 175, 59: 175, 175, 175, 66: 175, 68: 175, 175, 85: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175},
+		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 12: 174, 22: 174, 42: 174, 174, 174, 174, 174, 174, 174, 50: 174, 174, 174, 174, 174, 174, 59: 174, 174, 174, 66: 174, 68: 174, 174, 85: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174},
+		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 12: 173, 22: 173, 42: 173, 173, 173, 173, 173, 173, 173, 50: 173, 173, 173, 173, 173, 173, 59: 173, 173, 173, 66: 173, 68: 173, 173, 85: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
 		// 40
-		{171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 12: 171, 22: 171, 42: 171, 171, 171, 171, 171, 171, 171, 50: 171, 171, 171, 171, 171, 171, 59: 171, 171, 171, 66: 171, 171, 69: 171, 85: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171},
-		{28: 472, 43: 607},
-		{6: 464, 11: 594, 14: 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 595},
-		{137, 137, 137, 137, 137, 137, 137, 137, 137, 10: 359, 12: 137, 42: 592, 44: 137, 137, 137, 137, 137, 50: 137, 137, 137, 137, 137, 137, 59: 137, 137, 137, 66: 137, 137, 69: 137, 85: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137},
-		{2: 590},
+		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 12: 172, 22: 172, 42: 172, 172, 172, 172, 172, 172, 172, 50: 172, 172, 172, 172, 172, 172, 59: 172, 172, 172, 66: 172, 68: 172, 172, 85: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
+		{28: 473, 43: 610},
+		{6: 465, 11: 597, 14: 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 598},
+		{138, 138, 138, 138, 138, 138, 138, 138, 138, 10: 360, 12: 138, 42: 595, 44: 138, 138, 138, 138, 138, 50: 138, 138, 138, 138, 138, 138, 59: 138, 138, 138, 66: 138, 68: 138, 138, 85: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138},
+		{2: 593},
 		// 45
-		{10: 562},
-		{134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 12: 134, 22: 134, 42: 134, 134, 134, 134, 134, 134, 134, 50: 134, 134, 134, 134, 134, 134, 59: 134, 134, 134, 66: 134, 134, 69: 134, 85: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 12: 133, 22: 133, 42: 133, 133, 133, 133, 133, 133, 133, 50: 133, 133, 133, 133, 133, 133, 59: 133, 133, 133, 66: 133, 133, 69: 133, 85: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 12: 132, 22: 132, 42: 132, 132, 132, 132, 132, 132, 132, 50: 132, 132, 132, 132, 132, 132, 59: 132, 132, 132, 66: 132, 132, 69: 132, 85: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
-		{131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 12: 131, 22: 131, 42: 131, 131, 131, 131, 131, 131, 131, 50: 131, 131, 131, 131, 131, 131, 59: 131, 131, 131, 66: 131, 131, 69: 131, 85: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131},
+		{10: 565},
+		{135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 12: 135, 22: 135, 42: 135, 135, 135, 135, 135, 135, 135, 50: 135, 135, 135, 135, 135, 135, 59: 135, 135, 135, 66: 135, 68: 135, 135, 85: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135},
+		{134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 12: 134, 22: 134, 42: 134, 134, 134, 134, 134, 134, 134, 50: 134, 134, 134, 134, 134, 134, 59: 134, 134, 134, 66: 134, 68: 134, 134, 85: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134},
+		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 12: 133, 22: 133, 42: 133, 133, 133, 133, 133, 133, 133, 50: 133, 133, 133, 133, 133, 133, 59: 133, 133, 133, 66: 133, 68: 133, 133, 85: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
+		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 12: 132, 22: 132, 42: 132, 132, 132, 132, 132, 132, 132, 50: 132, 132, 132, 132, 132, 132, 59: 132, 132, 132, 66: 132, 68: 132, 132, 85: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
 		// 50
-		{130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 12: 130, 22: 130, 42: 130, 130, 130, 130, 130, 130, 130, 50: 130, 130, 130, 130, 130, 130, 59: 130, 130, 130, 66: 130, 130, 69: 130, 85: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130},
-		{129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 12: 129, 22: 129, 42: 129, 129, 129, 129, 129, 129, 129, 50: 129, 129, 129, 129, 129, 129, 59: 129, 129, 129, 66: 129, 129, 69: 129, 85: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129},
-		{128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 12: 128, 22: 128, 42: 128, 128, 128, 128, 128, 128, 128, 50: 128, 128, 128, 128, 128, 128, 59: 128, 128, 128, 66: 128, 128, 69: 128, 85: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128},
-		{127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 12: 127, 22: 127, 42: 127, 127, 127, 127, 127, 127, 127, 50: 127, 127, 127, 127, 127, 127, 59: 127, 127, 127, 66: 127, 127, 69: 127, 85: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127},
-		{126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 12: 126, 22: 126, 42: 126, 126, 126, 126, 126, 126, 126, 50: 126, 126, 126, 126, 126, 126, 59: 126, 126, 126, 66: 126, 126, 69: 126, 85: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126},
+		{131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 12: 131, 22: 131, 42: 131, 131, 131, 131, 131, 131, 131, 50: 131, 131, 131, 131, 131, 131, 59: 131, 131, 131, 66: 131, 68: 131, 131, 85: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131},
+		{130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 12: 130, 22: 130, 42: 130, 130, 130, 130, 130, 130, 130, 50: 130, 130, 130, 130, 130, 130, 59: 130, 130, 130, 66: 130, 68: 130, 130, 85: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130},
+		{129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 12: 129, 22: 129, 42: 129, 129, 129, 129, 129, 129, 129, 50: 129, 129, 129, 129, 129, 129, 59: 129, 129, 129, 66: 129, 68: 129, 129, 85: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129},
+		{128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 12: 128, 22: 128, 42: 128, 128, 128, 128, 128, 128, 128, 50: 128, 128, 128, 128, 128, 128, 59: 128, 128, 128, 66: 128, 68: 128, 128, 85: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128},
+		{127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 12: 127, 22: 127, 42: 127, 127, 127, 127, 127, 127, 127, 50: 127, 127, 127, 127, 127, 127, 59: 127, 127, 127, 66: 127, 68: 127, 127, 85: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127},
 		// 55
-		{125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 12: 125, 22: 125, 42: 125, 125, 125, 125, 125, 125, 125, 50: 125, 125, 125, 125, 125, 125, 59: 125, 125, 125, 66: 125, 125, 69: 125, 85: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125},
-		{124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 12: 124, 22: 124, 42: 124, 124, 124, 124, 124, 124, 124, 50: 124, 124, 124, 124, 124, 124, 59: 124, 124, 124, 66: 124, 124, 69: 124, 85: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124},
-		{123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 12: 123, 22: 123, 42: 123, 123, 123, 123, 123, 123, 123, 50: 123, 123, 123, 123, 123, 123, 59: 123, 123, 123, 66: 123, 123, 69: 123, 85: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 114: 560},
-		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 12: 121, 22: 121, 42: 121, 121, 121, 121, 121, 121, 121, 50: 121, 121, 121, 121, 121, 121, 59: 121, 121, 121, 66: 121, 121, 69: 121, 85: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121},
+		{126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 12: 126, 22: 126, 42: 126, 126, 126, 126, 126, 126, 126, 50: 126, 126, 126, 126, 126, 126, 59: 126, 126, 126, 66: 126, 68: 126, 126, 85: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126},
+		{125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 12: 125, 22: 125, 42: 125, 125, 125, 125, 125, 125, 125, 50: 125, 125, 125, 125, 125, 125, 59: 125, 125, 125, 66: 125, 68: 125, 125, 85: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125},
+		{124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 12: 124, 22: 124, 42: 124, 124, 124, 124, 124, 124, 124, 50: 124, 124, 124, 124, 124, 124, 59: 124, 124, 124, 66: 124, 68: 124, 124, 85: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 114: 563},
+		{122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 12: 122, 22: 122, 42: 122, 122, 122, 122, 122, 122, 122, 50: 122, 122, 122, 122, 122, 122, 59: 122, 122, 122, 66: 122, 68: 122, 122, 85: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122},
 		// 60
-		{120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 12: 120, 22: 120, 42: 120, 120, 120, 120, 120, 120, 120, 50: 120, 120, 120, 120, 120, 120, 59: 120, 120, 120, 66: 120, 120, 69: 120, 85: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
-		{117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 12: 117, 22: 117, 42: 117, 117, 117, 117, 117, 117, 117, 50: 117, 117, 117, 117, 117, 117, 59: 117, 117, 117, 66: 117, 117, 69: 117, 85: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
-		{107, 107, 373, 107, 107, 107, 372, 375, 374, 107, 107, 12: 107, 22: 107, 42: 107, 107, 107, 107, 107, 107, 107, 50: 107, 107, 107, 107, 107, 107, 59: 107, 107, 107, 66: 107, 107, 69: 107, 85: 107, 107, 107, 107, 107, 107, 107, 107, 555, 107, 107, 107},
-		{93: 551},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 550, 369},
+		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 12: 121, 22: 121, 42: 121, 121, 121, 121, 121, 121, 121, 50: 121, 121, 121, 121, 121, 121, 59: 121, 121, 121, 66: 121, 68: 121, 121, 85: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121},
+		{118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 12: 118, 22: 118, 42: 118, 118, 118, 118, 118, 118, 118, 50: 118, 118, 118, 118, 118, 118, 59: 118, 118, 118, 66: 118, 68: 118, 118, 85: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118},
+		{108, 108, 374, 108, 108, 108, 373, 376, 375, 108, 108, 12: 108, 22: 108, 42: 108, 108, 108, 108, 108, 108, 108, 50: 108, 108, 108, 108, 108, 108, 59: 108, 108, 108, 66: 108, 68: 108, 108, 85: 108, 108, 108, 108, 108, 108, 108, 108, 558, 108, 108, 108},
+		{93: 554},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 553, 370},
 		// 65
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 549, 369},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 545, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 371, 369},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 552, 370},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 548, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 372, 370},
+		{1: 104, 104, 104, 104, 104, 104, 104, 104, 11: 104, 13: 104, 104, 104, 104, 104, 104, 104, 104, 104, 23: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104},
 		{1: 103, 103, 103, 103, 103, 103, 103, 103, 11: 103, 13: 103, 103, 103, 103, 103, 103, 103, 103, 103, 23: 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103},
 		{1: 102, 102, 102, 102, 102, 102, 102, 102, 11: 102, 13: 102, 102, 102, 102, 102, 102, 102, 102, 102, 23: 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102},
-		{1: 101, 101, 101, 101, 101, 101, 101, 101, 11: 101, 13: 101, 101, 101, 101, 101, 101, 101, 101, 101, 23: 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101},
 		// 70
+		{1: 101, 101, 101, 101, 101, 101, 101, 101, 11: 101, 13: 101, 101, 101, 101, 101, 101, 101, 101, 101, 23: 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101},
 		{1: 100, 100, 100, 100, 100, 100, 100, 100, 11: 100, 13: 100, 100, 100, 100, 100, 100, 100, 100, 100, 23: 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100},
-		{1: 99, 99, 99, 99, 99, 99, 99, 99, 11: 99, 13: 99, 99, 99, 99, 99, 99, 99, 99, 99, 23: 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99},
-		{98, 98, 3: 98, 98, 98, 9: 98, 98, 12: 98, 22: 98, 42: 98, 98, 98, 98, 98, 98, 98, 50: 98, 98, 98, 98, 98, 98, 59: 98, 98, 98, 66: 532, 98, 69: 98, 85: 537, 541, 533, 535, 539, 536, 534, 543, 94: 540, 538, 542, 158: 531},
-		{94, 94, 3: 517, 94, 94, 9: 94, 94, 12: 94, 22: 94, 42: 94, 94, 94, 94, 94, 94, 94, 50: 94, 94, 94, 94, 94, 94, 59: 94, 94, 94, 67: 518, 69: 519},
-		{91, 91, 4: 515, 514, 9: 91, 91, 12: 91, 22: 91, 42: 91, 91, 91, 91, 91, 91, 91, 50: 91, 91, 91, 91, 91, 91, 59: 91, 91, 91},
+		{99, 99, 3: 99, 99, 99, 9: 99, 99, 12: 99, 22: 99, 42: 99, 99, 99, 99, 99, 99, 99, 50: 99, 99, 99, 99, 99, 99, 59: 99, 99, 99, 66: 534, 68: 99, 99, 85: 538, 542, 533, 536, 540, 537, 535, 544, 94: 541, 539, 543, 158: 532},
+		{95, 95, 3: 518, 95, 95, 9: 95, 95, 12: 95, 22: 95, 42: 95, 95, 95, 95, 95, 95, 95, 50: 95, 95, 95, 95, 95, 95, 59: 95, 95, 95, 68: 519, 520},
+		{92, 92, 4: 516, 515, 9: 92, 92, 12: 92, 22: 92, 42: 92, 92, 92, 92, 92, 92, 92, 50: 92, 92, 92, 92, 92, 92, 59: 92, 92, 92},
 		// 75
-		{87, 87, 9: 87, 87, 12: 87, 22: 87, 42: 87, 87, 87, 87, 87, 87, 87, 50: 87, 87, 87, 87, 87, 87, 59: 512, 510, 511},
-		{80, 80, 9: 80, 80, 12: 80, 22: 80, 42: 80, 80, 80, 80, 80, 80, 80, 50: 503, 506, 508, 505, 507, 504},
-		{78, 501, 9: 78, 78, 12: 78, 22: 78, 42: 78, 78, 78, 78, 78, 78, 78},
-		{76, 9: 76, 76, 12: 76, 22: 76, 42: 76, 76, 76, 76, 76, 76, 499},
-		{74, 9: 74, 74, 12: 74, 22: 74, 42: 74, 74, 74, 74, 74, 497},
+		{88, 88, 9: 88, 88, 12: 88, 22: 88, 42: 88, 88, 88, 88, 88, 88, 88, 50: 88, 88, 88, 88, 88, 88, 59: 513, 511, 512},
+		{81, 81, 9: 81, 81, 12: 81, 22: 81, 42: 81, 81, 81, 81, 81, 81, 81, 50: 504, 507, 509, 506, 508, 505},
+		{79, 502, 9: 79, 79, 12: 79, 22: 79, 42: 79, 79, 79, 79, 79, 79, 79},
+		{77, 9: 77, 77, 12: 77, 22: 77, 42: 77, 77, 77, 77, 77, 77, 500},
+		{75, 9: 75, 75, 12: 75, 22: 75, 42: 75, 75, 75, 75, 75, 498},
 		// 80
-		{72, 9: 72, 72, 12: 72, 22: 72, 42: 72, 72, 72, 72, 495},
-		{70, 9: 70, 70, 12: 70, 22: 70, 42: 70, 70, 490, 489},
-		{68, 9: 68, 68, 12: 68, 22: 68, 42: 68, 68},
-		{64, 9: 64, 64, 12: 64, 22: 64, 42: 64, 64},
+		{73, 9: 73, 73, 12: 73, 22: 73, 42: 73, 73, 73, 73, 496},
+		{71, 9: 71, 71, 12: 71, 22: 71, 42: 71, 71, 491, 490},
+		{69, 9: 69, 69, 12: 69, 22: 69, 42: 69, 69},
+		{65, 9: 65, 65, 12: 65, 22: 65, 42: 65, 65},
 		{50, 10: 50, 12: 50, 22: 50, 42: 50, 50},
 		// 85
-		{400, 12: 386},
-		{2: 448, 11: 447, 126: 449, 446},
-		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 13: 44, 44, 44, 44, 44, 44, 44, 44, 44, 23: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 49: 44, 56: 44, 44, 44, 62: 44, 44, 44, 72: 44, 77: 44, 44, 44, 44, 44, 44, 44, 44},
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 13: 43, 43, 43, 43, 43, 43, 43, 43, 43, 23: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 49: 43, 56: 43, 43, 43, 62: 43, 43, 43, 72: 43, 77: 43, 43, 43, 43, 43, 43, 43, 43},
-		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 13: 42, 42, 42, 42, 42, 42, 42, 42, 42, 23: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 49: 42, 56: 42, 42, 42, 62: 42, 42, 42, 72: 42, 77: 42, 42, 42, 42, 42, 42, 42, 42},
+		{401, 12: 387},
+		{2: 449, 11: 448, 126: 450, 447},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 13: 44, 44, 44, 44, 44, 44, 44, 44, 44, 23: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 49: 44, 56: 44, 44, 44, 62: 44, 44, 44, 76: 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 13: 43, 43, 43, 43, 43, 43, 43, 43, 43, 23: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 49: 43, 56: 43, 43, 43, 62: 43, 43, 43, 76: 43, 43, 43, 43, 43, 43, 43, 43, 43},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 13: 42, 42, 42, 42, 42, 42, 42, 42, 42, 23: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 49: 42, 56: 42, 42, 42, 62: 42, 42, 42, 76: 42, 42, 42, 42, 42, 42, 42, 42, 42},
 		// 90
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 13: 41, 41, 41, 41, 41, 41, 41, 41, 41, 23: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 49: 41, 56: 41, 41, 41, 62: 41, 41, 41, 72: 41, 77: 41, 41, 41, 41, 41, 41, 41, 41},
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 13: 40, 40, 40, 40, 40, 40, 40, 40, 40, 23: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 49: 40, 56: 40, 40, 40, 62: 40, 40, 40, 72: 40, 77: 40, 40, 40, 40, 40, 40, 40, 40},
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 13: 39, 39, 39, 39, 39, 39, 39, 39, 39, 23: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 49: 39, 56: 39, 39, 39, 62: 39, 39, 39, 72: 39, 77: 39, 39, 39, 39, 39, 39, 39, 39},
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 13: 38, 38, 38, 38, 38, 38, 38, 38, 38, 23: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 49: 38, 56: 38, 38, 38, 62: 38, 38, 38, 72: 38, 77: 38, 38, 38, 38, 38, 38, 38, 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 13: 37, 37, 37, 37, 37, 37, 37, 37, 37, 23: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 49: 37, 56: 37, 37, 37, 62: 37, 37, 37, 72: 37, 77: 37, 37, 37, 37, 37, 37, 37, 37},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 13: 41, 41, 41, 41, 41, 41, 41, 41, 41, 23: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 49: 41, 56: 41, 41, 41, 62: 41, 41, 41, 76: 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 13: 40, 40, 40, 40, 40, 40, 40, 40, 40, 23: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 49: 40, 56: 40, 40, 40, 62: 40, 40, 40, 76: 40, 40, 40, 40, 40, 40, 40, 40, 40},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 13: 39, 39, 39, 39, 39, 39, 39, 39, 39, 23: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 49: 39, 56: 39, 39, 39, 62: 39, 39, 39, 76: 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 13: 38, 38, 38, 38, 38, 38, 38, 38, 38, 23: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 49: 38, 56: 38, 38, 38, 62: 38, 38, 38, 76: 38, 38, 38, 38, 38, 38, 38, 38, 38},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 13: 37, 37, 37, 37, 37, 37, 37, 37, 37, 23: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 49: 37, 56: 37, 37, 37, 62: 37, 37, 37, 76: 37, 37, 37, 37, 37, 37, 37, 37, 37},
 		// 95
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 319, 320, 370, 321, 136: 443},
-		{42: 441},
-		{433},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 440, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 412, 340},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 13: 31, 31, 31, 31, 31, 31, 31, 31, 31, 23: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 49: 31, 56: 31, 31, 31, 62: 31, 31, 31, 72: 31, 77: 31, 31, 31, 31, 31, 31, 31, 31},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 321, 371, 322, 136: 444},
+		{42: 442},
+		{434},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 441, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 413, 341},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 13: 31, 31, 31, 31, 31, 31, 31, 31, 31, 23: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 49: 31, 56: 31, 31, 31, 62: 31, 31, 31, 76: 31, 31, 31, 31, 31, 31, 31, 31, 31},
 		// 100
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 13: 29, 29, 29, 29, 29, 29, 29, 29, 29, 23: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 49: 29, 56: 29, 29, 29, 62: 29, 29, 29, 72: 29, 77: 29, 29, 29, 29, 29, 29, 29, 29},
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 13: 28, 28, 28, 28, 28, 28, 28, 28, 28, 23: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 49: 28, 56: 28, 28, 28, 62: 28, 28, 28, 72: 28, 77: 28, 28, 28, 28, 28, 28, 28, 28},
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 13: 27, 27, 27, 27, 27, 27, 27, 27, 27, 23: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 49: 27, 56: 27, 27, 27, 62: 27, 27, 27, 72: 27, 77: 27, 27, 27, 27, 27, 27, 27, 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 13: 26, 26, 26, 26, 26, 26, 26, 26, 26, 23: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 49: 26, 56: 26, 26, 26, 62: 26, 26, 26, 72: 26, 77: 26, 26, 26, 26, 26, 26, 26, 26},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 319, 320, 406, 321},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 13: 29, 29, 29, 29, 29, 29, 29, 29, 29, 23: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 49: 29, 56: 29, 29, 29, 62: 29, 29, 29, 76: 29, 29, 29, 29, 29, 29, 29, 29, 29},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 13: 28, 28, 28, 28, 28, 28, 28, 28, 28, 23: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 49: 28, 56: 28, 28, 28, 62: 28, 28, 28, 76: 28, 28, 28, 28, 28, 28, 28, 28, 28},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 13: 27, 27, 27, 27, 27, 27, 27, 27, 27, 23: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 49: 27, 56: 27, 27, 27, 62: 27, 27, 27, 76: 27, 27, 27, 27, 27, 27, 27, 27, 27},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 13: 26, 26, 26, 26, 26, 26, 26, 26, 26, 23: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 49: 26, 56: 26, 26, 26, 62: 26, 26, 26, 76: 26, 26, 26, 26, 26, 26, 26, 26, 26},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 321, 407, 322},
 		// 105
-		{2: 402},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 114: 394, 116: 395},
-		{11: 392},
+		{2: 403},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 114: 395, 116: 396},
+		{11: 393},
+		{392},
 		{391},
-		{390},
 		// 110
-		{355, 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 352, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 353, 164: 354},
-		{137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 359, 12: 137, 22: 137, 42: 137, 137, 137, 137, 137, 137, 137, 50: 137, 137, 137, 137, 137, 137, 59: 137, 137, 137, 66: 137, 137, 69: 137, 85: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137},
-		{98, 98, 3: 98, 98, 98, 9: 98, 98, 12: 98, 22: 98, 42: 98, 98, 98, 98, 98, 98, 98, 50: 98, 98, 98, 98, 98, 98, 59: 98, 98, 98, 67: 98, 69: 98},
+		{356, 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 353, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 354, 165: 355},
+		{138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 360, 12: 138, 22: 138, 42: 138, 138, 138, 138, 138, 138, 138, 50: 138, 138, 138, 138, 138, 138, 59: 138, 138, 138, 66: 138, 68: 138, 138, 85: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138},
+		{99, 99, 3: 99, 99, 99, 9: 99, 99, 12: 99, 22: 99, 42: 99, 99, 99, 99, 99, 99, 99, 50: 99, 99, 99, 99, 99, 99, 59: 99, 99, 99, 68: 99, 99},
 		{7, 12: 7},
-		{357, 12: 356},
+		{358, 12: 357},
 		// 115
-		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 13: 2, 2, 2, 2, 2, 2, 2, 2, 2, 23: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 49: 2, 56: 2, 2, 2, 62: 2, 2, 2, 72: 2, 77: 2, 2, 2, 2, 2, 2, 2, 2},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 352, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 358},
-		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 13: 1, 1, 1, 1, 1, 1, 1, 1, 1, 23: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 49: 1, 56: 1, 1, 1, 62: 1, 1, 1, 72: 1, 77: 1, 1, 1, 1, 1, 1, 1, 1},
+		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 13: 2, 2, 2, 2, 2, 2, 2, 2, 2, 23: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 49: 2, 56: 2, 2, 2, 62: 2, 2, 2, 76: 2, 2, 2, 2, 2, 2, 2, 2, 2},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 353, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 359},
+		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 13: 1, 1, 1, 1, 1, 1, 1, 1, 1, 23: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 49: 1, 56: 1, 1, 1, 62: 1, 1, 1, 76: 1, 1, 1, 1, 1, 1, 1, 1, 1},
 		{6, 12: 6},
-		{9: 170, 11: 360, 170, 137: 361},
+		{9: 171, 11: 361, 171, 137: 362},
 		// 120
-		{42: 388},
-		{9: 363, 12: 362},
-		{11: 364},
-		{67, 9: 67, 67, 12: 67, 22: 67, 42: 67, 67},
-		{42: 365},
+		{42: 389},
+		{9: 364, 12: 363},
+		{11: 365},
+		{68, 9: 68, 68, 12: 68, 22: 68, 42: 68, 68},
+		{42: 366},
 		// 125
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 319, 320, 370, 321, 136: 366},
-		{9: 168, 12: 168},
-		{137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 12: 137, 22: 137, 42: 137, 137, 137, 137, 137, 137, 137, 50: 137, 137, 137, 137, 137, 137, 59: 137, 137, 137, 66: 137, 137, 69: 137, 85: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137},
-		{107, 107, 373, 107, 107, 107, 372, 375, 374, 107, 107, 12: 107, 22: 107, 42: 107, 107, 107, 107, 107, 107, 107, 50: 107, 107, 107, 107, 107, 107, 59: 107, 107, 107, 66: 107, 107, 69: 107, 85: 107, 107, 107, 107, 107, 107, 107, 107, 376, 107, 107, 107},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 371, 369},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 321, 371, 322, 136: 367},
+		{9: 169, 12: 169},
+		{138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 12: 138, 22: 138, 42: 138, 138, 138, 138, 138, 138, 138, 50: 138, 138, 138, 138, 138, 138, 59: 138, 138, 138, 66: 138, 68: 138, 138, 85: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138},
+		{108, 108, 374, 108, 108, 108, 373, 376, 375, 108, 108, 12: 108, 22: 108, 42: 108, 108, 108, 108, 108, 108, 108, 50: 108, 108, 108, 108, 108, 108, 59: 108, 108, 108, 66: 108, 68: 108, 108, 85: 108, 108, 108, 108, 108, 108, 108, 108, 377, 108, 108, 108},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 372, 370},
 		// 130
 		{9: 48, 12: 48, 42: 48},
-		{104, 104, 3: 104, 104, 104, 9: 104, 104, 12: 104, 22: 104, 42: 104, 104, 104, 104, 104, 104, 104, 50: 104, 104, 104, 104, 104, 104, 59: 104, 104, 104, 66: 104, 104, 69: 104, 85: 104, 104, 104, 104, 104, 104, 104, 104, 94: 104, 104, 104},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 114: 384},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 378, 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 380, 157: 379},
-		{112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 12: 112, 22: 112, 42: 112, 112, 112, 112, 112, 112, 112, 50: 112, 112, 112, 112, 112, 112, 59: 112, 112, 112, 66: 112, 112, 69: 112, 85: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
+		{105, 105, 3: 105, 105, 105, 9: 105, 105, 12: 105, 22: 105, 42: 105, 105, 105, 105, 105, 105, 105, 50: 105, 105, 105, 105, 105, 105, 59: 105, 105, 105, 66: 105, 68: 105, 105, 85: 105, 105, 105, 105, 105, 105, 105, 105, 94: 105, 105, 105},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 114: 385},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 379, 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 381, 157: 380},
+		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 12: 113, 22: 113, 42: 113, 113, 113, 113, 113, 113, 113, 50: 113, 113, 113, 113, 113, 113, 59: 113, 113, 113, 66: 113, 68: 113, 113, 85: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
 		// 135
-		{111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 12: 111, 22: 111, 42: 111, 111, 111, 111, 111, 111, 111, 50: 111, 111, 111, 111, 111, 111, 59: 111, 111, 111, 66: 111, 111, 69: 111, 85: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
-		{11: 377},
-		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 12: 110, 22: 110, 42: 110, 110, 110, 110, 110, 110, 110, 50: 110, 110, 110, 110, 110, 110, 59: 110, 110, 110, 66: 110, 110, 69: 110, 85: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110},
-		{114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 12: 114, 22: 114, 42: 114, 114, 114, 114, 114, 114, 114, 50: 114, 114, 114, 114, 114, 114, 59: 114, 114, 114, 66: 114, 114, 69: 114, 85: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114},
-		{12: 382, 22: 381},
+		{112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 12: 112, 22: 112, 42: 112, 112, 112, 112, 112, 112, 112, 50: 112, 112, 112, 112, 112, 112, 59: 112, 112, 112, 66: 112, 68: 112, 112, 85: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
+		{11: 378},
+		{111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 12: 111, 22: 111, 42: 111, 111, 111, 111, 111, 111, 111, 50: 111, 111, 111, 111, 111, 111, 59: 111, 111, 111, 66: 111, 68: 111, 111, 85: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
+		{115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 12: 115, 22: 115, 42: 115, 115, 115, 115, 115, 115, 115, 50: 115, 115, 115, 115, 115, 115, 59: 115, 115, 115, 66: 115, 68: 115, 115, 85: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115},
+		{12: 383, 22: 382},
 		// 140
+		{12: 110, 22: 110},
+		{114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 12: 114, 22: 114, 42: 114, 114, 114, 114, 114, 114, 114, 50: 114, 114, 114, 114, 114, 114, 59: 114, 114, 114, 66: 114, 68: 114, 114, 85: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 384},
 		{12: 109, 22: 109},
-		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 12: 113, 22: 113, 42: 113, 113, 113, 113, 113, 113, 113, 50: 113, 113, 113, 113, 113, 113, 59: 113, 113, 113, 66: 113, 113, 69: 113, 85: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 383},
-		{12: 108, 22: 108},
-		{12: 386, 43: 385},
+		{12: 387, 43: 386},
 		// 145
-		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 12: 116, 22: 116, 42: 116, 116, 116, 116, 116, 116, 116, 50: 116, 116, 116, 116, 116, 116, 59: 116, 116, 116, 66: 116, 116, 69: 116, 85: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 387},
+		{117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 12: 117, 22: 117, 42: 117, 117, 117, 117, 117, 117, 117, 50: 117, 117, 117, 117, 117, 117, 59: 117, 117, 117, 66: 117, 68: 117, 117, 85: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 388},
 		{49, 10: 49, 12: 49, 22: 49, 42: 49, 49},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 319, 320, 370, 321, 136: 389},
-		{9: 169, 12: 169},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 321, 371, 322, 136: 390},
+		{9: 170, 12: 170},
 		// 150
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 13: 3, 3, 3, 3, 3, 3, 3, 3, 3, 23: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 49: 3, 56: 3, 3, 3, 62: 3, 3, 3, 72: 3, 77: 3, 3, 3, 3, 3, 3, 3, 3},
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 13: 4, 4, 4, 4, 4, 4, 4, 4, 4, 23: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 49: 4, 56: 4, 4, 4, 62: 4, 4, 4, 72: 4, 77: 4, 4, 4, 4, 4, 4, 4, 4},
-		{393},
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 13: 5, 5, 5, 5, 5, 5, 5, 5, 5, 23: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 49: 5, 56: 5, 5, 5, 62: 5, 5, 5, 72: 5, 77: 5, 5, 5, 5, 5, 5, 5, 5},
-		{400, 10: 267, 12: 386, 113: 401},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 13: 3, 3, 3, 3, 3, 3, 3, 3, 3, 23: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 49: 3, 56: 3, 3, 3, 62: 3, 3, 3, 76: 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 13: 4, 4, 4, 4, 4, 4, 4, 4, 4, 23: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 49: 4, 56: 4, 4, 4, 62: 4, 4, 4, 76: 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{394},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 13: 5, 5, 5, 5, 5, 5, 5, 5, 5, 23: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 49: 5, 56: 5, 5, 5, 62: 5, 5, 5, 76: 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		{401, 10: 268, 12: 387, 113: 402},
 		// 155
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 114: 325, 116: 396},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 10: 267, 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 398, 397},
-		{10: 267, 12: 386, 113: 399},
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 13: 9, 9, 9, 9, 9, 9, 9, 9, 9, 23: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 49: 9, 56: 9, 9, 9, 62: 9, 9, 9, 72: 9, 77: 9, 9, 9, 9, 9, 9, 9, 9},
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 13: 8, 8, 8, 8, 8, 8, 8, 8, 8, 23: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 49: 8, 56: 8, 8, 8, 62: 8, 8, 8, 72: 8, 77: 8, 8, 8, 8, 8, 8, 8, 8},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 114: 326, 116: 397},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 10: 268, 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 399, 398},
+		{10: 268, 12: 387, 113: 400},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 13: 9, 9, 9, 9, 9, 9, 9, 9, 9, 23: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 49: 9, 56: 9, 9, 9, 62: 9, 9, 9, 76: 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 13: 8, 8, 8, 8, 8, 8, 8, 8, 8, 23: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 49: 8, 56: 8, 8, 8, 62: 8, 8, 8, 76: 8, 8, 8, 8, 8, 8, 8, 8, 8},
 		// 160
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 13: 25, 25, 25, 25, 25, 25, 25, 25, 25, 23: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 49: 25, 56: 25, 25, 25, 62: 25, 25, 25, 72: 25, 77: 25, 25, 25, 25, 25, 25, 25, 25},
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 13: 10, 10, 10, 10, 10, 10, 10, 10, 10, 23: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 49: 10, 56: 10, 10, 10, 62: 10, 10, 10, 72: 10, 77: 10, 10, 10, 10, 10, 10, 10, 10},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 114: 403},
-		{12: 386, 22: 404},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 10: 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 65: 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 405},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 13: 25, 25, 25, 25, 25, 25, 25, 25, 25, 23: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 49: 25, 56: 25, 25, 25, 62: 25, 25, 25, 76: 25, 25, 25, 25, 25, 25, 25, 25, 25},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 13: 10, 10, 10, 10, 10, 10, 10, 10, 10, 23: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 49: 10, 56: 10, 10, 10, 62: 10, 10, 10, 76: 10, 10, 10, 10, 10, 10, 10, 10, 10},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 114: 404},
+		{12: 387, 22: 405},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 10: 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 406},
 		// 165
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 13: 17, 17, 17, 17, 17, 17, 17, 17, 17, 23: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 49: 17, 56: 17, 17, 17, 62: 17, 17, 17, 72: 17, 77: 17, 17, 17, 17, 17, 17, 17, 17},
-		{10: 407, 113: 408},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 409, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 339, 340, 131: 410},
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 13: 18, 18, 18, 18, 18, 18, 18, 18, 18, 23: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 49: 18, 56: 18, 18, 18, 62: 18, 18, 18, 72: 18, 77: 18, 18, 18, 18, 18, 18, 18, 18},
-		{433, 128: 416, 132: 434, 417, 139: 435},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 13: 17, 17, 17, 17, 17, 17, 17, 17, 17, 23: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 49: 17, 56: 17, 17, 17, 62: 17, 17, 17, 76: 17, 17, 17, 17, 17, 17, 17, 17, 17},
+		{10: 408, 113: 409},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 410, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 340, 341, 131: 411},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 13: 18, 18, 18, 18, 18, 18, 18, 18, 18, 23: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 49: 18, 56: 18, 18, 18, 62: 18, 18, 18, 76: 18, 18, 18, 18, 18, 18, 18, 18, 18},
+		{434, 128: 417, 132: 435, 418, 139: 436},
 		// 170
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 411, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 412, 340},
-		{413, 128: 416, 132: 415, 417, 139: 414},
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 13: 30, 30, 30, 30, 30, 30, 30, 30, 30, 23: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 49: 30, 56: 30, 30, 30, 62: 30, 30, 30, 72: 30, 77: 30, 30, 30, 30, 30, 30, 30, 30},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 13: 32, 32, 32, 32, 32, 32, 32, 32, 32, 23: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 49: 32, 56: 32, 32, 32, 62: 32, 32, 32, 72: 32, 77: 32, 32, 32, 32, 32, 32, 32, 32, 108: 32, 32, 32, 32},
-		{430, 128: 416, 132: 429, 431},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 412, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 413, 341},
+		{414, 128: 417, 132: 416, 418, 139: 415},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 13: 30, 30, 30, 30, 30, 30, 30, 30, 30, 23: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 49: 30, 56: 30, 30, 30, 62: 30, 30, 30, 76: 30, 30, 30, 30, 30, 30, 30, 30, 30},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 13: 32, 32, 32, 32, 32, 32, 32, 32, 32, 23: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 49: 32, 56: 32, 32, 32, 62: 32, 32, 32, 76: 32, 32, 32, 32, 32, 32, 32, 32, 32, 108: 32, 32, 32, 32},
+		{431, 128: 417, 132: 430, 432},
 		// 175
-		{428},
-		{10: 419, 72: 418},
+		{429},
+		{10: 420, 76: 419},
 		{14, 128: 14},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 319, 320, 423, 321},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 421, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 339, 340, 131: 420},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 321, 424, 322},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 422, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 340, 341, 131: 421},
 		// 180
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 422, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 412, 340},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 423, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 413, 341},
 		{11},
 		{12},
-		{10: 424},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 426, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 339, 340, 131: 425},
+		{10: 425},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 427, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 340, 341, 131: 426},
 		// 185
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 427, 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 412, 340},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 428, 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 413, 341},
 		{15, 128: 15},
 		{16, 128: 16},
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 13: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 49: 23, 56: 23, 23, 23, 62: 23, 23, 23, 72: 23, 77: 23, 23, 23, 23, 23, 23, 23, 23},
-		{432},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 13: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 49: 23, 56: 23, 23, 23, 62: 23, 23, 23, 76: 23, 23, 23, 23, 23, 23, 23, 23, 23},
+		{433},
 		// 190
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 13: 21, 21, 21, 21, 21, 21, 21, 21, 21, 23: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 49: 21, 56: 21, 21, 21, 62: 21, 21, 21, 72: 21, 77: 21, 21, 21, 21, 21, 21, 21, 21},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 13: 21, 21, 21, 21, 21, 21, 21, 21, 21, 23: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 49: 21, 56: 21, 21, 21, 62: 21, 21, 21, 76: 21, 21, 21, 21, 21, 21, 21, 21, 21},
 		{13, 128: 13},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 13: 24, 24, 24, 24, 24, 24, 24, 24, 24, 23: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 49: 24, 56: 24, 24, 24, 62: 24, 24, 24, 72: 24, 77: 24, 24, 24, 24, 24, 24, 24, 24},
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 13: 33, 33, 33, 33, 33, 33, 33, 33, 33, 23: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 49: 33, 56: 33, 33, 33, 62: 33, 33, 33, 72: 33, 77: 33, 33, 33, 33, 33, 33, 33, 33, 108: 33, 33, 33, 33},
-		{439},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 13: 24, 24, 24, 24, 24, 24, 24, 24, 24, 23: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 49: 24, 56: 24, 24, 24, 62: 24, 24, 24, 76: 24, 24, 24, 24, 24, 24, 24, 24, 24},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 13: 33, 33, 33, 33, 33, 33, 33, 33, 33, 23: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 49: 33, 56: 33, 33, 33, 62: 33, 33, 33, 76: 33, 33, 33, 33, 33, 33, 33, 33, 33, 108: 33, 33, 33, 33},
+		{440},
 		// 195
-		{436, 128: 416, 132: 437, 431},
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 13: 20, 20, 20, 20, 20, 20, 20, 20, 20, 23: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 49: 20, 56: 20, 20, 20, 62: 20, 20, 20, 72: 20, 77: 20, 20, 20, 20, 20, 20, 20, 20},
-		{438},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 13: 19, 19, 19, 19, 19, 19, 19, 19, 19, 23: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 49: 19, 56: 19, 19, 19, 62: 19, 19, 19, 72: 19, 77: 19, 19, 19, 19, 19, 19, 19, 19},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 13: 22, 22, 22, 22, 22, 22, 22, 22, 22, 23: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 49: 22, 56: 22, 22, 22, 62: 22, 22, 22, 72: 22, 77: 22, 22, 22, 22, 22, 22, 22, 22},
+		{437, 128: 417, 132: 438, 432},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 13: 20, 20, 20, 20, 20, 20, 20, 20, 20, 23: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 49: 20, 56: 20, 20, 20, 62: 20, 20, 20, 76: 20, 20, 20, 20, 20, 20, 20, 20, 20},
+		{439},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 13: 19, 19, 19, 19, 19, 19, 19, 19, 19, 23: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 49: 19, 56: 19, 19, 19, 62: 19, 19, 19, 76: 19, 19, 19, 19, 19, 19, 19, 19, 19},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 13: 22, 22, 22, 22, 22, 22, 22, 22, 22, 23: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 49: 22, 56: 22, 22, 22, 62: 22, 22, 22, 76: 22, 22, 22, 22, 22, 22, 22, 22, 22},
 		// 200
-		{413},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 10: 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 65: 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 442},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 13: 34, 34, 34, 34, 34, 34, 34, 34, 34, 23: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 49: 34, 56: 34, 34, 34, 62: 34, 34, 34, 72: 34, 77: 34, 34, 34, 34, 34, 34, 34, 34},
-		{42: 444},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 10: 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 65: 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 445},
+		{414},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 10: 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 443},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 13: 34, 34, 34, 34, 34, 34, 34, 34, 34, 23: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 49: 34, 56: 34, 34, 34, 62: 34, 34, 34, 76: 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{42: 445},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 10: 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 446},
 		// 205
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 13: 35, 35, 35, 35, 35, 35, 35, 35, 35, 23: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 49: 35, 56: 35, 35, 35, 62: 35, 35, 35, 72: 35, 77: 35, 35, 35, 35, 35, 35, 35, 35},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 13: 35, 35, 35, 35, 35, 35, 35, 35, 35, 23: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 49: 35, 56: 35, 35, 35, 62: 35, 35, 35, 76: 35, 35, 35, 35, 35, 35, 35, 35, 35},
+		{3: 202, 6: 202, 11: 202, 13: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202},
 		{3: 201, 6: 201, 11: 201, 13: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201},
-		{3: 200, 6: 200, 11: 200, 13: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200},
-		{2: 448, 11: 447, 126: 487, 446},
-		{3: 451, 6: 452, 11: 454, 13: 450, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 453, 68: 455, 129: 456},
+		{2: 449, 11: 448, 126: 488, 447},
+		{3: 452, 6: 453, 11: 455, 13: 451, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 454, 67: 456, 129: 457},
 		// 210
-		{2: 476, 153: 477},
-		{3: 451, 6: 452, 11: 454, 13: 450, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 453, 68: 455, 129: 475},
-		{28: 472, 43: 471},
-		{189, 12: 189, 22: 189, 66: 189, 93: 469},
-		{188, 12: 188, 22: 188, 66: 188, 93: 467},
+		{2: 477, 153: 478},
+		{3: 452, 6: 453, 11: 455, 13: 451, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 454, 67: 456, 129: 476},
+		{28: 473, 43: 472},
+		{190, 12: 190, 22: 190, 66: 190, 93: 470},
+		{189, 12: 189, 22: 189, 66: 189, 93: 468},
 		// 215
-		{6: 464, 11: 463, 14: 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 462},
-		{457, 66: 458},
-		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 13: 47, 47, 47, 47, 47, 47, 47, 47, 47, 23: 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 49: 47, 56: 47, 47, 47, 62: 47, 47, 47, 72: 47, 77: 47, 47, 47, 47, 47, 47, 47, 47},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 460, 146: 459},
-		{461},
+		{6: 465, 11: 464, 14: 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 463},
+		{458, 66: 459},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 13: 47, 47, 47, 47, 47, 47, 47, 47, 47, 23: 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 49: 47, 56: 47, 47, 47, 62: 47, 47, 47, 76: 47, 47, 47, 47, 47, 47, 47, 47, 47},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 461, 146: 460},
+		{462},
 		// 220
 		{45},
-		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 13: 46, 46, 46, 46, 46, 46, 46, 46, 46, 23: 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 49: 46, 56: 46, 46, 46, 62: 46, 46, 46, 72: 46, 77: 46, 46, 46, 46, 46, 46, 46, 46},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 13: 46, 46, 46, 46, 46, 46, 46, 46, 46, 23: 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 49: 46, 56: 46, 46, 46, 62: 46, 46, 46, 76: 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{188, 12: 188, 22: 188, 66: 188},
 		{187, 12: 187, 22: 187, 66: 187},
-		{186, 12: 186, 22: 186, 66: 186},
-		{28: 465},
+		{28: 466},
 		// 225
-		{43: 466},
-		{6: 164, 11: 164, 14: 164, 164, 164, 164, 164, 164, 164, 164, 23: 164, 164, 164, 164, 164},
-		{11: 468},
-		{185, 12: 185, 22: 185, 66: 185},
-		{11: 470},
-		// 230
-		{184, 12: 184, 22: 184, 66: 184},
-		{3: 451, 6: 452, 11: 454, 13: 450, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 453, 68: 455, 129: 474},
-		{43: 473},
+		{43: 467},
 		{6: 165, 11: 165, 14: 165, 165, 165, 165, 165, 165, 165, 165, 23: 165, 165, 165, 165, 165},
-		{190, 12: 190, 22: 190, 66: 190},
-		// 235
+		{11: 469},
+		{186, 12: 186, 22: 186, 66: 186},
+		{11: 471},
+		// 230
+		{185, 12: 185, 22: 185, 66: 185},
+		{3: 452, 6: 453, 11: 455, 13: 451, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 454, 67: 456, 129: 475},
+		{43: 474},
+		{6: 166, 11: 166, 14: 166, 166, 166, 166, 166, 166, 166, 166, 23: 166, 166, 166, 166, 166},
 		{191, 12: 191, 22: 191, 66: 191},
-		{11: 479, 14: 268, 269, 271, 272, 274, 275, 276, 273, 482, 270, 278, 279, 280, 277, 65: 480, 160: 481},
-		{2: 476, 153: 478},
+		// 235
 		{192, 12: 192, 22: 192, 66: 192},
-		{12: 198, 22: 198},
+		{11: 480, 14: 269, 270, 272, 273, 275, 276, 277, 274, 483, 271, 279, 280, 281, 278, 65: 481, 160: 482},
+		{2: 477, 153: 479},
+		{193, 12: 193, 22: 193, 66: 193},
+		{12: 199, 22: 199},
 		// 240
-		{12: 197, 22: 197},
-		{12: 483, 22: 484},
-		{193, 2: 193, 12: 193, 22: 193, 66: 193},
-		{11: 485, 14: 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 486},
+		{12: 198, 22: 198},
+		{12: 484, 22: 485},
 		{194, 2: 194, 12: 194, 22: 194, 66: 194},
+		{11: 486, 14: 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 487},
+		{195, 2: 195, 12: 195, 22: 195, 66: 195},
 		// 245
+		{12: 197, 22: 197},
 		{12: 196, 22: 196},
-		{12: 195, 22: 195},
-		{22: 488},
-		{3: 199, 6: 199, 11: 199, 13: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 319, 494},
+		{22: 489},
+		{3: 200, 6: 200, 11: 200, 13: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 495},
 		// 250
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 114: 491},
-		{12: 386, 42: 492},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 319, 320, 493, 321},
-		{69, 9: 69, 69, 12: 69, 22: 69, 42: 69, 69},
-		{71, 9: 71, 71, 12: 71, 22: 71, 42: 71, 71, 71, 71, 495},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 114: 492},
+		{12: 387, 42: 493},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 321, 494, 322},
+		{70, 9: 70, 70, 12: 70, 22: 70, 42: 70, 70},
+		{72, 9: 72, 72, 12: 72, 22: 72, 42: 72, 72, 72, 72, 496},
 		// 255
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 318, 496},
-		{73, 9: 73, 73, 12: 73, 22: 73, 42: 73, 73, 73, 73, 73, 497},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 317, 498},
-		{75, 9: 75, 75, 12: 75, 22: 75, 42: 75, 75, 75, 75, 75, 75, 499},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 316, 500},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 497},
+		{74, 9: 74, 74, 12: 74, 22: 74, 42: 74, 74, 74, 74, 74, 498},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 499},
+		{76, 9: 76, 76, 12: 76, 22: 76, 42: 76, 76, 76, 76, 76, 76, 500},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 501},
 		// 260
-		{77, 501, 9: 77, 77, 12: 77, 22: 77, 42: 77, 77, 77, 77, 77, 77, 77},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 315, 502},
-		{79, 79, 9: 79, 79, 12: 79, 22: 79, 42: 79, 79, 79, 79, 79, 79, 79, 50: 503, 506, 508, 505, 507, 504},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 530},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 529},
+		{78, 502, 9: 78, 78, 12: 78, 22: 78, 42: 78, 78, 78, 78, 78, 78, 78},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 503},
+		{80, 80, 9: 80, 80, 12: 80, 22: 80, 42: 80, 80, 80, 80, 80, 80, 80, 50: 504, 507, 509, 506, 508, 505},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 531},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 530},
 		// 265
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 528},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 527},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 526},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 314, 509},
-		{81, 81, 9: 81, 81, 12: 81, 22: 81, 42: 81, 81, 81, 81, 81, 81, 81, 50: 81, 81, 81, 81, 81, 81, 59: 512, 510, 511},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 529},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 528},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 527},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 510},
+		{82, 82, 9: 82, 82, 12: 82, 22: 82, 42: 82, 82, 82, 82, 82, 82, 82, 50: 82, 82, 82, 82, 82, 82, 59: 513, 511, 512},
 		// 270
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 525},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 524},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 313, 513},
-		{88, 88, 4: 515, 514, 9: 88, 88, 12: 88, 22: 88, 42: 88, 88, 88, 88, 88, 88, 88, 50: 88, 88, 88, 88, 88, 88, 59: 88, 88, 88},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 523},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 526},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 525},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 514},
+		{89, 89, 4: 516, 515, 9: 89, 89, 12: 89, 22: 89, 42: 89, 89, 89, 89, 89, 89, 89, 50: 89, 89, 89, 89, 89, 89, 59: 89, 89, 89},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 524},
 		// 275
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 352, 369, 97: 516},
-		{92, 92, 3: 517, 92, 92, 9: 92, 92, 12: 92, 22: 92, 42: 92, 92, 92, 92, 92, 92, 92, 50: 92, 92, 92, 92, 92, 92, 59: 92, 92, 92, 67: 518, 69: 519},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 522, 369},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 521, 369},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 367, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 368, 301, 520, 369},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 517},
+		{93, 93, 3: 518, 93, 93, 9: 93, 93, 12: 93, 22: 93, 42: 93, 93, 93, 93, 93, 93, 93, 50: 93, 93, 93, 93, 93, 93, 59: 93, 93, 93, 68: 519, 520},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 523, 370},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 522, 370},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 521, 370},
 		// 280
-		{95, 95, 3: 95, 95, 95, 9: 95, 95, 12: 95, 22: 95, 42: 95, 95, 95, 95, 95, 95, 95, 50: 95, 95, 95, 95, 95, 95, 59: 95, 95, 95, 67: 95, 69: 95},
-		{96, 96, 3: 96, 96, 96, 9: 96, 96, 12: 96, 22: 96, 42: 96, 96, 96, 96, 96, 96, 96, 50: 96, 96, 96, 96, 96, 96, 59: 96, 96, 96, 67: 96, 69: 96},
-		{97, 97, 3: 97, 97, 97, 9: 97, 97, 12: 97, 22: 97, 42: 97, 97, 97, 97, 97, 97, 97, 50: 97, 97, 97, 97, 97, 97, 59: 97, 97, 97, 67: 97, 69: 97},
-		{93, 93, 3: 517, 93, 93, 9: 93, 93, 12: 93, 22: 93, 42: 93, 93, 93, 93, 93, 93, 93, 50: 93, 93, 93, 93, 93, 93, 59: 93, 93, 93, 67: 518, 69: 519},
-		{89, 89, 4: 515, 514, 9: 89, 89, 12: 89, 22: 89, 42: 89, 89, 89, 89, 89, 89, 89, 50: 89, 89, 89, 89, 89, 89, 59: 89, 89, 89},
+		{96, 96, 3: 96, 96, 96, 9: 96, 96, 12: 96, 22: 96, 42: 96, 96, 96, 96, 96, 96, 96, 50: 96, 96, 96, 96, 96, 96, 59: 96, 96, 96, 68: 96, 96},
+		{97, 97, 3: 97, 97, 97, 9: 97, 97, 12: 97, 22: 97, 42: 97, 97, 97, 97, 97, 97, 97, 50: 97, 97, 97, 97, 97, 97, 59: 97, 97, 97, 68: 97, 97},
+		{98, 98, 3: 98, 98, 98, 9: 98, 98, 12: 98, 22: 98, 42: 98, 98, 98, 98, 98, 98, 98, 50: 98, 98, 98, 98, 98, 98, 59: 98, 98, 98, 68: 98, 98},
+		{94, 94, 3: 518, 94, 94, 9: 94, 94, 12: 94, 22: 94, 42: 94, 94, 94, 94, 94, 94, 94, 50: 94, 94, 94, 94, 94, 94, 59: 94, 94, 94, 68: 519, 520},
+		{90, 90, 4: 516, 515, 9: 90, 90, 12: 90, 22: 90, 42: 90, 90, 90, 90, 90, 90, 90, 50: 90, 90, 90, 90, 90, 90, 59: 90, 90, 90},
 		// 285
-		{90, 90, 4: 515, 514, 9: 90, 90, 12: 90, 22: 90, 42: 90, 90, 90, 90, 90, 90, 90, 50: 90, 90, 90, 90, 90, 90, 59: 90, 90, 90},
-		{82, 82, 9: 82, 82, 12: 82, 22: 82, 42: 82, 82, 82, 82, 82, 82, 82, 50: 82, 82, 82, 82, 82, 82, 59: 512, 510, 511},
-		{83, 83, 9: 83, 83, 12: 83, 22: 83, 42: 83, 83, 83, 83, 83, 83, 83, 50: 83, 83, 83, 83, 83, 83, 59: 512, 510, 511},
-		{84, 84, 9: 84, 84, 12: 84, 22: 84, 42: 84, 84, 84, 84, 84, 84, 84, 50: 84, 84, 84, 84, 84, 84, 59: 512, 510, 511},
-		{85, 85, 9: 85, 85, 12: 85, 22: 85, 42: 85, 85, 85, 85, 85, 85, 85, 50: 85, 85, 85, 85, 85, 85, 59: 512, 510, 511},
+		{91, 91, 4: 516, 515, 9: 91, 91, 12: 91, 22: 91, 42: 91, 91, 91, 91, 91, 91, 91, 50: 91, 91, 91, 91, 91, 91, 59: 91, 91, 91},
+		{83, 83, 9: 83, 83, 12: 83, 22: 83, 42: 83, 83, 83, 83, 83, 83, 83, 50: 83, 83, 83, 83, 83, 83, 59: 513, 511, 512},
+		{84, 84, 9: 84, 84, 12: 84, 22: 84, 42: 84, 84, 84, 84, 84, 84, 84, 50: 84, 84, 84, 84, 84, 84, 59: 513, 511, 512},
+		{85, 85, 9: 85, 85, 12: 85, 22: 85, 42: 85, 85, 85, 85, 85, 85, 85, 50: 85, 85, 85, 85, 85, 85, 59: 513, 511, 512},
+		{86, 86, 9: 86, 86, 12: 86, 22: 86, 42: 86, 86, 86, 86, 86, 86, 86, 50: 86, 86, 86, 86, 86, 86, 59: 513, 511, 512},
 		// 290
-		{86, 86, 9: 86, 86, 12: 86, 22: 86, 42: 86, 86, 86, 86, 86, 86, 86, 50: 86, 86, 86, 86, 86, 86, 59: 512, 510, 511},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 544},
+		{87, 87, 9: 87, 87, 12: 87, 22: 87, 42: 87, 87, 87, 87, 87, 87, 87, 50: 87, 87, 87, 87, 87, 87, 59: 513, 511, 512},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 547},
+		{1: 61, 61, 61, 61, 61, 61, 61, 61, 11: 61, 13: 61, 61, 61, 61, 61, 61, 61, 61, 61, 23: 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 164: 545},
 		{1: 62, 62, 62, 62, 62, 62, 62, 62, 11: 62, 13: 62, 62, 62, 62, 62, 62, 62, 62, 62, 23: 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
-		{1: 61, 61, 61, 61, 61, 61, 61, 61, 11: 61, 13: 61, 61, 61, 61, 61, 61, 61, 61, 61, 23: 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
 		{1: 60, 60, 60, 60, 60, 60, 60, 60, 11: 60, 13: 60, 60, 60, 60, 60, 60, 60, 60, 60, 23: 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
 		// 295
 		{1: 59, 59, 59, 59, 59, 59, 59, 59, 11: 59, 13: 59, 59, 59, 59, 59, 59, 59, 59, 59, 23: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
@@ -1288,162 +1292,165 @@ var (
 		{1: 53, 53, 53, 53, 53, 53, 53, 53, 11: 53, 13: 53, 53, 53, 53, 53, 53, 53, 53, 53, 23: 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
 		{1: 52, 52, 52, 52, 52, 52, 52, 52, 11: 52, 13: 52, 52, 52, 52, 52, 52, 52, 52, 52, 23: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
 		{1: 51, 51, 51, 51, 51, 51, 51, 51, 11: 51, 13: 51, 51, 51, 51, 51, 51, 51, 51, 51, 23: 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
-		{63, 9: 63, 63, 12: 63, 22: 63, 42: 63, 63},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 368, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 369, 302, 353, 370, 97: 314, 315, 316, 317, 318, 319, 320, 321, 546, 322},
 		// 305
-		{137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 546, 12: 137, 22: 137, 42: 137, 137, 137, 137, 137, 137, 137, 50: 137, 137, 137, 137, 137, 137, 59: 137, 137, 137, 66: 137, 137, 69: 137, 85: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137},
-		{9: 170, 11: 360, 170, 137: 547},
-		{9: 548, 12: 362},
-		{66, 9: 66, 66, 12: 66, 22: 66, 42: 66, 66},
-		{105, 105, 3: 105, 105, 105, 9: 105, 105, 12: 105, 22: 105, 42: 105, 105, 105, 105, 105, 105, 105, 50: 105, 105, 105, 105, 105, 105, 59: 105, 105, 105, 66: 105, 105, 69: 105, 85: 105, 105, 105, 105, 105, 105, 105, 105, 94: 105, 105, 105},
+		{63, 9: 63, 63, 12: 63, 22: 63, 42: 63, 63},
+		{64, 9: 64, 64, 12: 64, 22: 64, 42: 64, 64},
+		{138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 549, 12: 138, 22: 138, 42: 138, 138, 138, 138, 138, 138, 138, 50: 138, 138, 138, 138, 138, 138, 59: 138, 138, 138, 66: 138, 68: 138, 138, 85: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138},
+		{9: 171, 11: 361, 171, 137: 550},
+		{9: 551, 12: 363},
 		// 310
-		{106, 106, 3: 106, 106, 106, 9: 106, 106, 12: 106, 22: 106, 42: 106, 106, 106, 106, 106, 106, 106, 50: 106, 106, 106, 106, 106, 106, 59: 106, 106, 106, 66: 106, 106, 69: 106, 85: 106, 106, 106, 106, 106, 106, 106, 106, 94: 106, 106, 106},
-		{11: 553, 14: 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 552, 156: 554},
-		{119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 12: 119, 22: 119, 42: 119, 119, 119, 119, 119, 119, 119, 50: 119, 119, 119, 119, 119, 119, 59: 119, 119, 119, 66: 119, 119, 69: 119, 85: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119},
-		{118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 12: 118, 22: 118, 42: 118, 118, 118, 118, 118, 118, 118, 50: 118, 118, 118, 118, 118, 118, 59: 118, 118, 118, 66: 118, 118, 69: 118, 85: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118},
-		{115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 12: 115, 22: 115, 42: 115, 115, 115, 115, 115, 115, 115, 50: 115, 115, 115, 115, 115, 115, 59: 115, 115, 115, 66: 115, 115, 69: 115, 85: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115},
+		{67, 9: 67, 67, 12: 67, 22: 67, 42: 67, 67},
+		{106, 106, 3: 106, 106, 106, 9: 106, 106, 12: 106, 22: 106, 42: 106, 106, 106, 106, 106, 106, 106, 50: 106, 106, 106, 106, 106, 106, 59: 106, 106, 106, 66: 106, 68: 106, 106, 85: 106, 106, 106, 106, 106, 106, 106, 106, 94: 106, 106, 106},
+		{107, 107, 3: 107, 107, 107, 9: 107, 107, 12: 107, 22: 107, 42: 107, 107, 107, 107, 107, 107, 107, 50: 107, 107, 107, 107, 107, 107, 59: 107, 107, 107, 66: 107, 68: 107, 107, 85: 107, 107, 107, 107, 107, 107, 107, 107, 94: 107, 107, 107},
+		{11: 556, 14: 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 555, 156: 557},
+		{120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 12: 120, 22: 120, 42: 120, 120, 120, 120, 120, 120, 120, 50: 120, 120, 120, 120, 120, 120, 59: 120, 120, 120, 66: 120, 68: 120, 120, 85: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
 		// 315
-		{11: 556},
-		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 557, 12: 110, 22: 110, 42: 110, 110, 110, 110, 110, 110, 110, 50: 110, 110, 110, 110, 110, 110, 59: 110, 110, 110, 66: 110, 110, 69: 110, 85: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110},
-		{9: 170, 11: 360, 170, 137: 558},
-		{9: 559, 12: 362},
-		{65, 9: 65, 65, 12: 65, 22: 65, 42: 65, 65},
+		{119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 12: 119, 22: 119, 42: 119, 119, 119, 119, 119, 119, 119, 50: 119, 119, 119, 119, 119, 119, 59: 119, 119, 119, 66: 119, 68: 119, 119, 85: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119},
+		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 12: 116, 22: 116, 42: 116, 116, 116, 116, 116, 116, 116, 50: 116, 116, 116, 116, 116, 116, 59: 116, 116, 116, 66: 116, 68: 116, 116, 85: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116},
+		{11: 559},
+		{111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 560, 12: 111, 22: 111, 42: 111, 111, 111, 111, 111, 111, 111, 50: 111, 111, 111, 111, 111, 111, 59: 111, 111, 111, 66: 111, 68: 111, 111, 85: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
+		{9: 171, 11: 361, 171, 137: 561},
 		// 320
-		{12: 386, 22: 561},
-		{122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 12: 122, 22: 122, 42: 122, 122, 122, 122, 122, 122, 122, 50: 122, 122, 122, 122, 122, 122, 59: 122, 122, 122, 66: 122, 122, 69: 122, 85: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122},
-		{9: 141, 11: 563, 134: 564, 162: 565, 566},
-		{2: 571},
-		{570},
+		{9: 562, 12: 363},
+		{66, 9: 66, 66, 12: 66, 22: 66, 42: 66, 66},
+		{12: 387, 22: 564},
+		{123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 12: 123, 22: 123, 42: 123, 123, 123, 123, 123, 123, 123, 50: 123, 123, 123, 123, 123, 123, 59: 123, 123, 123, 66: 123, 68: 123, 123, 85: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123},
+		{9: 142, 11: 566, 134: 567, 162: 568, 569},
 		// 325
-		{9: 140, 11: 563, 134: 568},
-		{9: 567},
-		{135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 12: 135, 22: 135, 42: 135, 135, 135, 135, 135, 135, 135, 50: 135, 135, 135, 135, 135, 135, 59: 135, 135, 135, 66: 135, 135, 69: 135, 85: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135},
-		{569},
-		{9: 142, 11: 142},
+		{2: 574},
+		{573},
+		{9: 141, 11: 566, 134: 571},
+		{9: 570},
+		{136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 12: 136, 22: 136, 42: 136, 136, 136, 136, 136, 136, 136, 50: 136, 136, 136, 136, 136, 136, 59: 136, 136, 136, 66: 136, 68: 136, 136, 85: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136},
 		// 330
+		{572},
 		{9: 143, 11: 143},
-		{4: 578, 11: 572, 14: 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 577, 65: 574, 134: 576, 573, 161: 575},
-		{2: 571, 12: 150, 22: 150},
-		{12: 149, 22: 149},
-		{93: 588},
+		{9: 144, 11: 144},
+		{4: 581, 11: 575, 14: 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 580, 65: 577, 134: 579, 576, 161: 578},
+		{2: 574, 12: 151, 22: 151},
 		// 335
-		{12: 584, 22: 585},
-		{12: 581, 22: 580},
-		{139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 49: 139, 56: 139, 139, 139, 62: 139, 139, 139, 72: 139, 77: 139, 139, 139, 139, 139, 139, 139, 139, 108: 139, 139, 139, 139},
-		{28: 579},
-		{138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 49: 138, 56: 138, 138, 138, 62: 138, 138, 138, 72: 138, 77: 138, 138, 138, 138, 138, 138, 138, 138, 108: 138, 138, 138, 138},
+		{12: 150, 22: 150},
+		{93: 591},
+		{12: 587, 22: 588},
+		{12: 584, 22: 583},
+		{140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 49: 140, 56: 140, 140, 140, 62: 140, 140, 140, 76: 140, 140, 140, 140, 140, 140, 140, 140, 140, 108: 140, 140, 140, 140},
 		// 340
-		{145, 12: 145, 22: 145},
-		{11: 563, 134: 582},
-		{22: 583},
-		{144, 12: 144, 22: 144},
-		{11: 586},
-		// 345
+		{28: 582},
+		{139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 49: 139, 56: 139, 139, 139, 62: 139, 139, 139, 76: 139, 139, 139, 139, 139, 139, 139, 139, 139, 108: 139, 139, 139, 139},
 		{146, 12: 146, 22: 146},
-		{22: 587},
-		{147, 12: 147, 22: 147},
+		{11: 566, 134: 585},
+		{22: 586},
+		// 345
+		{145, 12: 145, 22: 145},
 		{11: 589},
-		{12: 148, 22: 148},
+		{147, 12: 147, 22: 147},
+		{22: 590},
+		{148, 12: 148, 22: 148},
 		// 350
-		{22: 591},
-		{136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 12: 136, 22: 136, 42: 136, 136, 136, 136, 136, 136, 136, 50: 136, 136, 136, 136, 136, 136, 59: 136, 136, 136, 66: 136, 136, 69: 136, 85: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136},
-		{343, 307, 298, 308, 310, 309, 281, 305, 304, 10: 267, 283, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 49: 251, 56: 255, 254, 256, 62: 253, 252, 326, 303, 68: 282, 70: 300, 299, 344, 302, 301, 312, 306, 349, 335, 348, 336, 346, 347, 350, 345, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 324, 328, 325, 333, 329, 332, 331, 334, 327, 330, 341, 342, 593, 340},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 13: 36, 36, 36, 36, 36, 36, 36, 36, 36, 23: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 49: 36, 56: 36, 36, 36, 62: 36, 36, 36, 72: 36, 77: 36, 36, 36, 36, 36, 36, 36, 36},
-		{10: 603},
+		{11: 592},
+		{12: 149, 22: 149},
+		{22: 594},
+		{137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 12: 137, 22: 137, 42: 137, 137, 137, 137, 137, 137, 137, 50: 137, 137, 137, 137, 137, 137, 59: 137, 137, 137, 66: 137, 68: 137, 137, 85: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137},
+		{344, 308, 299, 309, 311, 310, 282, 306, 305, 10: 268, 284, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 49: 252, 56: 256, 255, 257, 62: 254, 253, 327, 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 345, 350, 336, 349, 337, 347, 348, 351, 346, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 325, 329, 326, 334, 330, 333, 332, 335, 328, 331, 342, 343, 596, 341},
 		// 355
-		{10: 596},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 599, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 597, 138: 598},
-		{9: 167, 12: 167},
-		{9: 601, 12: 600},
-		{158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 12: 158, 22: 158, 42: 158, 158, 158, 158, 158, 158, 158, 50: 158, 158, 158, 158, 158, 158, 59: 158, 158, 158, 66: 158, 158, 69: 158, 85: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 13: 36, 36, 36, 36, 36, 36, 36, 36, 36, 23: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 49: 36, 56: 36, 36, 36, 62: 36, 36, 36, 76: 36, 36, 36, 36, 36, 36, 36, 36, 36},
+		{10: 606},
+		{10: 599},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 602, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 600, 138: 601},
+		{9: 168, 12: 168},
 		// 360
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 602},
-		{159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 12: 159, 22: 159, 42: 159, 159, 159, 159, 159, 159, 159, 50: 159, 159, 159, 159, 159, 159, 59: 159, 159, 159, 66: 159, 159, 69: 159, 85: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159},
-		{9: 166, 12: 166},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 605, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 597, 138: 604},
-		{9: 606, 12: 600},
+		{9: 604, 12: 603},
+		{159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 12: 159, 22: 159, 42: 159, 159, 159, 159, 159, 159, 159, 50: 159, 159, 159, 159, 159, 159, 59: 159, 159, 159, 66: 159, 68: 159, 159, 85: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 605},
+		{160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 12: 160, 22: 160, 42: 160, 160, 160, 160, 160, 160, 160, 50: 160, 160, 160, 160, 160, 160, 59: 160, 160, 160, 66: 160, 68: 160, 160, 85: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160},
+		{9: 167, 12: 167},
 		// 365
-		{160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 12: 160, 22: 160, 42: 160, 160, 160, 160, 160, 160, 160, 50: 160, 160, 160, 160, 160, 160, 59: 160, 160, 160, 66: 160, 160, 69: 160, 85: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160},
-		{161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 12: 161, 22: 161, 42: 161, 161, 161, 161, 161, 161, 161, 50: 161, 161, 161, 161, 161, 161, 59: 161, 161, 161, 66: 161, 161, 69: 161, 85: 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161},
-		{6: 608, 11: 609, 14: 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 610, 70: 611},
-		{43: 607},
-		{10: 619},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 608, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 600, 138: 607},
+		{9: 609, 12: 603},
+		{161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 12: 161, 22: 161, 42: 161, 161, 161, 161, 161, 161, 161, 50: 161, 161, 161, 161, 161, 161, 59: 161, 161, 161, 66: 161, 68: 161, 161, 85: 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161},
+		{162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 12: 162, 22: 162, 42: 162, 162, 162, 162, 162, 162, 162, 50: 162, 162, 162, 162, 162, 162, 59: 162, 162, 162, 66: 162, 68: 162, 162, 85: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162},
+		{6: 611, 11: 612, 14: 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 613, 70: 614},
 		// 370
-		{10: 612},
-		{151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 12: 151, 22: 151, 42: 151, 151, 151, 151, 151, 151, 151, 50: 151, 151, 151, 151, 151, 151, 59: 151, 151, 151, 66: 151, 151, 69: 151, 85: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 615, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 613, 150: 614},
-		{9: 157, 12: 157},
-		{9: 617, 12: 616},
+		{43: 610},
+		{10: 622},
+		{10: 615},
+		{152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 12: 152, 22: 152, 42: 152, 152, 152, 152, 152, 152, 152, 50: 152, 152, 152, 152, 152, 152, 59: 152, 152, 152, 66: 152, 68: 152, 152, 85: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 618, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 616, 150: 617},
 		// 375
-		{152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 12: 152, 22: 152, 42: 152, 152, 152, 152, 152, 152, 152, 50: 152, 152, 152, 152, 152, 152, 59: 152, 152, 152, 66: 152, 152, 69: 152, 85: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 618},
-		{153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 12: 153, 22: 153, 42: 153, 153, 153, 153, 153, 153, 153, 50: 153, 153, 153, 153, 153, 153, 59: 153, 153, 153, 66: 153, 153, 69: 153, 85: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153},
-		{9: 156, 12: 156},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 621, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 613, 150: 620},
+		{9: 158, 12: 158},
+		{9: 620, 12: 619},
+		{153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 12: 153, 22: 153, 42: 153, 153, 153, 153, 153, 153, 153, 50: 153, 153, 153, 153, 153, 153, 59: 153, 153, 153, 66: 153, 68: 153, 153, 85: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 621},
+		{154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 12: 154, 22: 154, 42: 154, 154, 154, 154, 154, 154, 154, 50: 154, 154, 154, 154, 154, 154, 59: 154, 154, 154, 66: 154, 68: 154, 154, 85: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
 		// 380
-		{9: 622, 12: 616},
-		{154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 12: 154, 22: 154, 42: 154, 154, 154, 154, 154, 154, 154, 50: 154, 154, 154, 154, 154, 154, 59: 154, 154, 154, 66: 154, 154, 69: 154, 85: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
-		{155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 12: 155, 22: 155, 42: 155, 155, 155, 155, 155, 155, 155, 50: 155, 155, 155, 155, 155, 155, 59: 155, 155, 155, 66: 155, 155, 69: 155, 85: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155},
-		{13: 206, 49: 206, 56: 206, 206, 206, 62: 206, 206, 206, 108: 206, 206, 206, 206},
-		{2: 209, 10: 209},
+		{9: 157, 12: 157},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 624, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 616, 150: 623},
+		{9: 625, 12: 619},
+		{155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 12: 155, 22: 155, 42: 155, 155, 155, 155, 155, 155, 155, 50: 155, 155, 155, 155, 155, 155, 59: 155, 155, 155, 66: 155, 68: 155, 155, 85: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155},
+		{156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 12: 156, 22: 156, 42: 156, 156, 156, 156, 156, 156, 156, 50: 156, 156, 156, 156, 156, 156, 59: 156, 156, 156, 66: 156, 68: 156, 156, 85: 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156},
 		// 385
-		{22: 632},
-		{12: 630, 22: 205},
-		{12: 204, 22: 204},
-		{3: 451, 6: 452, 11: 454, 13: 450, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 453, 68: 455, 129: 629},
-		{202, 12: 202, 22: 202},
+		{13: 207, 49: 207, 56: 207, 207, 207, 62: 207, 207, 207, 108: 207, 207, 207, 207},
+		{2: 210, 10: 210},
+		{22: 635},
+		{12: 633, 22: 206},
+		{12: 205, 22: 205},
 		// 390
-		{2: 448, 11: 447, 126: 628, 446, 130: 631},
-		{12: 203, 22: 203},
-		{2: 208, 10: 208},
-		{2: 211},
-		{2: 448, 11: 447, 126: 628, 446, 130: 627, 148: 626, 635},
+		{3: 452, 6: 453, 11: 455, 13: 451, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 454, 67: 456, 129: 632},
+		{203, 12: 203, 22: 203},
+		{2: 449, 11: 448, 126: 631, 447, 130: 634},
+		{12: 204, 22: 204},
+		{2: 209, 10: 209},
 		// 395
-		{22: 636},
-		{11: 637},
-		{2: 210},
-		{639},
-		{13: 212, 49: 212, 56: 212, 212, 212, 62: 212, 212, 212, 108: 212, 212, 212, 212},
+		{2: 212},
+		{2: 449, 11: 448, 126: 631, 447, 130: 630, 148: 629, 638},
+		{22: 639},
+		{11: 640},
+		{2: 211},
 		// 400
-		{641},
+		{642},
 		{13: 213, 49: 213, 56: 213, 213, 213, 62: 213, 213, 213, 108: 213, 213, 213, 213},
-		{165: 643},
-		{10: 645, 152: 644},
-		{13: 218, 49: 218, 56: 218, 218, 218, 62: 218, 218, 218, 108: 218, 218, 218, 218},
+		{644},
+		{13: 214, 49: 214, 56: 214, 214, 214, 62: 214, 214, 214, 108: 214, 214, 214, 214},
+		{166: 646},
 		// 405
-		{2: 448, 9: 646, 11: 447, 126: 628, 446, 130: 648, 159: 647},
-		{654},
-		{2: 448, 9: 650, 11: 447, 126: 628, 446, 130: 651},
-		{649},
-		{2: 215, 9: 215, 11: 215},
+		{10: 648, 152: 647},
+		{13: 219, 49: 219, 56: 219, 219, 219, 62: 219, 219, 219, 108: 219, 219, 219, 219},
+		{2: 449, 9: 649, 11: 448, 126: 631, 447, 130: 651, 159: 650},
+		{657},
+		{2: 449, 9: 653, 11: 448, 126: 631, 447, 130: 654},
 		// 410
-		{653},
 		{652},
-		{2: 214, 9: 214, 11: 214},
-		{216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 13: 216, 216, 216, 216, 216, 216, 216, 216, 216, 23: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 49: 216, 56: 216, 216, 216, 62: 216, 216, 216, 72: 216, 77: 216, 216, 216, 216, 216, 216, 216, 216, 108: 216, 216, 216, 216},
-		{217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 13: 217, 217, 217, 217, 217, 217, 217, 217, 217, 23: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 49: 217, 56: 217, 217, 217, 62: 217, 217, 217, 72: 217, 77: 217, 217, 217, 217, 217, 217, 217, 217, 108: 217, 217, 217, 217},
+		{2: 216, 9: 216, 11: 216},
+		{656},
+		{655},
+		{2: 215, 9: 215, 11: 215},
 		// 415
-		{3: 451, 6: 452, 11: 454, 13: 450, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 65: 453, 68: 455, 129: 656},
-		{657, 66: 658},
-		{13: 220, 49: 220, 56: 220, 220, 220, 62: 220, 220, 220, 108: 220, 220, 220, 220},
-		{1: 307, 298, 308, 310, 309, 281, 305, 304, 11: 351, 13: 284, 268, 269, 271, 272, 274, 275, 276, 273, 23: 270, 278, 279, 280, 277, 290, 286, 287, 288, 297, 296, 285, 291, 311, 289, 292, 294, 295, 293, 65: 303, 68: 282, 70: 300, 299, 73: 302, 301, 312, 306, 97: 313, 314, 315, 316, 317, 318, 319, 320, 322, 321, 323, 112: 460, 146: 659},
-		{660},
+		{217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 13: 217, 217, 217, 217, 217, 217, 217, 217, 217, 23: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 49: 217, 56: 217, 217, 217, 62: 217, 217, 217, 76: 217, 217, 217, 217, 217, 217, 217, 217, 217, 108: 217, 217, 217, 217},
+		{218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 13: 218, 218, 218, 218, 218, 218, 218, 218, 218, 23: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 49: 218, 56: 218, 218, 218, 62: 218, 218, 218, 76: 218, 218, 218, 218, 218, 218, 218, 218, 218, 108: 218, 218, 218, 218},
+		{3: 452, 6: 453, 11: 455, 13: 451, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 65: 454, 67: 456, 129: 659},
+		{660, 66: 661},
+		{13: 221, 49: 221, 56: 221, 221, 221, 62: 221, 221, 221, 108: 221, 221, 221, 221},
 		// 420
-		{13: 219, 49: 219, 56: 219, 219, 219, 62: 219, 219, 219, 108: 219, 219, 219, 219},
-		{662, 10: 222, 155: 663},
-		{223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 13: 223, 223, 223, 223, 223, 223, 223, 223, 223, 23: 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 49: 223, 56: 223, 223, 223, 62: 223, 223, 223, 72: 223, 77: 223, 223, 223, 223, 223, 223, 223, 223, 108: 223, 223, 223, 223},
-		{10: 645, 152: 664},
-		{221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 13: 221, 221, 221, 221, 221, 221, 221, 221, 221, 23: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 49: 221, 56: 221, 221, 221, 62: 221, 221, 221, 72: 221, 77: 221, 221, 221, 221, 221, 221, 221, 221, 108: 221, 221, 221, 221},
+		{1: 308, 299, 309, 311, 310, 282, 306, 305, 11: 352, 13: 285, 269, 270, 272, 273, 275, 276, 277, 274, 23: 271, 279, 280, 281, 278, 291, 287, 288, 289, 298, 297, 286, 292, 312, 290, 293, 295, 296, 294, 65: 304, 67: 283, 70: 301, 300, 303, 302, 313, 307, 97: 314, 315, 316, 317, 318, 319, 320, 321, 323, 322, 324, 112: 461, 146: 662},
+		{663},
+		{13: 220, 49: 220, 56: 220, 220, 220, 62: 220, 220, 220, 108: 220, 220, 220, 220},
+		{665, 10: 223, 155: 666},
+		{224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 13: 224, 224, 224, 224, 224, 224, 224, 224, 224, 23: 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 49: 224, 56: 224, 224, 224, 62: 224, 224, 224, 76: 224, 224, 224, 224, 224, 224, 224, 224, 224, 108: 224, 224, 224, 224},
 		// 425
-		{10: 225, 154: 666},
-		{10: 267, 113: 667},
-		{224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 13: 224, 224, 224, 224, 224, 224, 224, 224, 224, 23: 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 49: 224, 56: 224, 224, 224, 62: 224, 224, 224, 72: 224, 77: 224, 224, 224, 224, 224, 224, 224, 224, 108: 224, 224, 224, 224},
-		{669},
-		{226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 13: 226, 226, 226, 226, 226, 226, 226, 226, 226, 23: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 49: 226, 56: 226, 226, 226, 62: 226, 226, 226, 72: 226, 77: 226, 226, 226, 226, 226, 226, 226, 226, 108: 226, 226, 226, 226},
+		{10: 648, 152: 667},
+		{222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 13: 222, 222, 222, 222, 222, 222, 222, 222, 222, 23: 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 49: 222, 56: 222, 222, 222, 62: 222, 222, 222, 76: 222, 222, 222, 222, 222, 222, 222, 222, 222, 108: 222, 222, 222, 222},
+		{10: 226, 154: 669},
+		{10: 268, 113: 670},
+		{225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 13: 225, 225, 225, 225, 225, 225, 225, 225, 225, 23: 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 49: 225, 56: 225, 225, 225, 62: 225, 225, 225, 76: 225, 225, 225, 225, 225, 225, 225, 225, 225, 108: 225, 225, 225, 225},
 		// 430
-		{227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 13: 227, 227, 227, 227, 227, 227, 227, 227, 227, 23: 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 49: 227, 56: 227, 227, 227, 62: 227, 227, 227, 72: 227, 77: 227, 227, 227, 227, 227, 227, 227, 227, 108: 227, 227, 227, 227},
-		{4: 578, 28: 577, 135: 672},
-		{228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 13: 228, 228, 228, 228, 228, 228, 228, 228, 228, 23: 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 49: 228, 56: 228, 228, 228, 62: 228, 228, 228, 72: 228, 77: 228, 228, 228, 228, 228, 228, 228, 228, 108: 228, 228, 228, 228},
-		{13: 238, 49: 238, 56: 238, 238, 238, 62: 238, 238, 238, 108: 238, 238, 238, 238},
+		{672},
+		{227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 13: 227, 227, 227, 227, 227, 227, 227, 227, 227, 23: 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 49: 227, 56: 227, 227, 227, 62: 227, 227, 227, 76: 227, 227, 227, 227, 227, 227, 227, 227, 227, 108: 227, 227, 227, 227},
+		{228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 13: 228, 228, 228, 228, 228, 228, 228, 228, 228, 23: 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 49: 228, 56: 228, 228, 228, 62: 228, 228, 228, 76: 228, 228, 228, 228, 228, 228, 228, 228, 228, 108: 228, 228, 228, 228},
+		{4: 581, 28: 580, 135: 675},
+		{229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 13: 229, 229, 229, 229, 229, 229, 229, 229, 229, 23: 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 49: 229, 56: 229, 229, 229, 62: 229, 229, 229, 76: 229, 229, 229, 229, 229, 229, 229, 229, 229, 108: 229, 229, 229, 229},
+		// 435
+		{13: 239, 49: 239, 56: 239, 239, 239, 62: 239, 239, 239, 108: 239, 239, 239, 239},
 	}
 )
 
@@ -1484,7 +1491,7 @@ func yylex1(yylex yyLexer, lval *yySymType) (n int) {
 }
 
 func yyParse(yylex yyLexer) int {
-	const yyError = 186
+	const yyError = 187
 
 	yyEx, _ := yylex.(yyLexerEx)
 	var yyn int
@@ -2372,24 +2379,28 @@ yynewstate:
 				}
 			}
 		}
-	case 191:
+	case 178:
+		{
+			yyVAL.expressions = RangeClause(yyS[yypt-3].expressions, yyS[yypt-0].expressions)
+		}
+	case 192:
 		{
 			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].Outputs = append(yyS[yypt-2].expressions[len(yyS[yypt-2].expressions)-1].Outputs, yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].Outputs...)
 			yyVAL.expressions = yyS[yypt-0].expressions
 		}
-	case 193:
+	case 194:
 		{
 			yyVAL.expressions = DeclareLocal(yyS[yypt-2].argument, yyS[yypt-1].argument, nil, false)
 		}
-	case 194:
+	case 195:
 		{
 			yyVAL.expressions = DeclareLocal(yyS[yypt-4].argument, yyS[yypt-3].argument, yyS[yypt-1].expressions, true)
 		}
-	case 202:
+	case 203:
 		{
 			yyVAL.expressions = nil
 		}
-	case 204:
+	case 205:
 		{
 			// it has to be the first expression so all the nested expressions are executed
 			// instead of only executing the last one
@@ -2401,10 +2412,6 @@ yynewstate:
 
 			yyVAL.expressions = yyS[yypt-0].expressions
 		}
-	case 205:
-		{
-			yyVAL.expressions = nil
-		}
 	case 206:
 		{
 			yyVAL.expressions = nil
@@ -2414,22 +2421,26 @@ yynewstate:
 			yyVAL.expressions = nil
 		}
 	case 208:
+		{
+			yyVAL.expressions = nil
+		}
+	case 209:
 		{
 			yyVAL.expressions = yyS[yypt-2].expressions
 		}
-	case 210:
+	case 211:
 		{
 			yyVAL.expressions = append(yyS[yypt-1].expressions, yyS[yypt-0].expressions...)
 		}
-	case 213:
+	case 214:
 		{
 			yyVAL.expressions = nil
 		}
-	case 214:
+	case 215:
 		{
 			yyVAL.expressions = nil
 		}
-	case 215:
+	case 216:
 		{
 			if len(yyS[yypt-1].expressions) > 0 && yyS[yypt-1].expressions[len(yyS[yypt-1].expressions)-1].Operator == nil && !yyS[yypt-1].expressions[len(yyS[yypt-1].expressions)-1].IsMethodCall {
 				outs := yyS[yypt-1].expressions[len(yyS[yypt-1].expressions)-1].Outputs
@@ -2444,95 +2455,99 @@ yynewstate:
 			}
 			// $$ = $1
 		}
-	case 216:
+	case 217:
 		{
 			yyVAL.expressions = SelectionStatement(yyS[yypt-6].expressions, yyS[yypt-4].expressions, yyS[yypt-2].SelectStatements, yyS[yypt-1].expressions, SEL_ELSEIFELSE)
 		}
-	case 217:
+	case 218:
 		{
 			yyVAL.expressions = SelectionExpressions(yyS[yypt-5].expressions, yyS[yypt-3].expressions, yyS[yypt-1].expressions)
 		}
-	case 218:
+	case 219:
 		{
 			yyVAL.expressions = SelectionExpressions(yyS[yypt-4].expressions, nil, yyS[yypt-1].expressions)
 		}
-	case 219:
+	case 220:
 		{
 			yyVAL.expressions = SelectionStatement(yyS[yypt-5].expressions, yyS[yypt-3].expressions, yyS[yypt-1].SelectStatements, nil, SEL_ELSEIF)
 		}
-	case 220:
+	case 221:
 		{
 			//
 			yyVAL.expressions = SelectionStatement(yyS[yypt-4].expressions, nil, yyS[yypt-1].SelectStatements, nil, SEL_ELSEIF)
 		}
-	case 221:
+	case 222:
 		{
 			//
 			yyVAL.expressions = SelectionStatement(yyS[yypt-5].expressions, nil, yyS[yypt-2].SelectStatements, yyS[yypt-1].expressions, SEL_ELSEIFELSE)
 		}
-	case 222:
+	case 223:
 		{
 			yyVAL.expressions = SelectionExpressions(yyS[yypt-1].expressions, yyS[yypt-0].expressions, nil)
 		}
-	case 223:
+	case 224:
 		{
 			yyVAL.expressions = nil
 		}
-	case 224:
+	case 225:
 		{
 			yyVAL.SelectStatement = SelectStatement{
 				Condition: yyS[yypt-3].expressions,
 				Then:      yyS[yypt-1].expressions,
 			}
 		}
-	case 225:
+	case 226:
 		{
 			yyVAL.SelectStatement = SelectStatement{
 				Condition: yyS[yypt-2].expressions,
 				Then:      nil,
 			}
 		}
-	case 226:
+	case 227:
 		{
 			yyVAL.SelectStatements = []SelectStatement{yyS[yypt-0].SelectStatement}
 		}
-	case 227:
+	case 228:
 		{
 			yyVAL.SelectStatements = append(yyS[yypt-1].SelectStatements, yyS[yypt-0].SelectStatement)
 		}
-	case 228:
+	case 229:
 		{
 			yyVAL.expressions = yyS[yypt-1].expressions
 		}
-	case 229:
+	case 230:
 		{
 			yyVAL.expressions = nil
 		}
-	case 230:
+	case 231:
 		{
-			yyVAL.expressions = IterationExpressions(nil, yyS[yypt-1].expressions, nil, yyS[yypt-0].expressions)
+			if len(yyS[yypt-1].expressions) > 0 && yyS[yypt-1].expressions[len(yyS[yypt-1].expressions)-1].IsRange {
+				yyVAL.expressions = RangeExpressions(yyS[yypt-1].expressions, yyS[yypt-0].expressions)
+			} else {
+				yyVAL.expressions = IterationExpressions(nil, yyS[yypt-1].expressions, nil, yyS[yypt-0].expressions)
+			}
 		}
-	case 231:
+	case 232:
 		{
 			yyVAL.expressions = IterationExpressions(yyS[yypt-2].expressions, yyS[yypt-1].expressions, nil, yyS[yypt-0].expressions)
 		}
-	case 232:
+	case 233:
 		{
 			yyVAL.expressions = IterationExpressions(yyS[yypt-3].expressions, yyS[yypt-2].expressions, yyS[yypt-1].expressions, yyS[yypt-0].expressions)
 		}
-	case 233:
+	case 234:
 		{
 			retExprs := ReturnExpressions{Expressions: AssociateReturnExpressions(0, yyS[yypt-0].expressions)}
 			retExprs.Size++
 			yyVAL.ReturnExpressions = retExprs
 		}
-	case 234:
+	case 235:
 		{
 			yyS[yypt-2].ReturnExpressions.Expressions = append(yyS[yypt-2].ReturnExpressions.Expressions, AssociateReturnExpressions(yyS[yypt-2].ReturnExpressions.Size, yyS[yypt-0].expressions)...)
 			yyS[yypt-2].ReturnExpressions.Size++
 			yyVAL.ReturnExpressions = yyS[yypt-2].ReturnExpressions
 		}
-	case 235:
+	case 236:
 		{
 			if pkg, err := PRGRM.GetCurrentPackage(); err == nil {
 				expr := MakeExpression(Natives[OP_JMP], CurrentFile, LineNo)
@@ -2549,19 +2564,19 @@ yynewstate:
 				panic(err)
 			}
 		}
-	case 236:
+	case 237:
 		{
 			yyVAL.expressions = ContinueExpressions()
 		}
-	case 237:
+	case 238:
 		{
 			yyVAL.expressions = BreakExpressions()
 		}
-	case 238:
+	case 239:
 		{
 			yyVAL.expressions = AddJmpToReturnExpressions(ReturnExpressions{})
 		}
-	case 239:
+	case 240:
 		{
 			yyVAL.expressions = AddJmpToReturnExpressions(yyS[yypt-1].ReturnExpressions)
 		}
@@ -8,7 +8,7 @@
 		. "github.com/skycoin/cx/cxgo/actions"
 	)
 
-	// var PRGRM = MakeProgram(CALLSTACK_SIZE, STACK_SIZE, INIT_HEAP_SIZE)
+	// var PRGRM = MakeProgram(INIT_CALLSTACK_SIZE, STACK_SIZE, INIT_HEAP_SIZE)
 	
 	func Parse (lexer *Lexer) int {
 		return yyParse(lexer)
@@ -84,7 +84,7 @@
                         I8 I16 I32 I64
                         STR
                         UI8 UI16 UI32 UI64
-                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE
+                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE RANGE
                         TYPE
                         
                         /* Types */
@@ -1164,6 +1164,10 @@ assignment_expression:
 				}
 			}
                 }
+	|       unary_expression CASSIGN RANGE conditional_expression
+                {
+			$$ = RangeClause($1, $4)
+                }
                 ;
 
 assignment_operator:
@@ -1360,7 +1364,11 @@ else_statement:
 iteration_statement:
                 FOR expression compound_statement
                 {
-			$$ = IterationExpressions(nil, $2, nil, $3)
+			if len($2) > 0 && $2[len($2) - 1].IsRange {
+				$$ = RangeExpressions($2, $3)
+			} else {
+				$$ = IterationExpressions(nil, $2, nil, $3)
+			}
                 }
         |       FOR expression_statement expression_statement compound_statement
                 {			
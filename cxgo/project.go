@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	cxcore "github.com/skycoin/cx/cx"
+	"github.com/skycoin/cx/cxgo/cxgo"
+	"github.com/skycoin/cx/cxgo/cxproject"
+)
+
+// isFlagSet checks if any of the flags `names` was set in the command line.
+func isFlagSet(names ...string) bool {
+	set := false
+	commandLine.Visit(func(f *flag.Flag) {
+		for _, name := range names {
+			if f.Name == name {
+				set = true
+			}
+		}
+	})
+	return set
+}
+
+// loadProjectConfig reads the configuration of the project containing the
+// first source file in `args`, or the working directory if there are none.
+// The runtime settings of the configuration become the defaults of
+// `options`, and the programs can only import the core packages that it
+// enables. It returns nil if there's no configuration.
+func loadProjectConfig(options *cxCmdFlags, args []string) *cxproject.Config {
+	dir := "."
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") || strings.HasPrefix(arg, "++") {
+			continue
+		}
+		if fi, err := os.Stat(arg); err == nil && fi.IsDir() {
+			dir = arg
+		} else {
+			dir = filepath.Dir(arg)
+		}
+		break
+	}
+	fileName, ok := cxproject.FindConfig(dir)
+	if !ok {
+		return nil
+	}
+	cfg, err := cxproject.ReadConfig(fileName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+
+	if options.initialHeap == "" {
+		options.initialHeap = cfg.Runtime.HeapInitial
+	}
+	if options.maxHeap == "" {
+		options.maxHeap = cfg.Runtime.HeapMax
+	}
+	if options.stackSize == "" {
+		options.stackSize = cfg.Runtime.StackSize
+	}
+	if options.maxCallStackSize == 0 {
+		options.maxCallStackSize = cfg.Runtime.CallStackMax
+	}
+	if options.minHeapFreeRatio == 0 {
+		options.minHeapFreeRatio = cfg.Runtime.MinHeapFreeRatio
+	}
+	if options.maxHeapFreeRatio == 0 {
+		options.maxHeapFreeRatio = cfg.Runtime.MaxHeapFreeRatio
+	}
+	cxgo.CorePackageEnabled = cfg.PackageEnabled
+
+	return cfg
+}
+
+// newProject runs `cx new <name>`, which creates a project from a template
+// in the working directory.
+func newProject(options cxCmdFlags, args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: cx new <name> [--template app|gui|http|lib]")
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+	root, err := cxproject.New(".", args[0], options.newTemplate)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cx new: %v\n", err)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+	fmt.Printf("Created %s\n", root)
+}
+
+// testProject runs `cx test`, which runs every test program of the project
+// `cfg` with the flags set in the command line, and fails if any of them
+// exits with an error.
+func testProject(options cxCmdFlags, cfg *cxproject.Config) {
+	if cfg == nil {
+		fmt.Fprintf(os.Stderr, "cx test: %s not found in the current directory or any parent directory\n", cxproject.ConfigFileName)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+	tests, err := cfg.TestPaths()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cx test: %v\n", err)
+		os.Exit(cxcore.CX_COMPILATION_ERROR)
+	}
+
+	var flags []string
+	commandLine.Visit(func(f *flag.Flag) {
+		flags = append(flags, fmt.Sprintf("--%s=%s", f.Name, f.Value))
+	})
+	for _, arg := range commandLine.Args() {
+		if strings.HasPrefix(arg, "++") {
+			flags = append(flags, arg)
+		}
+	}
+
+	failed := 0
+	for _, test := range tests {
+		ctx, cancel := context.Background(), context.CancelFunc(func() {})
+		if cfg.Test.Timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(cfg.Test.Timeout)*time.Second)
+		}
+
+		var out bytes.Buffer
+		cmd := exec.CommandContext(ctx, os.Args[0], append(flags, test)...)
+		cmd.Dir = cfg.Dir
+		cmd.Stdout = &out
+		cmd.Stderr = &out
+
+		name, err := filepath.Rel(cfg.Dir, test)
+		if err != nil {
+			name = test
+		}
+		start := time.Now()
+		err = cmd.Run()
+		elapsed := time.Since(start).Seconds()
+		timedOut := ctx.Err() == context.DeadlineExceeded
+		cancel()
+
+		switch {
+		case timedOut:
+			fmt.Printf("FAIL  %s: timed out after %ds\n", name, cfg.Test.Timeout)
+		case err != nil:
+			fmt.Printf("FAIL  %s (%.2fs): %v\n", name, elapsed, err)
+		default:
+			fmt.Printf("ok    %s (%.2fs)\n", name, elapsed)
+			continue
+		}
+		failed++
+		os.Stdout.Write(out.Bytes())
+	}
+
+	fmt.Printf("%d tests, %d passed, %d failed\n", len(tests), len(tests)-failed, failed)
+	if failed > 0 {
+		os.Exit(cxcore.CX_ASSERT)
+	}
+}
@@ -3,6 +3,7 @@ module github.com/skycoin/cx
 go 1.14
 
 require (
+	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-gl/gl v0.0.0-20190320180904-bf2b1f2f34d7
 	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20200707082815-5321531c36a2
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0 // indirect
@@ -14,6 +15,7 @@ require (
 	github.com/skycoin/dmsg v0.0.0-20201124135713-d832ca828200
 	github.com/skycoin/gltext v0.0.0-20200425002731-afdd8ff94e14
 	github.com/skycoin/skycoin v0.26.0
+	github.com/skycoin/yamux v0.0.0-20200803175205-571ceb89da9f
 	github.com/stretchr/testify v1.6.1 // indirect
 	golang.org/x/crypto v0.0.0-20200728195943-123391ffb6de // indirect
 	golang.org/x/exp v0.0.0-20200513190911-00229845015e // indirect
@@ -0,0 +1,10 @@
+module github.com/skycoin/cx/tests/dmsg-server
+
+go 1.14
+
+require (
+	github.com/sirupsen/logrus v1.7.0 // indirect
+	github.com/skycoin/dmsg v0.0.0-20201124135713-d832ca828200
+	github.com/skycoin/skycoin v0.26.0
+	golang.org/x/crypto v0.0.0-20200728195943-123391ffb6de // indirect
+)
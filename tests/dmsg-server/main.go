@@ -0,0 +1,62 @@
+// dmsg-server runs the dmsg server of test-http-dmsg.cx, which relays the
+// streams of its dmsg clients. It's a module of its own, so the dependencies
+// of the server aren't required by cx.
+//
+// Usage: go run . <discovery address>
+//
+// The server listens on a local TCP address and registers in the discovery.
+// When it's ready, it prints its public key and its address on a line, and it
+// runs until its standard input is closed.
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"time"
+
+	"github.com/skycoin/dmsg"
+	"github.com/skycoin/dmsg/cipher"
+	"github.com/skycoin/dmsg/disc"
+	"github.com/skycoin/skycoin/src/util/logging"
+)
+
+// timeout is how long the server waits to be registered in the discovery.
+const timeout = 30 * time.Second
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: dmsg-server <discovery address>")
+		os.Exit(2)
+	}
+	logging.Disable()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	pk, sk := cipher.GenerateKeyPair()
+	s := dmsg.NewServer(pk, sk, disc.NewHTTP(os.Args[1]), dmsg.DefaultServerConfig(), nil)
+	failed := make(chan error, 1)
+	go func() {
+		failed <- s.Serve(ln, "")
+	}()
+
+	select {
+	case <-s.Ready():
+	case err := <-failed:
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	case <-time.After(timeout):
+		fmt.Fprintln(os.Stderr, "dmsg-server: timed out waiting for the discovery")
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s %s\n", s.LocalPK().Hex(), s.AdvertisedAddr())
+	io.Copy(ioutil.Discard, os.Stdin)
+	s.Close()
+}
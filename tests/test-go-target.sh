@@ -0,0 +1,73 @@
+#!/usr/bin/env bash
+# Runs the programs of the test suite (the runTest calls of main.cx) with cx
+# and as Go programs generated by `cx build --target=go`, and compares their
+# outputs and exit codes.
+#
+# Usage: test-go-target.sh [path to cx]
+
+CX=${1:-cx}
+TESTS=$(cd "$(dirname "$0")" && pwd)
+ROOT=$(dirname "$TESTS")
+TIMEOUT=120
+
+# The generated packages are modules of their own, so they're built outside of
+# the cx module.
+OUT=$(mktemp -d)
+trap 'rm -rf "$OUT"' EXIT
+
+# cx logs the files that it opens, and the Go stack traces printed after a
+# runtime error depend on the binary
+filter() {
+	tr -d '\000' | grep -v -e '^Stating file' -e '^CXOpenFile' -e '^Failed to ' -e '^Creating dir' -e '^Creating file' -e '^Removing ' -e '^Renaming file' -e '^Reading dir' -e '^Reading file' | sed '/^goroutine /,$d'
+}
+
+count=0
+failed=0
+# the tests without source files start the REPL, there's nothing to build
+while IFS= read -r args; do
+	count=$((count + 1))
+	dir="$OUT/$count"
+
+	cd "$TESTS" || exit 1
+	timeout $TIMEOUT $CX $args < /dev/null > "$dir.out" 2>&1
+	wantCode=$?
+	want=$(filter < "$dir.out")
+
+	$CX build -o "$dir" $args > "$dir.build" 2>&1
+	buildCode=$?
+	if [ $buildCode -ne 0 ]; then
+		# programs that don't compile can't be built
+		if [ $buildCode -ne $wantCode ]; then
+			echo "FAILED  | '$args' | cx exited with $wantCode, cx build with $buildCode"
+			failed=$((failed + 1))
+		fi
+		continue
+	fi
+
+	# the packages require the version of cx that generated them, which is
+	# replaced by the sources being tested
+	cp "$ROOT/go.sum" "$dir/go.sum"
+	if ! (cd "$dir" && go mod edit -replace "github.com/skycoin/cx=$ROOT" && go build -tags base -o program .) > "$dir.log" 2>&1; then
+		echo "FAILED  | '$args' | the Go package doesn't build"
+		cat "$dir.log"
+		failed=$((failed + 1))
+		continue
+	fi
+
+	timeout $TIMEOUT "$dir/program" > "$dir.out" 2>&1
+	gotCode=$?
+	# the warnings of the compiler are printed by cx build
+	got=$(cat "$dir.build" "$dir.out" | filter)
+
+	if [ $gotCode -ne $wantCode ]; then
+		echo "FAILED  | '$args' | cx exited with $wantCode, the Go program with $gotCode"
+		failed=$((failed + 1))
+	elif [ "$got" != "$want" ]; then
+		echo "FAILED  | '$args' | different outputs"
+		diff <(echo "$want") <(echo "$got") | head -20
+		failed=$((failed + 1))
+	fi
+done < <(grep -E '^\s*runTest(Ex)?\("' "$TESTS/main.cx" | grep -v TEST_GUI | sed -E 's/^[^"]*"([^"]*)".*/\1/' | grep '\.cx')
+
+echo "$count programs, $((count - failed)) with the same results, $failed failed"
+[ $failed -eq 0 ]
@@ -0,0 +1,5 @@
+module example.com/app-a
+
+require (
+	example.com/greet v1.0.0
+)
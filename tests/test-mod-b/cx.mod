@@ -0,0 +1,6 @@
+module example.com/app-b
+
+require (
+	example.com/greet v1.1.0
+	example.com/strutil v0.1.0 // indirect
+)
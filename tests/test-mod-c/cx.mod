@@ -0,0 +1,5 @@
+module example.com/app-c
+
+require example.com/greet v1.0.0
+
+replace example.com/greet => ./greet
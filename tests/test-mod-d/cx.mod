@@ -0,0 +1,3 @@
+module example.com/app-d
+
+require example.com/greet v1.1.0